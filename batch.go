@@ -0,0 +1,92 @@
+package hermes
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BatchResult reports the outcome of parsing a single URL within a batch.
+// Exactly one of Result and Err is non-nil.
+type BatchResult struct {
+	// Index is the position of URL in the slice passed to ParseBatchFunc
+	Index int
+
+	// URL is the URL that was parsed
+	URL string
+
+	// Result is the parsed result, or nil if parsing failed
+	Result *Result
+
+	// Err is the parse error, or nil if parsing succeeded
+	Err error
+
+	// Duration is how long parsing this URL took
+	Duration time.Duration
+}
+
+// ParseBatchFunc parses urls concurrently and invokes fn as each URL completes.
+// At most concurrency URLs are parsed at once; values below 1 are treated as 1.
+//
+// fn is called exactly once per started URL, in completion order rather than
+// input order, and may be called from multiple goroutines at the same time.
+// It must therefore be safe for concurrent use: guard any shared state such as
+// counters, progress bars or output files with a mutex or channel.
+//
+// ParseBatchFunc returns once every URL has been reported, or once ctx is
+// cancelled and all in-flight parses have finished. URLs that were not started
+// before cancellation are not reported. fn is never invoked after
+// ParseBatchFunc returns. The returned error is ctx.Err() if the context was
+// cancelled before all URLs were started, and nil otherwise; per-URL failures
+// are reported through BatchResult.Err.
+//
+// Example:
+//
+//	var mu sync.Mutex
+//	done := 0
+//	err := client.ParseBatchFunc(ctx, urls, 5, func(br hermes.BatchResult) {
+//	    mu.Lock()
+//	    defer mu.Unlock()
+//	    done++
+//	    fmt.Printf("[%d/%d] %s\n", done, len(urls), br.URL)
+//	})
+func (c *Client) ParseBatchFunc(ctx context.Context, urls []string, concurrency int, fn func(BatchResult)) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	sem := make(chan struct{}, concurrency) // Semaphore for concurrency control
+	var wg sync.WaitGroup
+	var cancelled error
+
+	for i, u := range urls {
+		// Acquire a slot, giving up if the context is cancelled while waiting
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if cancelled = ctx.Err(); cancelled != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(index int, url string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			start := time.Now()
+			result, err := c.Parse(ctx, url)
+
+			fn(BatchResult{
+				Index:    index,
+				URL:      url,
+				Result:   result,
+				Err:      err,
+				Duration: time.Since(start),
+			})
+		}(i, u)
+	}
+
+	wg.Wait()
+	return cancelled
+}
@@ -0,0 +1,96 @@
+package hermes_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/BumpyClock/hermes"
+)
+
+func TestParseBatchFuncCallbackOncePerURL(t *testing.T) {
+	var inFlight, maxInFlight int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, `<html><head><title>Page %s</title></head><body><article><p>Content for %s with enough words to be extracted as an article body.</p></article></body></html>`, r.URL.Path, r.URL.Path)
+	}))
+	defer ts.Close()
+
+	var urls []string
+	for i := 0; i < 10; i++ {
+		urls = append(urls, fmt.Sprintf("%s/page-%d", ts.URL, i))
+	}
+	urls = append(urls, ts.URL+"/missing")
+
+	client := hermes.New(hermes.WithAllowPrivateNetworks(true))
+
+	var mu sync.Mutex
+	calls := make(map[string]int)
+	failures := 0
+	err := client.ParseBatchFunc(context.Background(), urls, 3, func(br hermes.BatchResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[br.URL]++
+		if urls[br.Index] != br.URL {
+			t.Errorf("Index %d does not match URL %s", br.Index, br.URL)
+		}
+		if br.Err != nil {
+			failures++
+		} else if br.Result == nil {
+			t.Errorf("Expected result for %s", br.URL)
+		}
+	})
+	if err != nil {
+		t.Fatalf("ParseBatchFunc returned error: %v", err)
+	}
+
+	if len(calls) != len(urls) {
+		t.Fatalf("Expected callbacks for %d URLs, got %d", len(urls), len(calls))
+	}
+	for _, u := range urls {
+		if calls[u] != 1 {
+			t.Errorf("Expected exactly one callback for %s, got %d", u, calls[u])
+		}
+	}
+	if failures != 1 {
+		t.Errorf("Expected 1 failure for the missing page, got %d", failures)
+	}
+	if maxInFlight > 3 {
+		t.Errorf("Expected at most 3 concurrent requests, got %d", maxInFlight)
+	}
+}
+
+func TestParseBatchFuncCancelledContext(t *testing.T) {
+	client := hermes.New()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls int32
+	err := client.ParseBatchFunc(ctx, []string{"https://example.com/a", "https://example.com/b"}, 1, func(br hermes.BatchResult) {
+		atomic.AddInt32(&calls, 1)
+	})
+	if err != context.Canceled {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("Expected no callbacks after cancellation, got %d", calls)
+	}
+}
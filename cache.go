@@ -0,0 +1,99 @@
+package hermes
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/BumpyClock/hermes/internal/utils/dom"
+	"github.com/BumpyClock/hermes/internal/utils/text"
+)
+
+// resultCache is a thread-safe LRU cache of successful parse results with a fixed TTL
+type resultCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	ll         *list.List               // Front is most recently used
+	items      map[string]*list.Element // Cache key -> element holding *resultCacheEntry
+}
+
+// resultCacheEntry is a single cached result
+type resultCacheEntry struct {
+	key       string
+	result    *Result
+	expiresAt time.Time
+}
+
+// newResultCache creates a cache whose entries live for ttl.
+// A maxEntries of zero or less means the cache is unbounded.
+func newResultCache(ttl time.Duration, maxEntries int) *resultCache {
+	return &resultCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// cacheKey normalizes a URL so tracking parameters and anchors don't fragment the cache
+func cacheKey(url string) string {
+	return text.RemoveAnchor(dom.SanitizeURL(url))
+}
+
+// get returns a deep copy of the cached result for url if present and not expired
+func (rc *resultCache) get(url string) (*Result, bool) {
+	key := cacheKey(url)
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	el, ok := rc.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*resultCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		rc.removeElement(el)
+		return nil, false
+	}
+
+	rc.ll.MoveToFront(el)
+	return entry.result.clone(), true
+}
+
+// set stores a deep copy of result for url, evicting the least recently used entry if full
+func (rc *resultCache) set(url string, result *Result) {
+	if result == nil {
+		return
+	}
+
+	key := cacheKey(url)
+	entry := &resultCacheEntry{
+		key:       key,
+		result:    result.clone(),
+		expiresAt: time.Now().Add(rc.ttl),
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if el, ok := rc.items[key]; ok {
+		el.Value = entry
+		rc.ll.MoveToFront(el)
+		return
+	}
+
+	rc.items[key] = rc.ll.PushFront(entry)
+
+	if rc.maxEntries > 0 && rc.ll.Len() > rc.maxEntries {
+		rc.removeElement(rc.ll.Back())
+	}
+}
+
+// removeElement removes an element from both the list and the index; callers hold mu
+func (rc *resultCache) removeElement(el *list.Element) {
+	rc.ll.Remove(el)
+	delete(rc.items, el.Value.(*resultCacheEntry).key)
+}
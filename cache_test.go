@@ -0,0 +1,171 @@
+package hermes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newCountingServer returns a test server that counts requests per path
+func newCountingServer(t *testing.T) (*httptest.Server, func(path string) int) {
+	t.Helper()
+
+	var mu sync.Mutex
+	hits := make(map[string]int)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		hits[r.URL.Path]++
+		mu.Unlock()
+
+		if r.URL.Path == "/error" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><head><title>Cached Page</title></head><body><article><p>Cached content with enough words to be extracted by the parser as an article body.</p></article></body></html>`))
+	}))
+
+	count := func(path string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return hits[path]
+	}
+	return ts, count
+}
+
+func TestWithCacheHit(t *testing.T) {
+	ts, count := newCountingServer(t)
+	defer ts.Close()
+
+	client := New(WithAllowPrivateNetworks(true), WithCache(time.Minute, 10))
+	ctx := context.Background()
+
+	first, err := client.Parse(ctx, ts.URL+"/article")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	// Tracking parameters and anchors normalize to the same cache key
+	second, err := client.Parse(ctx, ts.URL+"/article?utm_source=newsletter&fbclid=abc#comments")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if count("/article") != 1 {
+		t.Errorf("Expected 1 request with cache hit, got %d", count("/article"))
+	}
+	if second.Title != first.Title {
+		t.Errorf("Expected cached title %q, got %q", first.Title, second.Title)
+	}
+
+	// Mutating a returned result must not affect the cached copy
+	second.Title = "changed"
+	third, _ := client.Parse(ctx, ts.URL+"/article")
+	if third.Title != first.Title {
+		t.Errorf("Cached result was mutated through a returned pointer: %q", third.Title)
+	}
+}
+
+func TestWithCacheMissAfterExpiry(t *testing.T) {
+	ts, count := newCountingServer(t)
+	defer ts.Close()
+
+	client := New(WithAllowPrivateNetworks(true), WithCache(50*time.Millisecond, 10))
+	ctx := context.Background()
+
+	if _, err := client.Parse(ctx, ts.URL+"/article"); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if _, err := client.Parse(ctx, ts.URL+"/article"); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if count("/article") != 2 {
+		t.Errorf("Expected 2 requests after expiry, got %d", count("/article"))
+	}
+}
+
+func TestWithCacheLRUEviction(t *testing.T) {
+	ts, count := newCountingServer(t)
+	defer ts.Close()
+
+	client := New(WithAllowPrivateNetworks(true), WithCache(time.Minute, 2))
+	ctx := context.Background()
+
+	for _, path := range []string{"/a", "/b", "/a", "/c", "/a", "/b"} {
+		if _, err := client.Parse(ctx, ts.URL+path); err != nil {
+			t.Fatalf("Parse %s failed: %v", path, err)
+		}
+	}
+
+	// /a stays hot, /b is evicted when /c arrives and must be fetched again
+	if count("/a") != 1 {
+		t.Errorf("Expected /a to be fetched once, got %d", count("/a"))
+	}
+	if count("/b") != 2 {
+		t.Errorf("Expected /b to be refetched after eviction, got %d", count("/b"))
+	}
+	if count("/c") != 1 {
+		t.Errorf("Expected /c to be fetched once, got %d", count("/c"))
+	}
+}
+
+func TestWithCacheSkipsErrors(t *testing.T) {
+	ts, count := newCountingServer(t)
+	defer ts.Close()
+
+	client := New(WithAllowPrivateNetworks(true), WithCache(time.Minute, 10))
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Parse(ctx, ts.URL+"/error"); err == nil {
+			t.Fatal("Expected error for failing page")
+		}
+	}
+
+	if count("/error") != 2 {
+		t.Errorf("Expected errors not to be cached, got %d requests", count("/error"))
+	}
+}
+
+func TestResultCacheDeepCopies(t *testing.T) {
+	cache := newResultCache(time.Minute, 10)
+	published := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	original := &Result{
+		Title:         "Cached Page",
+		DatePublished: &published,
+		Breadcrumbs:   []string{"Home", "News"},
+		Warnings:      []string{"missing author"},
+		FieldSources:  map[string]string{"title": "meta"},
+		Tables:        [][][]string{{{"a", "b"}}},
+		Structured:    map[string]interface{}{"recipe": map[string]interface{}{"ingredients": []string{"flour"}}},
+	}
+	cache.set("http://127.0.0.1/article", original)
+
+	// Neither the stored result nor a loaded copy may alias the other
+	original.Breadcrumbs[0] = "changed"
+	original.FieldSources["title"] = "changed"
+	loaded, ok := cache.get("http://127.0.0.1/article")
+	if !ok {
+		t.Fatal("Expected a cache hit")
+	}
+	loaded.Warnings[0] = "changed"
+	loaded.Tables[0][0][0] = "changed"
+	*loaded.DatePublished = time.Time{}
+	loaded.Structured["recipe"].(map[string]interface{})["ingredients"].([]string)[0] = "changed"
+
+	again, _ := cache.get("http://127.0.0.1/article")
+	if again.Breadcrumbs[0] != "Home" || again.FieldSources["title"] != "meta" {
+		t.Errorf("Cached result shares state with the stored result: %+v", again)
+	}
+	if again.Warnings[0] != "missing author" || again.Tables[0][0][0] != "a" || !again.DatePublished.Equal(published) {
+		t.Errorf("Cached result shares state with a loaded copy: %+v", again)
+	}
+	if got := again.Structured["recipe"].(map[string]interface{})["ingredients"].([]string)[0]; got != "flour" {
+		t.Errorf("Cached structured data shares state with a loaded copy: %q", got)
+	}
+}
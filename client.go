@@ -3,10 +3,17 @@ package hermes
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/BumpyClock/hermes/internal/extractors/generic"
 	"github.com/BumpyClock/hermes/internal/parser"
+	"github.com/BumpyClock/hermes/internal/utils/dom"
 	"github.com/BumpyClock/hermes/internal/validation"
 )
 
@@ -15,9 +22,81 @@ import (
 type Client struct {
 	httpClient           *http.Client
 	userAgent            string
+	acceptHeader         string
 	timeout              time.Duration
 	allowPrivateNetworks bool
 	contentType          string
+	expandTruncated      bool
+	keepSafeStyles       bool
+	pdfSupport           bool
+	locale               string
+	summarySentences     int
+	maxContentLength     int64
+	pageTimeout          time.Duration
+	stripTrackingParams  bool
+	linkDensityThreshold float64
+	includeRawContent    bool
+	proseWordCount       bool
+	nestHeadings         bool
+	minLeadImageWidth    int
+	minLeadImageHeight   int
+	structuredData       bool
+	contentHint          string
+	contentSections      bool
+	asciiQuotes          bool
+	rejectNonArticles    bool
+	minParagraphWords    int
+	allowedContentTypes  []string
+	contributors         bool
+	markdownFrontmatter  bool
+	allowDekURLs         bool
+	debugScores          bool
+	metadataOnly         bool
+	followMetaRefresh    bool
+	fallback             bool
+	fallbackSet          bool
+	stripEmoji           bool
+	keepEmbedHosts       []string
+	verifyFavicon        bool
+	
+	// Field extractions run at once per parse, 1 runs them in order and 0 means no limit
+	maxExtractionConcurrency int
+	
+	// Extra request headers, only set per call by ParseWithOptions
+	headers map[string]string
+	
+	// Set when the HTTP client was created by an option rather than supplied with WithHTTPClient
+	ownsHTTPClient bool
+	
+	// SSRF host lists layered on the private network check
+	ssrfAllowHosts []string
+	ssrfDenyHosts  []string
+	
+	// Optional fetcher replacing the built-in HTTP fetching
+	fetcher Fetcher
+	
+	// Optional tuning for the default HTTP transport
+	transportConfig *TransportConfig
+	
+	// Source of the current time for date-relative fields, nil uses time.Now
+	clock func() time.Time
+	
+	// Optional logger for parse diagnostics, nil keeps the client silent
+	logger Logger
+	
+	// Result transformers applied in order after extraction
+	resultTransformers []func(*Result) error
+	
+	// Optional cache of successful parse results
+	cache *resultCache
+	
+	// Optional store of ETag/Last-Modified validators for conditional fetching
+	conditionalStore ConditionalStore
+	
+	// Per-domain option overrides keyed by host without "www.", and the
+	// clients built from them once the base configuration is complete
+	domainProfileOptions map[string][]Option
+	domainProfiles       map[string]*Client
 	
 	// Internal parser instance
 	parser *parser.Hermes
@@ -49,15 +128,12 @@ func New(opts ...Option) *Client {
 	// Create HTTP client if not provided
 	if c.httpClient == nil {
 		c.httpClient = &http.Client{
-			Timeout: c.timeout,
-			Transport: &http.Transport{
-				MaxIdleConns:        100,
-				MaxIdleConnsPerHost: 10,
-				IdleConnTimeout:     90 * time.Second,
-				DisableCompression:  false,
-				// Re-enable HTTP/2 by default (remove old workaround)
-			},
+			Timeout:   c.timeout,
+			Transport: newTransport(c.transportConfig),
 		}
+	} else if c.httpClient.Transport == nil && (c.ownsHTTPClient || c.transportConfig != nil) {
+		// WithTimeout creates a client without a transport; give it the pinned, tuned one
+		c.httpClient.Transport = newTransport(c.transportConfig)
 	}
 	
 	// Create internal parser
@@ -65,9 +141,44 @@ func New(opts ...Option) *Client {
 	// until we can refactor the parser to accept it directly
 	c.parser = parser.New()
 	
+	c.buildDomainProfiles()
+	
 	return c
 }
 
+// newTransport builds the default HTTP transport, overriding its pool and
+// protocol settings with any non-zero values from config
+func newTransport(config *TransportConfig) *http.Transport {
+	transport := &http.Transport{
+		// Connect only to addresses that pass the SSRF checks of the request's parse
+		DialContext: validation.PinnedDialContext(&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}),
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     90 * time.Second,
+		DisableCompression:  false,
+		// Re-enable HTTP/2 by default (remove old workaround)
+	}
+	if config == nil {
+		return transport
+	}
+	
+	if config.MaxIdleConns > 0 {
+		transport.MaxIdleConns = config.MaxIdleConns
+	}
+	if config.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = config.MaxIdleConnsPerHost
+	}
+	if config.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = config.IdleConnTimeout
+	}
+	transport.ForceAttemptHTTP2 = config.ForceHTTP2
+	transport.DisableKeepAlives = config.DisableKeepAlives
+	return transport
+}
+
 // Parse extracts content from the given URL.
 // The context can be used to cancel the request or set a deadline.
 //
@@ -79,7 +190,9 @@ func New(opts ...Option) *Client {
 //	    // Handle error
 //	}
 //	fmt.Println(result.Title)
-func (c *Client) Parse(ctx context.Context, url string) (*Result, error) {
+func (c *Client) Parse(ctx context.Context, url string) (result *Result, err error) {
+	defer func() { recordParse(result, err) }()
+	
 	// Validate URL
 	if url == "" {
 		return nil, &ParseError{
@@ -90,14 +203,42 @@ func (c *Client) Parse(ctx context.Context, url string) (*Result, error) {
 		}
 	}
 	
+	// Apply the target domain's profile, if any
+	c = c.forURL(url)
+	
+	// Serve from the result cache when enabled
+	if c.cache != nil {
+		if cached, ok := c.cache.get(url); ok {
+			return cached, nil
+		}
+	}
+	
 	// Create parser options with client configuration
 	opts := c.buildParserOptions()
 	
+	// Send cache validators from the previous fetch when conditional fetching is enabled
+	var previous ConditionalEntry
+	if c.conditionalStore != nil {
+		previous, _ = c.conditionalStore.Get(url)
+		if previous.ETag != "" {
+			opts.Headers["If-None-Match"] = previous.ETag
+		}
+		if previous.LastModified != "" {
+			opts.Headers["If-Modified-Since"] = previous.LastModified
+		}
+	}
+	
 	// Parse the URL with context support
 	internalResult, err := c.parser.ParseWithContext(ctx, url, opts)
 	if err != nil {
 		// Use proper error classification instead of string matching
 		code := ErrorCode(parser.ClassifyErrorCode(err, ctx, "Parse"))
+		
+		// Unchanged since the last fetch: hand back the previous result if we have one
+		if code == ErrNotModified && previous.Result != nil {
+			return previous.Result.clone(), nil
+		}
+		
 		// Wrap error with type information
 		return nil, &ParseError{
 			Code: code,
@@ -108,7 +249,25 @@ func (c *Client) Parse(ctx context.Context, url string) (*Result, error) {
 	}
 	
 	// Map internal result to public result
-	result := mapInternalResult(internalResult)
+	result = mapInternalResult(internalResult)
+	if err := c.transformResult(result, url, "Parse"); err != nil {
+		return nil, err
+	}
+	
+	// Only successful results are cached
+	if c.cache != nil {
+		c.cache.set(url, result)
+	}
+	
+	// Remember validators so the next fetch of this URL can be conditional
+	if c.conditionalStore != nil && (internalResult.ETag != "" || internalResult.LastModified != "") {
+		// The store keeps its own deep copy so later edits to result never reach it
+		c.conditionalStore.Set(url, ConditionalEntry{
+			ETag:         internalResult.ETag,
+			LastModified: internalResult.LastModified,
+			Result:       result.clone(),
+		})
+	}
 	return result, nil
 }
 
@@ -119,7 +278,9 @@ func (c *Client) Parse(ctx context.Context, url string) (*Result, error) {
 //
 //	html := "<html>...</html>"
 //	result, err := client.ParseHTML(ctx, html, "https://example.com/article")
-func (c *Client) ParseHTML(ctx context.Context, html, url string) (*Result, error) {
+func (c *Client) ParseHTML(ctx context.Context, html, url string) (result *Result, err error) {
+	defer func() { recordParse(result, err) }()
+	
 	// Validate inputs
 	if url == "" {
 		return nil, &ParseError{
@@ -139,11 +300,11 @@ func (c *Client) ParseHTML(ctx context.Context, html, url string) (*Result, erro
 		}
 	}
 	
-	// Validate URL format
-	validationOpts := validation.DefaultValidationOptions()
-	validationOpts.AllowPrivateNetworks = c.allowPrivateNetworks
-	validationOpts.AllowLocalhost = c.allowPrivateNetworks // Localhost should be allowed when private networks are allowed
+	// Apply the target domain's profile, if any
+	c = c.forURL(url)
 	
+	// Validate URL format
+	validationOpts := c.validationOptions()
 	if err := validation.ValidateURL(ctx, url, validationOpts); err != nil {
 		return nil, &ParseError{
 			Code: ErrInvalidURL,
@@ -152,6 +313,8 @@ func (c *Client) ParseHTML(ctx context.Context, html, url string) (*Result, erro
 			Err:  err,
 		}
 	}
+	// Pages fetched while parsing, like later pages of a series, are held to the same rules
+	ctx = validation.ContextWithOptions(ctx, validationOpts)
 	
 	// Create parser options with client configuration
 	opts := c.buildParserOptions()
@@ -171,20 +334,166 @@ func (c *Client) ParseHTML(ctx context.Context, html, url string) (*Result, erro
 	}
 	
 	// Map internal result to public result
-	result := mapInternalResult(internalResult)
+	result = mapInternalResult(internalResult)
+	if err := c.transformResult(result, url, "ParseHTML"); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ParseDocument extracts content from an already parsed document, skipping
+// the serialize-and-reparse round trip ParseHTML would need. It suits callers
+// that fetch or render pages themselves, or adjust the DOM before extraction.
+// The document is copied before extraction, so doc itself is left unchanged.
+//
+// Example:
+//
+//	doc, _ := goquery.NewDocumentFromReader(renderedPage)
+//	result, err := client.ParseDocument(ctx, doc, "https://example.com/article")
+func (c *Client) ParseDocument(ctx context.Context, doc *goquery.Document, url string) (result *Result, err error) {
+	defer func() { recordParse(result, err) }()
+	
+	// Validate inputs
+	if url == "" {
+		return nil, &ParseError{
+			Code: ErrInvalidURL,
+			URL:  url,
+			Op:   "ParseDocument",
+			Err:  fmt.Errorf("empty URL"),
+		}
+	}
+	
+	if doc == nil || doc.Selection == nil || doc.Selection.Length() == 0 {
+		return nil, &ParseError{
+			Code: ErrInvalidURL,
+			URL:  url,
+			Op:   "ParseDocument",
+			Err:  fmt.Errorf("empty document"),
+		}
+	}
+	
+	// Apply the target domain's profile, if any
+	c = c.forURL(url)
+	
+	// Validate URL format
+	validationOpts := c.validationOptions()
+	if err := validation.ValidateURL(ctx, url, validationOpts); err != nil {
+		return nil, &ParseError{
+			Code: ErrInvalidURL,
+			URL:  url,
+			Op:   "ParseDocument",
+			Err:  err,
+		}
+	}
+	// Pages fetched while parsing, like later pages of a series, are held to the same rules
+	ctx = validation.ContextWithOptions(ctx, validationOpts)
+	
+	opts := c.buildParserOptions()
+	
+	internalResult, err := c.parser.ParseDocumentWithContext(ctx, doc, url, opts)
+	if err != nil {
+		code := ErrorCode(parser.ClassifyErrorCode(err, ctx, "ParseDocument"))
+		return nil, &ParseError{
+			Code: code,
+			URL:  url,
+			Op:   "ParseDocument",
+			Err:  err,
+		}
+	}
+	
+	result = mapInternalResult(internalResult)
+	if err := c.transformResult(result, url, "ParseDocument"); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
+// transformResult runs the configured result transformers in order, stopping
+// at the first error and wrapping it as an ErrTransform ParseError
+func (c *Client) transformResult(result *Result, url, op string) error {
+	for _, transform := range c.resultTransformers {
+		if err := transform(result); err != nil {
+			return &ParseError{
+				Code: ErrTransform,
+				URL:  url,
+				Op:   op,
+				Err:  err,
+			}
+		}
+	}
+	return nil
+}
+
+// validationOptions returns the client's SSRF rules
+func (c *Client) validationOptions() validation.ValidationOptions {
+	return validation.SSRFOptions(c.allowPrivateNetworks, c.ssrfAllowHosts, c.ssrfDenyHosts)
+}
+
 // buildParserOptions creates parser options with client configuration
 // This centralizes the option building logic to avoid duplication
 func (c *Client) buildParserOptions() *parser.ParserOptions {
-	return &parser.ParserOptions{
+	headers := map[string]string{"User-Agent": c.userAgent}
+	if c.acceptHeader != "" {
+		headers["Accept"] = c.acceptHeader
+	} else if c.pdfSupport {
+		headers["Accept"] = "text/html,application/xhtml+xml,application/pdf;q=0.9"
+	}
+	for name, value := range c.headers {
+		headers[http.CanonicalHeaderKey(name)] = value
+	}
+	
+	opts := &parser.ParserOptions{
 		FetchAllPages:        false,
 		ContentType:          c.contentType,
-		Headers:              map[string]string{"User-Agent": c.userAgent},
+		Headers:              headers,
 		HTTPClient:           c.httpClient,
 		AllowPrivateNetworks: c.allowPrivateNetworks,
+		ExpandTruncated:      c.expandTruncated,
+		KeepSafeStyles:       c.keepSafeStyles,
+		PDFSupport:           c.pdfSupport,
+		Locale:               c.locale,
+		SummarySentences:     c.summarySentences,
+		MaxContentLength:     c.maxContentLength,
+		PageTimeout:          c.pageTimeout,
+		StripTrackingParams:  c.stripTrackingParams,
+		LinkDensityThreshold: c.linkDensityThreshold,
+		IncludeRawContent:    c.includeRawContent,
+		ProseWordCount:       c.proseWordCount,
+		NestHeadings:         c.nestHeadings,
+		LeadImage: generic.ImageOptions{
+			MinWidth:  c.minLeadImageWidth,
+			MinHeight: c.minLeadImageHeight,
+		},
+		StructuredData:      c.structuredData,
+		ContentHint:         c.contentHint,
+		ContentSections:     c.contentSections,
+		ASCIIQuotes:         c.asciiQuotes,
+		RejectNonArticles:   c.rejectNonArticles,
+		MinParagraphWords:   c.minParagraphWords,
+		AllowedContentTypes: c.allowedContentTypes,
+		Contributors:        c.contributors,
+		MarkdownFrontmatter: c.markdownFrontmatter,
+		SSRFAllowHosts:      c.ssrfAllowHosts,
+		SSRFDenyHosts:       c.ssrfDenyHosts,
+		AllowDekURLs:        c.allowDekURLs,
+		DebugScores:         c.debugScores,
+		Clock:               c.clock,
+		MetadataOnly:        c.metadataOnly,
+		FollowMetaRefresh:   c.followMetaRefresh,
+		Fallback:            c.fallback,
+		FallbackExplicit:    c.fallbackSet,
+		StripEmoji:          c.stripEmoji,
+		MaxExtractionConcurrency: c.maxExtractionConcurrency,
+		KeepEmbedHosts:      c.keepEmbedHosts,
+		VerifyFavicon:       c.verifyFavicon,
+	}
+	if c.fetcher != nil {
+		opts.Fetcher = c.fetcher.Fetch
 	}
+	if c.logger != nil {
+		opts.Logger = c.logger
+	}
+	return opts
 }
 
 // mapInternalResult converts the internal parser.Result to our public Result type
@@ -194,21 +503,159 @@ func mapInternalResult(internal *parser.Result) *Result {
 	}
 	
 	return &Result{
-		URL:           internal.URL,
-		Title:         internal.Title,
-		Content:       internal.Content,
-		Author:        internal.Author,
-		DatePublished: internal.DatePublished,
-		LeadImageURL:  internal.LeadImageURL,
-		Dek:           internal.Dek,
-		Domain:        internal.Domain,
-		Excerpt:       internal.Excerpt,
-		WordCount:     internal.WordCount,
-		Direction:     internal.Direction,
-		TotalPages:    internal.TotalPages,
-		RenderedPages: internal.RenderedPages,
-		SiteName:      internal.SiteName,
-		Description:   internal.Description,
-		Language:      internal.Language,
-	}
-}
\ No newline at end of file
+		URL:             internal.URL,
+		Title:           internal.Title,
+		Content:         internal.Content,
+		RawContent:      internal.RawContent,
+		Author:          internal.Author,
+		DatePublished:   internal.DatePublished,
+		PublishTimezone: internal.PublishTimezone,
+		DateModified:    internal.DateModified,
+		Freshness:       internal.Freshness,
+		Authors:         mapAuthors(internal.Authors),
+		Contributors:    mapAuthors(internal.Contributors),
+		LeadImageURL:    internal.LeadImageURL,
+		LeadImageWidth:  internal.LeadImageWidth,
+		LeadImageHeight: internal.LeadImageHeight,
+		Dek:             internal.Dek,
+		Domain:          internal.Domain,
+		Excerpt:         internal.Excerpt,
+		Summary:         internal.Summary,
+		ContentHash:     internal.ContentHash,
+		WordCount:       internal.WordCount,
+		TotalWordCount:  internal.TotalWordCount,
+		CommentCount:    internal.CommentCount,
+		Paywalled:       internal.Paywalled,
+		IsArticle:       internal.IsArticle,
+		PageType:        internal.PageType,
+		Direction:       internal.Direction,
+		TotalPages:      internal.TotalPages,
+		RenderedPages:   internal.RenderedPages,
+		SiteName:        internal.SiteName,
+		Description:     internal.Description,
+		Language:        internal.Language,
+		Alternates:      internal.Alternates,
+		Favicon:         internal.Favicon,
+		Icons:           mapIcons(internal.Icons),
+		ThemeColor:      internal.ThemeColor,
+		Breadcrumbs:     internal.Breadcrumbs,
+		Section:         internal.Section,
+		Location:        internal.Location,
+		Geo:             mapGeo(internal.Geo),
+		SocialMeta:      internal.SocialMeta,
+		Videos:          internal.Videos,
+		ImageCount:      internal.ImageCount,
+		EmbedCount:      internal.EmbedCount,
+		AudioURL:        internal.AudioURL,
+		Tables:          internal.Tables,
+		Quotes:          internal.Quotes,
+		Sections:        mapSections(internal.Sections),
+		ExtractorUsed:   internal.ExtractorUsed,
+		FieldSources:    internal.FieldSources,
+		Warnings:        internal.Warnings,
+		Structured:      internal.Structured,
+		DebugScores:     mapCandidateScores(internal.DebugScores),
+	}
+}
+
+// buildDomainProfiles derives a client for each domain profile by applying its
+// options on top of a copy of the fully configured base client
+func (c *Client) buildDomainProfiles() {
+	if len(c.domainProfileOptions) == 0 {
+		return
+	}
+	
+	c.domainProfiles = make(map[string]*Client, len(c.domainProfileOptions))
+	for domain, opts := range c.domainProfileOptions {
+		profile := *c
+		profile.domainProfileOptions = nil
+		profile.domainProfiles = nil
+		
+		// Options like WithTimeout modify the HTTP client in place, so the
+		// profile gets its own copy rather than changing the base client's
+		httpClient := *c.httpClient
+		profile.httpClient = &httpClient
+		
+		for _, opt := range opts {
+			opt(&profile)
+		}
+		c.domainProfiles[domain] = &profile
+	}
+}
+
+// forURL returns the client to use for rawURL: the profile matching its host, or c itself
+func (c *Client) forURL(rawURL string) *Client {
+	if len(c.domainProfiles) == 0 {
+		return c
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return c
+	}
+	if profile, ok := c.domainProfiles[profileDomain(u.Hostname())]; ok {
+		return profile
+	}
+	return c
+}
+
+// profileDomain normalizes a host for domain profile matching, ignoring case
+// and a leading "www." the same way custom extractor lookup does
+func profileDomain(host string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(host)), "www.")
+}
+
+// mapSections converts the internal section list to the public ContentSection type
+func mapSections(sections []parser.ContentSection) []ContentSection {
+	if len(sections) == 0 {
+		return nil
+	}
+	mapped := make([]ContentSection, len(sections))
+	for i, section := range sections {
+		mapped[i] = ContentSection{Heading: section.Heading, HTML: section.HTML}
+	}
+	return mapped
+}
+
+// mapCandidateScores converts the internal candidate ranking to the public CandidateScore type
+func mapCandidateScores(scores []dom.CandidateScore) []CandidateScore {
+	if len(scores) == 0 {
+		return nil
+	}
+	mapped := make([]CandidateScore, len(scores))
+	for i, score := range scores {
+		mapped[i] = CandidateScore{Path: score.Path, Score: score.Score}
+	}
+	return mapped
+}
+
+// mapAuthors converts the internal credit list to the public AuthorInfo type
+func mapAuthors(credits []generic.AuthorInfo) []AuthorInfo {
+	if len(credits) == 0 {
+		return nil
+	}
+	mapped := make([]AuthorInfo, len(credits))
+	for i, credit := range credits {
+		mapped[i] = AuthorInfo{Name: credit.Name, Role: credit.Role}
+	}
+	return mapped
+}
+
+// mapGeo converts internal coordinates to the public GeoPoint type
+func mapGeo(point *generic.GeoPoint) *GeoPoint {
+	if point == nil {
+		return nil
+	}
+	return &GeoPoint{Latitude: point.Latitude, Longitude: point.Longitude}
+}
+
+// mapIcons converts the internal icon list to the public IconInfo type
+func mapIcons(icons []generic.IconInfo) []IconInfo {
+	if len(icons) == 0 {
+		return nil
+	}
+	mapped := make([]IconInfo, len(icons))
+	for i, icon := range icons {
+		mapped[i] = IconInfo{URL: icon.URL, Sizes: icon.Sizes, Type: icon.Type}
+	}
+	return mapped
+}
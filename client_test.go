@@ -77,6 +77,12 @@ func TestErrorTypes(t *testing.T) {
 		hermes.ErrSSRF,
 		hermes.ErrExtract,
 		hermes.ErrContext,
+		hermes.ErrNotModified,
+		hermes.ErrUnsupportedContentType,
+		hermes.ErrJavaScriptRequired,
+		hermes.ErrParse,
+		hermes.ErrNotArticle,
+		hermes.ErrTransform,
 	}
 
 	for _, code := range codes {
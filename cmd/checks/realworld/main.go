@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/BumpyClock/hermes/internal/parser"
+	"github.com/BumpyClock/hermes/internal/utils/text"
 )
 
 func main() {
@@ -64,8 +65,8 @@ func main() {
 		
 		// Display results
 		fmt.Printf("  ✅ Success (took %v)\n", duration)
-		fmt.Printf("     Title: %s\n", truncate(result.Title, 80))
-		fmt.Printf("     Author: %s\n", truncate(result.Author, 40))
+		fmt.Printf("     Title: %s\n", text.Truncate(result.Title, 80))
+		fmt.Printf("     Author: %s\n", text.Truncate(result.Author, 40))
 		fmt.Printf("     Domain: %s\n", result.Domain)
 		fmt.Printf("     Word Count: %d\n", result.WordCount)
 		fmt.Printf("     Content Length: %d chars\n", len(result.Content))
@@ -76,7 +77,7 @@ func main() {
 		
 		// Show first 200 chars of content
 		if len(result.Content) > 0 {
-			fmt.Printf("     Content Preview: %s...\n", truncate(stripHTML(result.Content), 150))
+			fmt.Printf("     Content Preview: %s...\n", text.Truncate(stripHTML(result.Content), 150))
 		}
 	}
 	
@@ -91,13 +92,6 @@ func main() {
 	}
 }
 
-func truncate(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
-	}
-	return s[:maxLen] + "..."
-}
-
 // Simple HTML tag stripper for preview
 func stripHTML(s string) string {
 	inTag := false
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/BumpyClock/hermes/internal/extractors/custom"
+	"github.com/spf13/cobra"
+)
+
+// newExtractorsCmd creates the extractors subcommand
+func newExtractorsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "extractors [filter]",
+		Short: "List sites with custom extractors",
+		Long: "Lists every domain with a built-in custom extractor and the fields it extracts. " +
+			"Sites not listed are handled by the generic extractor. An optional filter " +
+			"limits the list to domains containing that text.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: runExtractors,
+	}
+}
+
+func runExtractors(cmd *cobra.Command, args []string) error {
+	filter := ""
+	if len(args) > 0 {
+		filter = strings.ToLower(args[0])
+	}
+
+	var matched []*custom.CustomExtractor
+	for _, extractor := range custom.GetAllCustomExtractors() {
+		if filter == "" || extractorMatches(extractor, filter) {
+			matched = append(matched, extractor)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].Domain < matched[j].Domain
+	})
+
+	out := cmd.OutOrStdout()
+	if len(matched) == 0 {
+		fmt.Fprintf(out, "No custom extractors match %q\n", filter)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DOMAIN\tFIELDS\tALSO MATCHES")
+	for _, extractor := range matched {
+		fmt.Fprintf(w, "%s\t%s\t%s\n",
+			extractor.Domain,
+			strings.Join(extractorFields(extractor), ", "),
+			strings.Join(extractor.SupportedDomains, ", "))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "\n%d custom extractor(s)\n", len(matched))
+	return nil
+}
+
+// extractorMatches reports whether the extractor's domain or any supported domain contains filter
+func extractorMatches(extractor *custom.CustomExtractor, filter string) bool {
+	if strings.Contains(strings.ToLower(extractor.Domain), filter) {
+		return true
+	}
+	for _, domain := range extractor.SupportedDomains {
+		if strings.Contains(strings.ToLower(domain), filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// extractorFields lists the result fields an extractor has selectors for
+func extractorFields(extractor *custom.CustomExtractor) []string {
+	var fields []string
+	add := func(name string, field *custom.FieldExtractor) {
+		if field != nil && len(field.Selectors) > 0 {
+			fields = append(fields, name)
+		}
+	}
+
+	add("title", extractor.Title)
+	add("author", extractor.Author)
+	if extractor.Content != nil {
+		add("content", extractor.Content.FieldExtractor)
+	}
+	add("date_published", extractor.DatePublished)
+	add("lead_image_url", extractor.LeadImageURL)
+	add("dek", extractor.Dek)
+	add("next_page_url", extractor.NextPageURL)
+	add("excerpt", extractor.Excerpt)
+
+	extended := make([]string, 0, len(extractor.Extend))
+	for name := range extractor.Extend {
+		extended = append(extended, name)
+	}
+	sort.Strings(extended)
+	for _, name := range extended {
+		add(name, extractor.Extend[name])
+	}
+
+	return fields
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func runExtractorsCommand(t *testing.T, args ...string) string {
+	t.Helper()
+	cmd := newExtractorsCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("extractors command failed: %v", err)
+	}
+	return out.String()
+}
+
+func TestExtractorsCommand(t *testing.T) {
+	output := runExtractorsCommand(t)
+	for _, domain := range []string{"www.nytimes.com", "medium.com", "ma.ttias.be"} {
+		if !strings.Contains(output, domain) {
+			t.Errorf("Expected %s in output:\n%s", domain, output)
+		}
+	}
+	if !strings.Contains(output, "title, author, content") {
+		t.Errorf("Expected extracted fields in output:\n%s", output)
+	}
+}
+
+func TestExtractorsCommandFilter(t *testing.T) {
+	output := runExtractorsCommand(t, "NYTIMES")
+	if !strings.Contains(output, "www.nytimes.com") {
+		t.Errorf("Expected www.nytimes.com in filtered output:\n%s", output)
+	}
+	if strings.Contains(output, "medium.com") {
+		t.Errorf("Expected medium.com to be filtered out:\n%s", output)
+	}
+
+	output = runExtractorsCommand(t, "no-such-site.invalid")
+	if !strings.Contains(output, "No custom extractors match") {
+		t.Errorf("Expected no-match message, got:\n%s", output)
+	}
+}
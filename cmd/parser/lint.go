@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/BumpyClock/hermes/internal/extractors/custom"
+	"github.com/spf13/cobra"
+)
+
+var lintBuiltin bool
+
+// newLintExtractorCmd creates the lint-extractor subcommand
+func newLintExtractorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint-extractor [file.json...]",
+		Short: "Validate custom extractor definitions",
+		Long: "Checks custom extractor JSON definitions for missing fields, malformed selectors, " +
+			"invalid CSS and unknown transform tags before they are used at runtime",
+		RunE:          runLintExtractor,
+		SilenceUsage:  true, // lint problems are not usage errors
+		SilenceErrors: true, // main reports the error
+	}
+
+	cmd.Flags().BoolVar(&lintBuiltin, "builtin", false, "Also lint the built-in custom extractors")
+
+	return cmd
+}
+
+func runLintExtractor(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 && !lintBuiltin {
+		return fmt.Errorf("provide at least one extractor file or --builtin")
+	}
+
+	problems := 0
+	report := func(name string, errs []error) {
+		for _, err := range errs {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", name, err)
+		}
+		problems += len(errs)
+	}
+
+	for _, path := range args {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			report(path, []error{err})
+			continue
+		}
+		extractor, err := custom.ParseExtractorJSON(data)
+		if err != nil {
+			report(path, []error{err})
+			continue
+		}
+		report(path, custom.ValidateExtractor(extractor))
+	}
+
+	if lintBuiltin {
+		extractors := custom.GetAllCustomExtractors()
+		names := make([]string, 0, len(extractors))
+		for name := range extractors {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			report(name, custom.ValidateExtractor(extractors[name]))
+		}
+	}
+
+	if problems > 0 {
+		return fmt.Errorf("found %d problem(s)", problems)
+	}
+
+	fmt.Println("All extractors are valid")
+	return nil
+}
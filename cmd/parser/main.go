@@ -51,7 +51,7 @@ func main() {
 		},
 	}
 
-	rootCmd.AddCommand(parseCmd, versionCmd)
+	rootCmd.AddCommand(parseCmd, versionCmd, newLintExtractorCmd(), newExtractorsCmd())
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -70,34 +70,18 @@ func runParse(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Create hermes client with options
-	clientOptions := []hermes.Option{
-		hermes.WithTimeout(timeout),
-	}
-	
-	// Set content type based on output format for the parser
-	// This determines how the content is extracted, not just how it's formatted
+	client := hermes.New(hermes.WithTimeout(timeout))
+
+	// The content type determines how the content is extracted, not just how
+	// it's formatted, so it is set per request along with the custom headers
+	parseOptions := hermes.CallOptions{ContentType: "html", Headers: customHeaders}
 	switch outputFormat {
-	case "html":
-		clientOptions = append(clientOptions, hermes.WithContentType("html"))
-	case "markdown":
-		clientOptions = append(clientOptions, hermes.WithContentType("markdown"))
-	case "text":
-		clientOptions = append(clientOptions, hermes.WithContentType("text"))
-	default:
-		clientOptions = append(clientOptions, hermes.WithContentType("html"))
+	case "markdown", "text":
+		parseOptions.ContentType = outputFormat
 	}
-	
-	// Add custom headers if provided
-	if len(customHeaders) > 0 {
-		// TODO: Add header support to hermes.Option - for now we'll skip this
-		// Will add hermes.WithHeaders() in future enhancement
-	}
-	
-	client := hermes.New(clientOptions...)
 
 	// Use batch processing for concurrent parsing
-	results, err := batchParse(client, urls)
+	results, err := batchParse(client, urls, parseOptions)
 	if err != nil {
 		return err
 	}
@@ -150,7 +134,7 @@ type ParseResult struct {
 }
 
 // batchParse processes multiple URLs concurrently using semaphore pattern
-func batchParse(client *hermes.Client, urls []string) ([]ParseResult, error) {
+func batchParse(client *hermes.Client, urls []string, opts hermes.CallOptions) ([]ParseResult, error) {
 	results := make([]ParseResult, len(urls))
 	sem := make(chan struct{}, concurrency) // Semaphore for concurrency control
 	var wg sync.WaitGroup
@@ -168,7 +152,7 @@ func batchParse(client *hermes.Client, urls []string) ([]ParseResult, error) {
 			defer cancel()
 
 			start := time.Now()
-			result, err := client.Parse(ctx, u)
+			result, err := client.ParseWithOptions(ctx, u, opts)
 			parseTime := time.Since(start)
 
 			results[index] = ParseResult{
@@ -0,0 +1,106 @@
+package hermes_test
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+
+	"github.com/BumpyClock/hermes"
+)
+
+const compressedArticleHTML = `<html><head><title>Compressed Article</title></head><body>
+  <article>
+    <p>This article was served with a compressed body and must be decoded before it reaches the HTML parser.</p>
+    <p>If decompression is skipped, the parser sees binary garbage instead of these two readable paragraphs.</p>
+  </article>
+</body></html>`
+
+func compressBody(t *testing.T, encoding string, data []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	var writer io.WriteCloser
+	switch encoding {
+	case "gzip":
+		writer = gzip.NewWriter(&buf)
+	case "deflate":
+		writer, _ = flate.NewWriter(&buf, flate.DefaultCompression)
+	case "br":
+		writer = brotli.NewWriter(&buf)
+	default:
+		t.Fatalf("unknown encoding %q", encoding)
+	}
+
+	if _, err := writer.Write(data); err != nil {
+		t.Fatalf("compress: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("compress: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func newCompressedServer(t *testing.T, encoding string, data []byte) *httptest.Server {
+	t.Helper()
+
+	body := compressBody(t, encoding, data)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Encoding", encoding)
+		w.Write(body)
+	}))
+}
+
+func TestCompressedResponses(t *testing.T) {
+	for _, encoding := range []string{"gzip", "deflate", "br"} {
+		t.Run(encoding, func(t *testing.T) {
+			ts := newCompressedServer(t, encoding, []byte(compressedArticleHTML))
+			defer ts.Close()
+
+			// A transport with compression disabled never decodes bodies itself
+			httpClient := &http.Client{Transport: &http.Transport{DisableCompression: true}}
+			client := hermes.New(hermes.WithAllowPrivateNetworks(true), hermes.WithHTTPClient(httpClient))
+
+			result, err := client.Parse(context.Background(), ts.URL)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			if result.Title != "Compressed Article" {
+				t.Errorf("Expected decoded title, got %q", result.Title)
+			}
+			if !strings.Contains(result.Content, "must be decoded before it reaches the HTML parser") {
+				t.Errorf("Expected decoded content, got %q", result.Content)
+			}
+		})
+	}
+}
+
+func TestCompressedResponseSizeGuard(t *testing.T) {
+	// Highly compressible padding expands far beyond the configured limit
+	padding := strings.Repeat("<p>padding</p>", 20000)
+	ts := newCompressedServer(t, "gzip", []byte(compressedArticleHTML+padding))
+	defer ts.Close()
+
+	client := hermes.New(
+		hermes.WithAllowPrivateNetworks(true),
+		hermes.WithMaxContentLength(64*1024),
+	)
+
+	_, err := client.Parse(context.Background(), ts.URL)
+	var parseErr *hermes.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("Expected ParseError for oversized body, got %v", err)
+	}
+	if !strings.Contains(parseErr.Error(), "too large") {
+		t.Errorf("Expected size limit error, got %v", parseErr)
+	}
+}
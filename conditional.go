@@ -0,0 +1,63 @@
+package hermes
+
+import (
+	"sync"
+)
+
+// ConditionalEntry holds the HTTP cache validators and last result for a URL.
+type ConditionalEntry struct {
+	// ETag is the entity tag from the last successful response, sent as If-None-Match
+	ETag string
+
+	// LastModified is the Last-Modified header from the last successful response,
+	// sent as If-Modified-Since
+	LastModified string
+
+	// Result is the result parsed from the last successful response.
+	// It is returned again when the server answers 304 Not Modified.
+	Result *Result
+}
+
+// ConditionalStore persists ETag/Last-Modified validators per URL for conditional fetching.
+// Implementations must be safe for concurrent use by multiple goroutines.
+type ConditionalStore interface {
+	// Get returns the entry stored for url, if any
+	Get(url string) (ConditionalEntry, bool)
+
+	// Set stores the entry for url, replacing any previous entry
+	Set(url string, entry ConditionalEntry)
+}
+
+// MemoryConditionalStore is an in-memory ConditionalStore.
+// It is safe for concurrent use and never evicts entries.
+type MemoryConditionalStore struct {
+	mu      sync.RWMutex
+	entries map[string]ConditionalEntry
+}
+
+// NewMemoryConditionalStore creates an empty in-memory ConditionalStore
+func NewMemoryConditionalStore() *MemoryConditionalStore {
+	return &MemoryConditionalStore{
+		entries: make(map[string]ConditionalEntry),
+	}
+}
+
+// Get returns the entry stored for url, if any
+func (s *MemoryConditionalStore) Get(url string) (ConditionalEntry, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, ok := s.entries[url]
+	return entry, ok
+}
+
+// Set stores the entry for url, replacing any previous entry
+func (s *MemoryConditionalStore) Set(url string, entry ConditionalEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[url] = entry
+}
+
+// Ensure MemoryConditionalStore implements the ConditionalStore interface
+var _ ConditionalStore = (*MemoryConditionalStore)(nil)
@@ -0,0 +1,147 @@
+package hermes_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/BumpyClock/hermes"
+)
+
+func newConditionalServer(t *testing.T, etag, lastModified string) (*httptest.Server, *int32, *int32) {
+	t.Helper()
+
+	var requests, notModified int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		if (etag != "" && r.Header.Get("If-None-Match") == etag) ||
+			(lastModified != "" && r.Header.Get("If-Modified-Since") == lastModified) {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		if etag != "" {
+			w.Header().Set("ETag", etag)
+		}
+		if lastModified != "" {
+			w.Header().Set("Last-Modified", lastModified)
+		}
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><head><title>Polled Article</title></head><body><article><p>Article content that rarely changes but is polled frequently by the client.</p></article></body></html>`))
+	}))
+	return ts, &requests, &notModified
+}
+
+func TestWithConditionalFetchETag(t *testing.T) {
+	ts, requests, notModified := newConditionalServer(t, `"v1"`, "")
+	defer ts.Close()
+
+	store := hermes.NewMemoryConditionalStore()
+	client := hermes.New(hermes.WithAllowPrivateNetworks(true), hermes.WithConditionalFetch(store))
+	ctx := context.Background()
+
+	first, err := client.Parse(ctx, ts.URL)
+	if err != nil {
+		t.Fatalf("First parse failed: %v", err)
+	}
+
+	entry, ok := store.Get(ts.URL)
+	if !ok || entry.ETag != `"v1"` {
+		t.Fatalf("Expected ETag to be stored, got %+v", entry)
+	}
+
+	// Editing the returned result must not reach the stored copy
+	titleSource := first.FieldSources["title"]
+	if titleSource == "" {
+		t.Fatalf("Expected a title field source, got %v", first.FieldSources)
+	}
+	first.FieldSources["title"] = "changed"
+
+	second, err := client.Parse(ctx, ts.URL)
+	if err != nil {
+		t.Fatalf("Second parse failed: %v", err)
+	}
+
+	if atomic.LoadInt32(requests) != 2 {
+		t.Errorf("Expected 2 requests, got %d", atomic.LoadInt32(requests))
+	}
+	if atomic.LoadInt32(notModified) != 1 {
+		t.Errorf("Expected the second request to be answered with 304, got %d", atomic.LoadInt32(notModified))
+	}
+	if second.Title != first.Title || second.Content != first.Content {
+		t.Errorf("Expected previous result on 304, got title %q", second.Title)
+	}
+	if second.FieldSources["title"] != titleSource {
+		t.Errorf("Expected the stored result to be unaffected by edits, got %v", second.FieldSources)
+	}
+}
+
+func TestWithConditionalFetchLastModified(t *testing.T) {
+	lastModified := "Wed, 21 Oct 2015 07:28:00 GMT"
+	ts, _, notModified := newConditionalServer(t, "", lastModified)
+	defer ts.Close()
+
+	client := hermes.New(hermes.WithAllowPrivateNetworks(true), hermes.WithConditionalFetch(hermes.NewMemoryConditionalStore()))
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Parse(ctx, ts.URL); err != nil {
+			t.Fatalf("Parse %d failed: %v", i, err)
+		}
+	}
+
+	if atomic.LoadInt32(notModified) != 1 {
+		t.Errorf("Expected If-Modified-Since to produce a 304, got %d", atomic.LoadInt32(notModified))
+	}
+}
+
+func TestWithConditionalFetchAndAllowedContentTypes(t *testing.T) {
+	ts, _, notModified := newConditionalServer(t, `"v1"`, "")
+	defer ts.Close()
+
+	client := hermes.New(
+		hermes.WithAllowPrivateNetworks(true),
+		hermes.WithConditionalFetch(hermes.NewMemoryConditionalStore()),
+		hermes.WithAllowedContentTypes([]string{"text/html"}),
+	)
+	ctx := context.Background()
+
+	first, err := client.Parse(ctx, ts.URL)
+	if err != nil {
+		t.Fatalf("First parse failed: %v", err)
+	}
+
+	// A 304 carries no Content-Type, so the allowlist must not reject it
+	second, err := client.Parse(ctx, ts.URL)
+	if err != nil {
+		t.Fatalf("Expected the stored result on 304, got %v", err)
+	}
+	if atomic.LoadInt32(notModified) != 1 {
+		t.Errorf("Expected the second request to be answered with 304, got %d", atomic.LoadInt32(notModified))
+	}
+	if second.Title != first.Title {
+		t.Errorf("Expected previous result on 304, got title %q", second.Title)
+	}
+}
+
+func TestWithConditionalFetchNotModifiedWithoutResult(t *testing.T) {
+	ts, _, _ := newConditionalServer(t, `"v1"`, "")
+	defer ts.Close()
+
+	// Validators persisted elsewhere without the parsed result
+	store := hermes.NewMemoryConditionalStore()
+	store.Set(ts.URL, hermes.ConditionalEntry{ETag: `"v1"`})
+
+	client := hermes.New(hermes.WithAllowPrivateNetworks(true), hermes.WithConditionalFetch(store))
+	_, err := client.Parse(context.Background(), ts.URL)
+
+	var parseErr *hermes.ParseError
+	if !errors.As(err, &parseErr) || !parseErr.IsNotModified() {
+		t.Fatalf("Expected ErrNotModified, got %v", err)
+	}
+}
@@ -436,6 +436,48 @@ func TestSSRFProtectionNetworkErrors(t *testing.T) {
 	}
 }
 
+// TestMalformedHTMLHandling feeds broken markup through the full pipeline.
+// Each input must produce either a partial result or a typed ErrParse, never a panic.
+func TestMalformedHTMLHandling(t *testing.T) {
+	client := New(WithAllowPrivateNetworks(true))
+
+	inputs := map[string]string{
+		"unclosed tags":        `<html><head><title>Test</><body><p>Unclosed tags<div>More content`,
+		"stray closing tags":   `</html></body><p>Text, before the document, starts.</p></div></div><html><body><<<<>>>><p>Text, text, text, text.</p>`,
+		"unbalanced tables":    strings.Repeat("<table><tr><td><p>Cell text, commas, words</td>", 200),
+		"misnested formatting": strings.Repeat("<b><i><u><a href=x>", 300) + "Text, text, more text, words words",
+		"foreign content":      `<p class="` + strings.Repeat(`"a'`, 100) + `">text</p><svg><math><mi><mglyph><style><img src=x></style></math>`,
+		"null bytes":           "<p>\x00\x00 Text, with, commas</p><script>" + strings.Repeat("<", 1000),
+		"runaway nesting":      strings.Repeat("<div>", 5000) + "<p>Deep text, with commas, and more words.</p>",
+	}
+
+	for name, html := range inputs {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			result, err := client.ParseHTML(ctx, html, "http://127.0.0.1/malformed")
+			if err != nil {
+				var parseErr *ParseError
+				if !errors.As(err, &parseErr) || !parseErr.IsParse() {
+					t.Fatalf("Expected a partial result or ErrParse, got %v", err)
+				}
+				return
+			}
+			if result == nil {
+				t.Fatal("Expected a result when no error is returned")
+			}
+		})
+	}
+
+	// Runaway nesting is rejected up front rather than extracted slowly
+	_, err := client.ParseHTML(context.Background(), strings.Repeat("<div>", 5000)+"<p>Deep</p>", "http://127.0.0.1/deep")
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Code != ErrParse {
+		t.Errorf("Expected ErrParse for runaway nesting, got %v", err)
+	}
+}
+
 // TestErrorCodeValues tests that error codes have expected values
 func TestErrorCodeValues(t *testing.T) {
 	expectedCodes := map[ErrorCode]string{
@@ -445,6 +487,12 @@ func TestErrorCodeValues(t *testing.T) {
 		ErrSSRF:       "SSRF blocked",
 		ErrExtract:    "extraction error",
 		ErrContext:    "context cancelled",
+		ErrNotModified: "not modified",
+		ErrUnsupportedContentType: "unsupported content type",
+		ErrJavaScriptRequired: "JavaScript required",
+		ErrParse:              "parse error",
+		ErrNotArticle:         "not an article",
+		ErrTransform:          "transform error",
 	}
 
 	for code, expectedStr := range expectedCodes {
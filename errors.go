@@ -25,6 +25,30 @@ const (
 	
 	// ErrContext indicates the context was cancelled
 	ErrContext
+	
+	// ErrNotModified indicates a conditional request returned 304 Not Modified
+	// and no previous result was available to return instead
+	ErrNotModified
+	
+	// ErrUnsupportedContentType indicates the server returned a non-HTML
+	// content type such as application/pdf or image/*
+	ErrUnsupportedContentType
+	
+	// ErrJavaScriptRequired indicates the page is an empty single-page-app shell
+	// whose content is rendered by JavaScript; fetch it with a headless browser
+	ErrJavaScriptRequired
+	
+	// ErrParse indicates the HTML could not be turned into a usable document,
+	// e.g. pathologically deep nesting or an extractor failure on broken markup
+	ErrParse
+	
+	// ErrNotArticle indicates the page looks like a home page or listing rather
+	// than a single article; returned only with WithRejectNonArticles
+	ErrNotArticle
+	
+	// ErrTransform indicates a transformer added with WithResultTransformer
+	// returned an error
+	ErrTransform
 )
 
 // String returns a human-readable string for the error code
@@ -42,6 +66,18 @@ func (e ErrorCode) String() string {
 		return "extraction error"
 	case ErrContext:
 		return "context cancelled"
+	case ErrNotModified:
+		return "not modified"
+	case ErrUnsupportedContentType:
+		return "unsupported content type"
+	case ErrJavaScriptRequired:
+		return "JavaScript required"
+	case ErrParse:
+		return "parse error"
+	case ErrNotArticle:
+		return "not an article"
+	case ErrTransform:
+		return "transform error"
 	default:
 		return "unknown error"
 	}
@@ -113,4 +149,34 @@ func (e *ParseError) IsInvalidURL() bool {
 // IsContext returns true if the error was caused by context cancellation
 func (e *ParseError) IsContext() bool {
 	return e.Code == ErrContext
-}
\ No newline at end of file
+}
+
+// IsNotModified returns true if the resource was unchanged since the last conditional fetch
+func (e *ParseError) IsNotModified() bool {
+	return e.Code == ErrNotModified
+}
+
+// IsUnsupportedContentType returns true if the server returned a non-HTML content type
+func (e *ParseError) IsUnsupportedContentType() bool {
+	return e.Code == ErrUnsupportedContentType
+}
+
+// IsJavaScriptRequired returns true if the page needs JavaScript rendering to show its content
+func (e *ParseError) IsJavaScriptRequired() bool {
+	return e.Code == ErrJavaScriptRequired
+}
+
+// IsParse returns true if the HTML could not be parsed into a usable document
+func (e *ParseError) IsParse() bool {
+	return e.Code == ErrParse
+}
+
+// IsNotArticle returns true if the page was rejected as a home page or listing
+func (e *ParseError) IsNotArticle() bool {
+	return e.Code == ErrNotArticle
+}
+
+// IsTransform returns true if a result transformer rejected the result
+func (e *ParseError) IsTransform() bool {
+	return e.Code == ErrTransform
+}
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/BumpyClock/hermes"
+	"github.com/BumpyClock/hermes/internal/utils/text"
 )
 
 func main() {
@@ -69,7 +70,7 @@ func main() {
 
 // displayResult formats and displays the extracted content
 func displayResult(result *hermes.Result) {
-	fmt.Printf("📰 Title: %s\n", truncate(result.Title, 60))
+	fmt.Printf("📰 Title: %s\n", text.Truncate(result.Title, 60))
 	fmt.Printf("👤 Author: %s\n", result.Author)
 	fmt.Printf("🌐 Domain: %s\n", result.Domain)
 	fmt.Printf("📝 Word Count: %d\n", result.WordCount)
@@ -79,29 +80,18 @@ func displayResult(result *hermes.Result) {
 	}
 	
 	if result.LeadImageURL != "" {
-		fmt.Printf("🖼️  Lead Image: %s\n", truncate(result.LeadImageURL, 50))
+		fmt.Printf("🖼️  Lead Image: %s\n", text.Truncate(result.LeadImageURL, 50))
 	}
 	
 	if result.Description != "" {
-		fmt.Printf("📄 Description: %s\n", truncate(result.Description, 100))
+		fmt.Printf("📄 Description: %s\n", text.Truncate(result.Description, 100))
 	}
 	
 	if result.Content != "" {
-		fmt.Printf("📖 Content: %s...\n", truncate(result.Content, 200))
+		fmt.Printf("📖 Content: %s...\n", text.Truncate(result.Content, 200))
 	}
 
 	if result.Excerpt != "" {
-		fmt.Printf("✂️  Excerpt: %s\n", truncate(result.Excerpt, 150))
+		fmt.Printf("✂️  Excerpt: %s\n", text.Truncate(result.Excerpt, 150))
 	}
-}
-
-// truncate shortens a string to the specified length with ellipsis
-func truncate(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
-	}
-	if maxLen <= 3 {
-		return s[:maxLen]
-	}
-	return s[:maxLen-3] + "..."
 }
\ No newline at end of file
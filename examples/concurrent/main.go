@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/BumpyClock/hermes"
+	"github.com/BumpyClock/hermes/internal/utils/text"
 )
 
 // ProcessResult holds the result of processing a single URL
@@ -175,20 +176,9 @@ func displayResults(results []ProcessResult) {
 			}
 		} else {
 			r := result.Result
-			fmt.Printf("    ✅ Title: %s\n", truncate(r.Title, 50))
+			fmt.Printf("    ✅ Title: %s\n", text.Truncate(r.Title, 50))
 			fmt.Printf("    📝 Words: %d, Domain: %s\n", r.WordCount, r.Domain)
 		}
 		fmt.Println()
 	}
-}
-
-// truncate shortens a string to the specified length with ellipsis
-func truncate(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
-	}
-	if maxLen <= 3 {
-		return s[:maxLen]
-	}
-	return s[:maxLen-3] + "..."
 }
\ No newline at end of file
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/BumpyClock/hermes"
+	"github.com/BumpyClock/hermes/internal/utils/text"
 )
 
 func main() {
@@ -265,21 +266,10 @@ func parseAndDisplay(client *hermes.Client, testURL, clientName string) {
 	}
 
 	fmt.Printf("✅ Success! (took %v)\n", duration)
-	fmt.Printf("   Title: %s\n", truncate(result.Title, 50))
+	fmt.Printf("   Title: %s\n", text.Truncate(result.Title, 50))
 	fmt.Printf("   Domain: %s\n", result.Domain)
 	fmt.Printf("   Word Count: %d\n", result.WordCount)
 	if result.Content != "" {
-		fmt.Printf("   Content Preview: %s\n", truncate(result.Content, 80))
+		fmt.Printf("   Content Preview: %s\n", text.Truncate(result.Content, 80))
 	}
-}
-
-// truncate shortens a string to the specified length with ellipsis
-func truncate(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
-	}
-	if maxLen <= 3 {
-		return s[:maxLen]
-	}
-	return s[:maxLen-3] + "..."
 }
\ No newline at end of file
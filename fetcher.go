@@ -0,0 +1,88 @@
+// ABOUTME: Fetcher interface for plugging custom page sources into the parse pipeline
+// ABOUTME: HTTPFetcher is the plain HTTP implementation, validating the URL and every redirect hop against SSRF rules
+
+package hermes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/BumpyClock/hermes/internal/validation"
+)
+
+// Fetcher retrieves the HTML for a URL, decoupling fetching from parsing.
+// Implement it to source pages from a headless browser, a cache or a queue.
+// Implementations must be safe for concurrent use by multiple goroutines.
+type Fetcher interface {
+	// Fetch returns the page body, the URL the body was served from after any
+	// redirects, and the HTTP status code. The caller closes the body.
+	Fetch(ctx context.Context, url string, headers map[string]string) (body io.ReadCloser, finalURL string, statusCode int, err error)
+}
+
+// HTTPFetcher is a Fetcher backed by an http.Client with SSRF protection.
+// It is useful as a building block for fetchers that wrap plain HTTP
+// fetching, e.g. to add caching or logging.
+type HTTPFetcher struct {
+	client               *http.Client
+	allowPrivateNetworks bool
+	allowHosts           []string
+	denyHosts            []string
+}
+
+// NewHTTPFetcher creates an HTTPFetcher using httpClient, or a default client
+// when nil. Requests to private networks and localhost are refused unless
+// allowPrivateNetworks is set. Every redirect target is validated before it
+// is requested. The default client also pins each connection to the
+// validated addresses, defeating DNS rebinding; a caller-supplied client
+// keeps its own transport, which only gets that protection if it was built
+// by this package.
+func NewHTTPFetcher(httpClient *http.Client, allowPrivateNetworks bool) *HTTPFetcher {
+	if httpClient == nil {
+		httpClient = &http.Client{Transport: newTransport(nil)}
+	}
+	return &HTTPFetcher{
+		client:               validation.GuardRedirects(httpClient),
+		allowPrivateNetworks: allowPrivateNetworks,
+	}
+}
+
+// WithSSRFHosts returns a copy of the fetcher that also applies allow and
+// deny host lists, with the same meaning as WithSSRFAllowHosts and
+// WithSSRFDenyHosts
+func (f *HTTPFetcher) WithSSRFHosts(allow, deny []string) *HTTPFetcher {
+	copied := *f
+	copied.allowHosts = append([]string(nil), allow...)
+	copied.denyHosts = append([]string(nil), deny...)
+	return &copied
+}
+
+// Fetch performs a GET request for url with the given headers
+func (f *HTTPFetcher) Fetch(ctx context.Context, url string, headers map[string]string) (io.ReadCloser, string, int, error) {
+	opts := f.validationOptions()
+	if err := validation.ValidateURL(ctx, url, opts); err != nil {
+		return nil, "", 0, fmt.Errorf("URL validation failed: %w", err)
+	}
+	// The pinned dialer and the redirect check read the rules from the context
+	ctx = validation.ContextWithOptions(ctx, opts)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return resp.Body, resp.Request.URL.String(), resp.StatusCode, nil
+}
+
+// validationOptions applies the same SSRF rules as Client.Parse
+func (f *HTTPFetcher) validationOptions() validation.ValidationOptions {
+	return validation.SSRFOptions(f.allowPrivateNetworks, f.allowHosts, f.denyHosts)
+}
@@ -0,0 +1,194 @@
+package hermes_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/BumpyClock/hermes"
+)
+
+const fetcherArticleHTML = `<html><head><title>Rendered Article</title></head><body>
+  <article>
+    <p>This article was rendered by a headless browser before being handed to the parser for extraction.</p>
+    <p>The parser never talks to the network itself when a fetcher is configured on the client.</p>
+  </article>
+</body></html>`
+
+// stubFetcher returns canned responses and records the requests it receives
+type stubFetcher struct {
+	mu       sync.Mutex
+	body     string
+	finalURL string
+	status   int
+	err      error
+	urls     []string
+	headers  map[string]string
+}
+
+func (f *stubFetcher) Fetch(ctx context.Context, url string, headers map[string]string) (io.ReadCloser, string, int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.urls = append(f.urls, url)
+	f.headers = headers
+	if f.err != nil {
+		return nil, "", 0, f.err
+	}
+	return io.NopCloser(strings.NewReader(f.body)), f.finalURL, f.status, nil
+}
+
+func TestWithFetcher(t *testing.T) {
+	fetcher := &stubFetcher{body: fetcherArticleHTML, status: http.StatusOK}
+	client := hermes.New(
+		hermes.WithAllowPrivateNetworks(true),
+		hermes.WithUserAgent("StubAgent/1.0"),
+		hermes.WithFetcher(fetcher),
+	)
+
+	result, err := client.Parse(context.Background(), "http://127.0.0.1/rendered")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(fetcher.urls) != 1 || fetcher.urls[0] != "http://127.0.0.1/rendered" {
+		t.Errorf("Expected fetcher to be called once with the URL, got %v", fetcher.urls)
+	}
+	if fetcher.headers["User-Agent"] != "StubAgent/1.0" {
+		t.Errorf("Expected client headers to reach the fetcher, got %v", fetcher.headers)
+	}
+	if result.Title != "Rendered Article" {
+		t.Errorf("Expected title from fetched HTML, got %q", result.Title)
+	}
+	if !strings.Contains(result.Content, "rendered by a headless browser") {
+		t.Errorf("Expected content from fetched HTML, got %q", result.Content)
+	}
+}
+
+func TestWithFetcherFinalURL(t *testing.T) {
+	fetcher := &stubFetcher{
+		body:     fetcherArticleHTML,
+		finalURL: "http://127.0.0.1/news/2024/rendered",
+		status:   http.StatusOK,
+	}
+	client := hermes.New(hermes.WithAllowPrivateNetworks(true), hermes.WithFetcher(fetcher))
+
+	result, err := client.Parse(context.Background(), "http://127.0.0.1/short-link")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if result.URL != "http://127.0.0.1/news/2024/rendered" {
+		t.Errorf("Expected result URL to be the final URL, got %q", result.URL)
+	}
+}
+
+func TestWithFetcherErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		fetcher *stubFetcher
+		code    hermes.ErrorCode
+	}{
+		{
+			name:    "fetcher error",
+			fetcher: &stubFetcher{err: errors.New("browser crashed")},
+			code:    hermes.ErrFetch,
+		},
+		{
+			name:    "non-200 status",
+			fetcher: &stubFetcher{body: "<html><body>Not found</body></html>", status: http.StatusNotFound},
+			code:    hermes.ErrFetch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := hermes.New(hermes.WithAllowPrivateNetworks(true), hermes.WithFetcher(tt.fetcher))
+
+			_, err := client.Parse(context.Background(), "http://127.0.0.1/broken")
+			var parseErr *hermes.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Expected ParseError, got %v", err)
+			}
+			if parseErr.Code != tt.code {
+				t.Errorf("Expected code %v, got %v", tt.code, parseErr.Code)
+			}
+		})
+	}
+}
+
+func TestWithFetcherSSRF(t *testing.T) {
+	fetcher := &stubFetcher{body: fetcherArticleHTML, status: http.StatusOK}
+	client := hermes.New(hermes.WithFetcher(fetcher))
+
+	if _, err := client.Parse(context.Background(), "http://127.0.0.1/internal"); err == nil {
+		t.Fatal("Expected private network URL to be rejected")
+	}
+	if len(fetcher.urls) != 0 {
+		t.Errorf("Expected fetcher not to be called for a blocked URL, got %v", fetcher.urls)
+	}
+}
+
+func TestHTTPFetcher(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/old" {
+			http.Redirect(w, r, "/new", http.StatusMovedPermanently)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(r.Header.Get("X-Test")))
+	}))
+	defer ts.Close()
+
+	fetcher := hermes.NewHTTPFetcher(ts.Client(), true)
+	body, finalURL, status, err := fetcher.Fetch(context.Background(), ts.URL+"/old", map[string]string{"X-Test": "hello"})
+	if err != nil {
+		t.Fatalf("Fetch failed: %v", err)
+	}
+	defer body.Close()
+
+	data, _ := io.ReadAll(body)
+	if string(data) != "hello" || status != http.StatusOK || finalURL != ts.URL+"/new" {
+		t.Errorf("Unexpected response: body=%q status=%d finalURL=%q", data, status, finalURL)
+	}
+
+	// Private networks are refused by default
+	blocked := hermes.NewHTTPFetcher(ts.Client(), false)
+	if _, _, _, err := blocked.Fetch(context.Background(), ts.URL, nil); err == nil {
+		t.Error("Expected localhost URL to be rejected")
+	}
+}
+
+func TestHTTPFetcherValidatesRedirectsBeforeFollowing(t *testing.T) {
+	var targetHit int32
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.StoreInt32(&targetHit, 1)
+	}))
+	defer target.Close()
+
+	// Same loopback address, but reached by the denied name "localhost"
+	deniedURL := strings.Replace(target.URL, "127.0.0.1", "localhost", 1) + "/metadata"
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, deniedURL, http.StatusFound)
+	}))
+	defer origin.Close()
+
+	for name, fetcher := range map[string]*hermes.HTTPFetcher{
+		"default client":  hermes.NewHTTPFetcher(nil, true).WithSSRFHosts(nil, []string{"localhost"}),
+		"supplied client": hermes.NewHTTPFetcher(origin.Client(), true).WithSSRFHosts(nil, []string{"localhost"}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, _, _, err := fetcher.Fetch(context.Background(), origin.URL, nil); err == nil {
+				t.Error("Expected a redirect to a denied host to fail")
+			}
+			if atomic.LoadInt32(&targetHit) != 0 {
+				t.Error("Expected the denied redirect target never to be requested")
+			}
+		})
+	}
+}
@@ -2,11 +2,22 @@ package hermes
 
 import (
 	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/BumpyClock/hermes/internal/extractors/custom"
 )
 
 // Helper function to check if content contains substring
@@ -199,4 +210,2670 @@ func TestAllowPrivateNetworks(t *testing.T) {
 	if result.Title != "Private Network" {
 		t.Errorf("Expected title 'Private Network', got '%s'", result.Title)
 	}
-}
\ No newline at end of file
+}
+
+// TestExpandTruncated verifies that "continue reading" links are followed to the full article
+func TestExpandTruncated(t *testing.T) {
+	// Written from the server's handler goroutines and read by the test
+	var fullRequested, relatedRequested atomic.Bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		switch {
+		case r.URL.Path == "/story" && r.URL.Query().Get("page") == "full":
+			fullRequested.Store(true)
+			w.Write([]byte(`<html><head><title>Truncated Story</title></head><body>
+  <article>
+    <h1>Truncated Story</h1>
+    <p>The opening paragraph of the story introduces the topic and sets up the rest of the article for the reader.</p>
+    <p>The second paragraph continues with the full body that only appears on the expanded page of this article.</p>
+    <p>A third paragraph adds even more detail so that the full version is clearly longer than the short teaser.</p>
+  </article>
+</body></html>`))
+		case r.URL.Path == "/story":
+			w.Write([]byte(`<html><head><title>Truncated Story</title></head><body>
+  <article>
+    <h1>Truncated Story</h1>
+    <p>The opening paragraph of the story introduces the topic and sets up the rest of the article for the reader.</p>
+    <p><a href="/related-story">Read more</a></p>
+    <p><a href="/story?page=full">Continue reading →</a></p>
+  </article>
+</body></html>`))
+		default:
+			relatedRequested.Store(true)
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	// Without the option only the teaser is extracted
+	teaser, err := New(WithAllowPrivateNetworks(true)).Parse(ctx, ts.URL+"/story")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if contains(teaser.Content, "expanded page") {
+		t.Error("Expected teaser content without WithExpandTruncated")
+	}
+	if fullRequested.Load() {
+		t.Error("Full page should not be fetched without WithExpandTruncated")
+	}
+
+	client := New(WithAllowPrivateNetworks(true), WithExpandTruncated(true))
+	result, err := client.Parse(ctx, ts.URL+"/story")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if !fullRequested.Load() {
+		t.Fatal("Expected the continue reading link to be followed")
+	}
+	if relatedRequested.Load() {
+		t.Error("Related article link should not be followed")
+	}
+	if !contains(result.Content, "expanded page") {
+		t.Errorf("Expected full article content, got: %s", result.Content)
+	}
+	if result.WordCount <= teaser.WordCount {
+		t.Errorf("Expected word count above teaser's %d, got %d", teaser.WordCount, result.WordCount)
+	}
+	if result.URL != ts.URL+"/story" {
+		t.Errorf("Expected result URL to remain the original URL, got %s", result.URL)
+	}
+}
+
+// TestPageTimeout verifies that a stalled follow-on page is abandoned without failing the parse
+func TestPageTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		if r.URL.Query().Get("page") == "full" {
+			// Stall until the client gives up
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		w.Write([]byte(`<html><head><title>Slow Story</title></head><body>
+  <article>
+    <h1>Slow Story</h1>
+    <p>The opening paragraph of the story introduces the topic and sets up the rest of the article for the reader.</p>
+    <p><a href="/story?page=full">Continue reading →</a></p>
+  </article>
+</body></html>`))
+	}))
+	defer ts.Close()
+
+	client := New(
+		WithAllowPrivateNetworks(true),
+		WithExpandTruncated(true),
+		WithTimeout(10*time.Second),
+		WithPageTimeout(200*time.Millisecond),
+	)
+
+	start := time.Now()
+	result, err := client.Parse(context.Background(), ts.URL+"/story")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Errorf("Expected the stalled page to be abandoned, parse took %v", elapsed)
+	}
+	if result.Title != "Slow Story" || !contains(result.Content, "opening paragraph") {
+		t.Errorf("Expected the teaser to be returned, got title %q content %q", result.Title, result.Content)
+	}
+}
+
+func TestKeepSafeStyles(t *testing.T) {
+	html := `<html><head><title>Styled Article</title></head><body>
+  <article>
+    <h1>Styled Article</h1>
+    <p style="text-align:center">A centered pull quote that the author wanted readers to notice in the middle of the page.</p>
+    <p style="background:url(javascript:alert(1))">A paragraph with a hostile background declaration that must never survive extraction.</p>
+    <p>A plain paragraph with enough words to make this article look like real content to the extractor.</p>
+  </article>
+</body></html>`
+	ctx := context.Background()
+	articleURL := "http://127.0.0.1/styled"
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(ctx, html, articleURL)
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if contains(result.Content, "style=") {
+		t.Errorf("Expected styles to be stripped by default, got: %s", result.Content)
+	}
+
+	result, err = New(WithAllowPrivateNetworks(true), WithKeepSafeStyles(true)).ParseHTML(ctx, html, articleURL)
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if !contains(result.Content, `style="text-align: center"`) {
+		t.Errorf("Expected text-align to survive, got: %s", result.Content)
+	}
+	if contains(result.Content, "javascript:") || contains(result.Content, "background:") {
+		t.Errorf("Expected unsafe styles to be removed, got: %s", result.Content)
+	}
+}
+
+func TestBreadcrumbs(t *testing.T) {
+	html := `<html><head>
+  <title>Chip Makers Race Ahead</title>
+  <script type="application/ld+json">
+  {"@context": "https://schema.org", "@type": "BreadcrumbList", "itemListElement": [
+    {"@type": "ListItem", "position": 1, "name": "News"},
+    {"@type": "ListItem", "position": 2, "name": "Technology"},
+    {"@type": "ListItem", "position": 3, "name": "Chip Makers Race Ahead"}
+  ]}
+  </script>
+</head><body>
+  <article>
+    <h1>Chip Makers Race Ahead</h1>
+    <p>Chip makers are racing ahead with new designs that promise better performance and lower power use.</p>
+    <p>Analysts expect the new generation of processors to ship in volume before the end of the year.</p>
+  </article>
+</body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/chips")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	expected := []string{"News", "Technology"}
+	if strings.Join(result.Breadcrumbs, "|") != strings.Join(expected, "|") {
+		t.Errorf("Expected breadcrumbs %q without the self crumb, got %q", expected, result.Breadcrumbs)
+	}
+}
+
+func TestBreadcrumbsMicrodataMeta(t *testing.T) {
+	// Names and positions in meta tags, listed out of order
+	html := `<html><head><title>Summer Reading</title></head><body>
+  <ol itemscope itemtype="https://schema.org/BreadcrumbList">
+    <li itemprop="itemListElement" itemscope itemtype="https://schema.org/ListItem">
+      <a itemprop="item" href="/books"><span>Browse</span></a>
+      <meta itemprop="name" content="Books"><meta itemprop="position" content="2">
+    </li>
+    <li itemprop="itemListElement" itemscope itemtype="https://schema.org/ListItem">
+      <a itemprop="item" href="/"><span>Start</span></a>
+      <meta itemprop="name" content="Home"><meta itemprop="position" content="1">
+    </li>
+  </ol>
+  <article>
+    <h1>Summer Reading</h1>
+    <p>Our critics pick the novels, memoirs and histories worth packing for the long days of summer.</p>
+    <p>Each recommendation comes with a short review and a note on who is likely to enjoy it most.</p>
+  </article>
+</body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/books/summer")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	expected := []string{"Home", "Books"}
+	if strings.Join(result.Breadcrumbs, "|") != strings.Join(expected, "|") {
+		t.Errorf("Expected breadcrumbs %q from microdata meta tags, got %q", expected, result.Breadcrumbs)
+	}
+}
+
+func TestContentTypeNegotiation(t *testing.T) {
+	var acceptHeaders []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptHeaders = append(acceptHeaders, r.Header.Get("Accept"))
+		switch r.URL.Path {
+		case "/report.pdf":
+			w.Header().Set("Content-Type", "application/pdf")
+			w.Write([]byte("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n1 0 obj\n<< /Type /Catalog >>\nendobj"))
+		default:
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Write([]byte(`<html><head><title>HTML Article</title></head><body><article><p>An ordinary HTML article that should parse without any trouble at all.</p></article></body></html>`))
+		}
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	client := New(WithAllowPrivateNetworks(true))
+
+	result, err := client.Parse(ctx, ts.URL+"/article")
+	if err != nil {
+		t.Fatalf("Parse failed for HTML response: %v", err)
+	}
+	if result.Title != "HTML Article" {
+		t.Errorf("Expected title 'HTML Article', got %q", result.Title)
+	}
+	if acceptHeaders[0] != "text/html,application/xhtml+xml" {
+		t.Errorf("Expected default Accept header, got %q", acceptHeaders[0])
+	}
+
+	_, err = client.Parse(ctx, ts.URL+"/report.pdf")
+	parseErr, ok := err.(*ParseError)
+	if !ok {
+		t.Fatalf("Expected *ParseError for PDF response, got %v", err)
+	}
+	if !parseErr.IsUnsupportedContentType() {
+		t.Errorf("Expected ErrUnsupportedContentType, got %v", parseErr.Code)
+	}
+
+	custom := New(WithAllowPrivateNetworks(true), WithAcceptHeader("text/html"))
+	if _, err := custom.Parse(ctx, ts.URL+"/article"); err != nil {
+		t.Fatalf("Parse failed with custom Accept header: %v", err)
+	}
+	if last := acceptHeaders[len(acceptHeaders)-1]; last != "text/html" {
+		t.Errorf("Expected overridden Accept header, got %q", last)
+	}
+}
+
+func TestPDFSupport(t *testing.T) {
+	fixture, err := os.ReadFile("internal/fixtures/sample.pdf")
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		if r.URL.Path == "/broken.pdf" {
+			w.Write([]byte("%PDF-1.4\ntrailer\n<< /Root 1 0 R /Encrypt 2 0 R >>\n%%EOF"))
+			return
+		}
+		w.Write(fixture)
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+
+	// Without the option PDFs are rejected
+	_, err = New(WithAllowPrivateNetworks(true)).Parse(ctx, ts.URL+"/report.pdf")
+	if parseErr, ok := err.(*ParseError); !ok || !parseErr.IsUnsupportedContentType() {
+		t.Fatalf("Expected ErrUnsupportedContentType without WithPDFSupport, got %v", err)
+	}
+
+	client := New(WithAllowPrivateNetworks(true), WithPDFSupport(true))
+	result, err := client.Parse(ctx, ts.URL+"/report.pdf")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if result.ExtractorUsed != "pdf" {
+		t.Errorf("Expected ExtractorUsed 'pdf', got %q", result.ExtractorUsed)
+	}
+	if result.Title != "Quarterly Report Q3" {
+		t.Errorf("Expected title from PDF metadata, got %q", result.Title)
+	}
+	if !contains(result.Content, "Revenue grew steadily") {
+		t.Errorf("Expected PDF body text in content, got: %s", result.Content)
+	}
+	if result.WordCount == 0 {
+		t.Error("Expected a word count for PDF content")
+	}
+
+	_, err = client.Parse(ctx, ts.URL+"/broken.pdf")
+	if parseErr, ok := err.(*ParseError); !ok || !parseErr.IsUnsupportedContentType() {
+		t.Errorf("Expected ErrUnsupportedContentType for encrypted PDF, got %v", err)
+	}
+}
+
+func TestBrSeparatedParagraphs(t *testing.T) {
+	html := `<html><head><title>One Big Paragraph</title></head><body>
+  <article>
+    <p>The first paragraph of a story published by a CMS that uses line breaks for everything.<br><br>The second paragraph follows after a double break and keeps going for a while.<br>It wraps once here.<br><br>The third paragraph closes the story with a few final words for the reader.</p>
+  </article>
+</body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/cms")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	if got := strings.Count(result.Content, "<p>"); got != 3 {
+		t.Errorf("Expected 3 paragraphs, got %d: %s", got, result.Content)
+	}
+	if contains(result.Content, "<br") {
+		t.Errorf("Expected single br to collapse into a space, got: %s", result.Content)
+	}
+}
+
+func TestLocaleDateParsing(t *testing.T) {
+	html := `<html><head><title>Local News</title></head><body>
+  <article>
+    <span class="entry-date">02/03/2024</span>
+    <p>The council met on Saturday to discuss the new library opening hours and the budget for the coming year.</p>
+    <p>Residents raised concerns about parking near the high street and asked for more frequent bus services.</p>
+  </article>
+</body></html>`
+
+	tests := []struct {
+		name     string
+		opts     []Option
+		expected string
+	}{
+		{"default US ordering", nil, "2024-02-03"},
+		{"British locale", []Option{WithLocale("en-GB")}, "2024-03-02"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := New(append(tt.opts, WithAllowPrivateNetworks(true))...)
+			result, err := client.ParseHTML(context.Background(), html, "http://127.0.0.1/news")
+			if err != nil {
+				t.Fatalf("ParseHTML failed: %v", err)
+			}
+			if result.DatePublished == nil {
+				t.Fatal("Expected a publication date")
+			}
+			if got := result.DatePublished.Format("2006-01-02"); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSummary(t *testing.T) {
+	html := `<html><head><title>Solar Plan Approved</title></head><body>
+  <article>
+    <p>The city council approved a new solar energy plan on Tuesday after months of debate.</p>
+    <p>Under the plan, solar panels will be installed on every public school and library in the city by 2027. Officials expect the solar energy program to cut electricity costs for public buildings by a third.</p>
+    <p>The weather on Tuesday was mild and sunny. Several residents attended the meeting in person.</p>
+    <p>Critics argued the solar plan was too expensive, but supporters said the energy savings would repay the cost within ten years. The council will review progress on the solar energy rollout every six months.</p>
+  </article>
+</body></html>`
+
+	for _, contentType := range []string{"html", "markdown", "text"} {
+		t.Run(contentType, func(t *testing.T) {
+			client := New(WithAllowPrivateNetworks(true), WithContentType(contentType), WithSummary(2))
+			result, err := client.ParseHTML(context.Background(), html, "http://127.0.0.1/solar")
+			if err != nil {
+				t.Fatalf("ParseHTML failed: %v", err)
+			}
+
+			if !strings.HasPrefix(result.Summary, "The city council approved a new solar energy plan") {
+				t.Errorf("Expected summary to lead with the highest-signal sentence, got %q", result.Summary)
+			}
+			if got := strings.Count(result.Summary, ". "); got != 1 {
+				t.Errorf("Expected a 2 sentence summary, got %q", result.Summary)
+			}
+			if contains(result.Summary, "<") {
+				t.Errorf("Expected plain text summary, got %q", result.Summary)
+			}
+		})
+	}
+
+	t.Run("short content falls back to excerpt", func(t *testing.T) {
+		short := `<html><head><title>Brief</title></head><body><article>
+    <p>The library will open an hour earlier on weekdays starting next month, giving students more time to study before classes begin.</p>
+    <p>Weekend opening hours are not changing and remain the same as they have been for the last several years.</p>
+  </article></body></html>`
+		result, err := New(WithAllowPrivateNetworks(true), WithSummary(3)).ParseHTML(context.Background(), short, "http://127.0.0.1/short")
+		if err != nil {
+			t.Fatalf("ParseHTML failed: %v", err)
+		}
+		if result.Summary == "" || result.Summary != result.Excerpt {
+			t.Errorf("Expected excerpt fallback %q, got %q", result.Excerpt, result.Summary)
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/solar")
+		if err != nil {
+			t.Fatalf("ParseHTML failed: %v", err)
+		}
+		if result.Summary != "" {
+			t.Errorf("Expected no summary without WithSummary, got %q", result.Summary)
+		}
+	})
+}
+
+func TestTextContentParagraphs(t *testing.T) {
+	html := `<html><head><title>Three Paragraphs</title></head><body>
+  <article>
+    <p>The first paragraph introduces the story and sets the scene for everything that follows below.</p>
+    <p>The second paragraph adds detail, quoting a   local official about the decision and its impact.</p>
+    <p>The third paragraph wraps up the story with a look at what happens next for the town.</p>
+  </article>
+</body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true), WithContentType("text")).ParseHTML(context.Background(), html, "http://127.0.0.1/story")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	blocks := strings.Split(result.Content, "\n\n")
+	if len(blocks) != 3 {
+		t.Fatalf("Expected 3 blocks separated by blank lines, got %d: %q", len(blocks), result.Content)
+	}
+	for _, block := range blocks {
+		if strings.Contains(block, "\n") || strings.Contains(block, "  ") {
+			t.Errorf("Expected normalized single-line block, got %q", block)
+		}
+	}
+	if !strings.HasPrefix(blocks[0], "The first paragraph") || !strings.HasPrefix(blocks[2], "The third paragraph") {
+		t.Errorf("Expected paragraphs in order, got %q", result.Content)
+	}
+}
+
+func TestTextContentPreservesCodeIndentation(t *testing.T) {
+	html := `<html><head><title>Python Tips</title></head><body>
+  <article>
+    <p>Guard clauses keep     functions flat and readable, so      the happy path stays at the left margin of the code.</p>
+    <pre><code>def greet(name):
+    if not name:
+        return "Hello, stranger"
+    return "Hello, " + name
+</code></pre>
+    <p>Each early return handles one edge case, which makes the    remaining logic easier to follow for future readers.</p>
+  </article>
+</body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true), WithContentType("text")).ParseHTML(context.Background(), html, "http://127.0.0.1/tips")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	snippet := "def greet(name):\n    if not name:\n        return \"Hello, stranger\"\n    return \"Hello, \" + name"
+	if !strings.Contains(result.Content, snippet) {
+		t.Errorf("Expected code indentation to survive, got %q", result.Content)
+	}
+	if !strings.Contains(result.Content, "Guard clauses keep functions flat and readable, so the happy path") {
+		t.Errorf("Expected paragraph whitespace to be collapsed, got %q", result.Content)
+	}
+	if strings.Contains(result.Content, "the    remaining") {
+		t.Errorf("Expected paragraph whitespace to be collapsed, got %q", result.Content)
+	}
+}
+
+func TestJavaScriptShellDetection(t *testing.T) {
+	reactShell := `<!DOCTYPE html><html><head>
+  <meta charset="utf-8">
+  <title>My App</title>
+  <link rel="stylesheet" href="/static/css/main.css">
+</head><body>
+  <noscript>You need to enable JavaScript to run this app.</noscript>
+  <div id="root"></div>
+  <script src="/static/js/bundle.js"></script>
+</body></html>`
+
+	t.Run("React shell returns ErrJavaScriptRequired", func(t *testing.T) {
+		_, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), reactShell, "http://127.0.0.1/app")
+
+		parseErr, ok := err.(*ParseError)
+		if !ok {
+			t.Fatalf("Expected ParseError, got %v", err)
+		}
+		if !parseErr.IsJavaScriptRequired() {
+			t.Errorf("Expected ErrJavaScriptRequired, got %v", parseErr.Code)
+		}
+	})
+
+	t.Run("noscript notice without a framework root", func(t *testing.T) {
+		shell := `<html><head><title>Loading</title></head><body>
+  <noscript><p>This site requires JavaScript. Please enable JavaScript in your browser.</p></noscript>
+  <div class="loader">Loading...</div>
+</body></html>`
+
+		_, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), shell, "http://127.0.0.1/loading")
+		if parseErr, ok := err.(*ParseError); !ok || parseErr.Code != ErrJavaScriptRequired {
+			t.Errorf("Expected ErrJavaScriptRequired, got %v", err)
+		}
+	})
+
+	t.Run("server-rendered SPA is parsed normally", func(t *testing.T) {
+		rendered := `<html><head><title>Rendered Story</title></head><body>
+  <noscript>You need to enable JavaScript to run this app.</noscript>
+  <div id="__next">
+    <article>
+      <h1>Rendered Story</h1>
+      <p>This page is built with a client-side framework but renders its content on the server, so every reader and crawler gets the full text of the article without running any scripts at all.</p>
+      <p>A second paragraph adds more detail about the story so there is plenty of readable content available for extraction by the parser and nothing is hidden behind JavaScript rendering.</p>
+    </article>
+  </div>
+</body></html>`
+
+		result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), rendered, "http://127.0.0.1/story")
+		if err != nil {
+			t.Fatalf("Expected content-rich page to parse, got %v", err)
+		}
+		if !contains(result.Content, "renders its content on the server") {
+			t.Errorf("Expected article content, got %q", result.Content)
+		}
+	})
+}
+
+func TestVideos(t *testing.T) {
+	html := `<html><head><title>Launch Day</title></head><body>
+  <article>
+    <p>The team showed off the new rocket during a livestream that drew a record audience from around the world.</p>
+    <iframe src="https://www.youtube.com/embed/dQw4w9WgXcQ?rel=0" width="560" height="315"></iframe>
+    <p>A behind-the-scenes documentary about the build process was released on the same day for supporters.</p>
+    <iframe src="https://player.vimeo.com/video/76979871"></iframe>
+    <p>Footage of the final engine test is also available directly from the mission website for download.</p>
+    <video controls><source src="/media/engine-test.mp4" type="video/mp4"></video>
+  </article>
+</body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/launch")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	expected := []string{
+		"https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+		"https://vimeo.com/76979871",
+		"http://127.0.0.1/media/engine-test.mp4",
+	}
+	if strings.Join(result.Videos, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected videos %q, got %q", expected, result.Videos)
+	}
+
+	// The embeds stay in the content as well
+	if !contains(result.Content, `<iframe src="https://www.youtube.com/embed/dQw4w9WgXcQ?rel=0"`) {
+		t.Errorf("Expected YouTube iframe to be kept in content, got %s", result.Content)
+	}
+	if !contains(result.Content, `<iframe src="https://player.vimeo.com/video/76979871"`) {
+		t.Errorf("Expected Vimeo iframe to be kept in content, got %s", result.Content)
+	}
+}
+
+func TestCommentSectionsAndCount(t *testing.T) {
+	html := `<html><head><title>Budget Vote</title>
+<script type="application/ld+json">{"@type":"NewsArticle","headline":"Budget Vote","commentCount":"214"}</script>
+</head><body>
+  <article class="article-content">
+    <p>The council approved the new budget after a long evening session that ran well past midnight on Tuesday.</p>
+    <p>Members debated the proposed cuts to library hours before agreeing on a compromise that keeps branches open.</p>
+    <p>The final vote was seven to two, with both dissenting members citing concerns about the transit allocation.</p>
+    <div class="article-comments content">
+      <p>Reader comment: this compromise is the best outcome anyone could have hoped for, honestly and truly.</p>
+      <p>Reader comment: the transit allocation is far too small for a growing city, and everyone knows it.</p>
+    </div>
+  </article>
+</body></html>`
+
+	client := New(WithAllowPrivateNetworks(true))
+	result, err := client.ParseHTML(context.Background(), html, "http://127.0.0.1/budget")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	if !contains(result.Content, "approved the new budget") {
+		t.Errorf("Expected article content, got %s", result.Content)
+	}
+	if contains(result.Content, "Reader comment") {
+		t.Errorf("Expected comment section to be removed, got %s", result.Content)
+	}
+	if result.CommentCount != 214 {
+		t.Errorf("Expected comment count 214, got %d", result.CommentCount)
+	}
+
+	// Pages without a count report -1 rather than zero
+	result, err = client.ParseHTML(context.Background(), `<html><head><title>Quiet</title></head><body><article>
+    <p>An article that does not expose any comment widgets, counts or structured data about its discussion.</p>
+  </article></body></html>`, "http://127.0.0.1/quiet")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.CommentCount != -1 {
+		t.Errorf("Expected unknown comment count -1, got %d", result.CommentCount)
+	}
+
+	// Microdata meta tags are normalized before extraction
+	result, err = client.ParseHTML(context.Background(), `<html><head><title>Counted</title></head><body><article>
+    <meta itemprop="commentCount" content="37">
+    <p>An article that declares its comment count in a microdata meta tag rather than in a visible widget.</p>
+  </article></body></html>`, "http://127.0.0.1/counted")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.CommentCount != 37 {
+		t.Errorf("Expected comment count 37 from microdata meta, got %d", result.CommentCount)
+	}
+}
+
+func TestPaywallDetection(t *testing.T) {
+	client := New(WithAllowPrivateNetworks(true))
+
+	tests := []struct {
+		name     string
+		html     string
+		expected bool
+	}{
+		{
+			name: "JSON-LD flagged",
+			html: `<html><head><title>Rates</title>
+<script type="application/ld+json">{"@type":"NewsArticle","headline":"Rates","isAccessibleForFree":false}</script>
+</head><body><article><p>The central bank held rates steady on Wednesday, citing slowing inflation and a cooling labor market.</p></article></body></html>`,
+			expected: true,
+		},
+		{
+			name: "content tier locked",
+			html: `<html><head><title>Rates</title>
+<meta property="article:content_tier" content="locked">
+</head><body><article><p>The central bank held rates steady on Wednesday, citing slowing inflation and a cooling labor market.</p></article></body></html>`,
+			expected: true,
+		},
+		{
+			name: "paywall overlay",
+			html: `<html><head><title>Rates</title></head><body>
+<article><p>The central bank held rates steady on Wednesday, citing slowing inflation and a cooling labor market.</p></article>
+<div id="paywall" class="paywall-overlay"><p>This article is for subscribers. Start your trial today.</p></div>
+</body></html>`,
+			expected: true,
+		},
+		{
+			name: "free article with subscribe prompt",
+			html: `<html><head><title>Rates</title></head><body>
+<article><p>The central bank held rates steady on Wednesday, citing slowing inflation and a cooling labor market.</p>
+<p>Officials said further cuts would depend on data over the coming months, and markets rallied on the news.</p></article>
+<div class="newsletter-signup"><p>Subscribe to our newsletter for the morning briefing.</p></div>
+</body></html>`,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := client.ParseHTML(context.Background(), tt.html, "http://127.0.0.1/rates")
+			if err != nil {
+				t.Fatalf("ParseHTML failed: %v", err)
+			}
+			if result.Paywalled != tt.expected {
+				t.Errorf("Expected Paywalled %v, got %v", tt.expected, result.Paywalled)
+			}
+		})
+	}
+}
+
+func TestStripTrackingParams(t *testing.T) {
+	html := `<html><head><title>Rates</title></head><body><article>
+<p>The central bank held rates steady on Wednesday, citing slowing inflation and a cooling labor market across the region.</p>
+<p>Read the <a href="https://example.com/statement?utm_source=newsletter&utm_medium=email&id=42">full statement</a> from the board, which runs to several pages.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true), WithContentType("markdown"), WithStripTrackingParams(true)).
+		ParseHTML(context.Background(), html, "http://127.0.0.1/rates")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if !contains(result.Content, "(https://example.com/statement?id=42)") || contains(result.Content, "utm_") {
+		t.Errorf("Expected tracking params to be stripped, got %s", result.Content)
+	}
+
+	// Off by default
+	result, err = New(WithAllowPrivateNetworks(true), WithContentType("markdown")).
+		ParseHTML(context.Background(), html, "http://127.0.0.1/rates")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if !contains(result.Content, "utm_source=newsletter") {
+		t.Errorf("Expected tracking params to be preserved, got %s", result.Content)
+	}
+}
+
+func TestTablesExtraction(t *testing.T) {
+	html := `<html><head><title>League Table</title></head><body><article>
+<p>The Rovers finished the season top of the league after a late run of wins that surprised most of their rivals.</p>
+<p>Their defence conceded only four goals all season, the fewest in the division since records began decades ago.</p>
+<table>
+  <thead>
+    <tr><th rowspan="2">Team</th><th colspan="2">Goals</th></tr>
+    <tr><th>For</th><th>Against</th></tr>
+  </thead>
+  <tbody>
+    <tr><td>Rovers</td><td>12</td><td>4</td></tr>
+    <tr><td>United</td><td>9</td><td>7</td></tr>
+  </tbody>
+</table>
+<p>United will hope to close the gap next year after signing two new strikers during the winter transfer window.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/league")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	expected := [][]string{
+		{"Team", "Goals", "Goals"},
+		{"Team", "For", "Against"},
+		{"Rovers", "12", "4"},
+		{"United", "9", "7"},
+	}
+	if len(result.Tables) != 1 || !reflect.DeepEqual(result.Tables[0], expected) {
+		t.Errorf("Expected table %q, got %q", expected, result.Tables)
+	}
+	if !contains(result.Content, "<table>") || !contains(result.Content, `colspan="2"`) {
+		t.Errorf("Expected table to stay in content HTML, got %s", result.Content)
+	}
+}
+
+func TestSocialMetaExtraction(t *testing.T) {
+	html := `<html><head><title>Budget Vote</title>
+<meta property="og:title" content="Budget Vote">
+<meta property="og:image" content="https://example.com/vote.jpg">
+<meta property="og:image:width" content="1200">
+<meta name="twitter:card" content="summary_large_image">
+<meta property="article:section" content="Politics">
+</head><body><article>
+<p>The council approved the new budget after a long evening session that ran well past midnight on Tuesday.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/budget")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	for key, expected := range map[string]string{
+		"og:image:width":  "1200",
+		"twitter:card":    "summary_large_image",
+		"article:section": "Politics",
+	} {
+		if got := result.SocialMeta[key]; got != expected {
+			t.Errorf("Expected SocialMeta[%q] = %q, got %q", key, expected, got)
+		}
+	}
+}
+
+func TestOpenGraphPropertyMetaTags(t *testing.T) {
+	// The only title on the page is an OpenGraph property tag
+	html := `<html><head>
+<meta property="og:title" content="Council Approves Budget After Marathon Session">
+</head><body><article>
+<p>The council approved the new budget after a long evening session that ran well past midnight on Tuesday.</p>
+<p>Members debated the proposed cuts to library hours before agreeing on a compromise that keeps branches open.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/budget")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Title != "Council Approves Budget After Marathon Session" {
+		t.Errorf("Expected og:title to be used, got %q", result.Title)
+	}
+}
+
+func TestIconsExtraction(t *testing.T) {
+	html := `<html><head><title>Budget Vote</title>
+<link rel="icon" href="/favicon-32.png" sizes="32x32" type="image/png">
+<link rel="apple-touch-icon" href="/apple-touch-icon.png" sizes="180x180">
+<link rel="icon" href="/favicon-192.png" sizes="192x192" type="image/png">
+</head><body><article>
+<p>The council approved the new budget after a long evening session that ran well past midnight on Tuesday.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/news/budget")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	expected := []IconInfo{
+		{URL: "http://127.0.0.1/favicon-192.png", Sizes: "192x192", Type: "image/png"},
+		{URL: "http://127.0.0.1/apple-touch-icon.png", Sizes: "180x180"},
+		{URL: "http://127.0.0.1/favicon-32.png", Sizes: "32x32", Type: "image/png"},
+	}
+	if !reflect.DeepEqual(result.Icons, expected) {
+		t.Errorf("Expected icons %+v, got %+v", expected, result.Icons)
+	}
+	if result.Favicon == "" {
+		t.Error("Expected Favicon to still be set")
+	}
+}
+
+// routeToCustomExtractor makes host use the ma.ttias.be custom extractor, whose
+// content selector is ".content", for the rest of the test
+func routeToCustomExtractor(t *testing.T, host string) {
+	t.Helper()
+	extractor := custom.MaTtiasBeExtractor
+	supported := extractor.SupportedDomains
+	extractor.SupportedDomains = append(append([]string{}, supported...), host)
+	t.Cleanup(func() { extractor.SupportedDomains = supported })
+}
+
+func TestContentTypeConsistencyAcrossExtractors(t *testing.T) {
+	routeToCustomExtractor(t, "127.0.0.2")
+
+	html := `<html><head><title>Budget Vote</title></head><body><article class="content">
+<p>The council approved the budget after a long debate about road repairs and the new library wing.</p>
+<p>Residents can read the <a href="https://example.com/budget">full budget</a> online, and the <strong>final vote</strong> was seven to two.</p>
+<ul><li>Roads</li><li>Library</li></ul>
+</article></body></html>`
+
+	for _, contentType := range []string{"markdown", "text", "html"} {
+		t.Run(contentType, func(t *testing.T) {
+			client := New(WithAllowPrivateNetworks(true), WithContentType(contentType))
+
+			customResult, err := client.ParseHTML(context.Background(), html, "http://127.0.0.2/news/budget")
+			if err != nil {
+				t.Fatalf("ParseHTML with custom extractor failed: %v", err)
+			}
+			if customResult.ExtractorUsed != "custom:ma.ttias.be" {
+				t.Fatalf("Expected custom extractor to be used, got %q", customResult.ExtractorUsed)
+			}
+
+			genericResult, err := client.ParseHTML(context.Background(), html, "http://127.0.0.1/news/budget")
+			if err != nil {
+				t.Fatalf("ParseHTML with generic extractor failed: %v", err)
+			}
+
+			if customResult.Content != genericResult.Content {
+				t.Errorf("Expected identical content\ncustom:  %q\ngeneric: %q", customResult.Content, genericResult.Content)
+			}
+			if customResult.Excerpt != genericResult.Excerpt {
+				t.Errorf("Expected identical excerpts, got %q and %q", customResult.Excerpt, genericResult.Excerpt)
+			}
+			if customResult.WordCount != genericResult.WordCount {
+				t.Errorf("Expected identical word counts, got %d and %d", customResult.WordCount, genericResult.WordCount)
+			}
+		})
+	}
+}
+
+func TestDatePublishedFromURL(t *testing.T) {
+	body := `<body><article>
+<p>The council approved the new budget after a long evening session that ran well past midnight on Tuesday.</p>
+</article></body></html>`
+	client := New(WithAllowPrivateNetworks(true))
+
+	result, err := client.ParseHTML(context.Background(), `<html><head><title>Budget Vote</title></head>`+body, "http://127.0.0.1/news/2024/01/15/budget-vote")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.DatePublished == nil || result.DatePublished.Format("2006-01-02") != "2024-01-15" {
+		t.Errorf("Expected date from URL path, got %v", result.DatePublished)
+	}
+
+	withMeta := `<html><head><title>Budget Vote</title><meta name="article:published_time" content="2024-01-16T09:30:00Z"></head>` + body
+	result, err = client.ParseHTML(context.Background(), withMeta, "http://127.0.0.1/news/2024/01/15/budget-vote")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.DatePublished == nil || result.DatePublished.Format("2006-01-02") != "2024-01-16" {
+		t.Errorf("Expected meta date to take precedence over URL date, got %v", result.DatePublished)
+	}
+
+	result, err = client.ParseHTML(context.Background(), `<html><head><title>Budget Vote</title></head>`+body, "http://127.0.0.1/p/12024-01-156/budget-vote")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.DatePublished != nil {
+		t.Errorf("Expected no date from spurious number sequence, got %v", result.DatePublished)
+	}
+}
+
+func TestIncludeRawContent(t *testing.T) {
+	routeToCustomExtractor(t, "127.0.0.2")
+
+	html := `<html><head><title>Budget Vote</title></head><body><article class="content">
+<p>The council approved the budget after a long debate about road repairs and the new library wing.</p>
+<script type="application/ld+json">{"@type":"NewsArticle","headline":"Budget Vote"}</script>
+<p onclick="steal()">Residents can read the full budget online, and the final vote was seven to two.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true), WithIncludeRawContent(true)).
+		ParseHTML(context.Background(), html, "http://127.0.0.2/news/budget")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	if !strings.Contains(result.RawContent, `<script type="application/ld+json">`) {
+		t.Errorf("Expected script tag in RawContent, got %q", result.RawContent)
+	}
+	if !strings.Contains(result.RawContent, `onclick="steal()"`) {
+		t.Errorf("Expected event handler in RawContent, got %q", result.RawContent)
+	}
+	if strings.Contains(result.Content, "<script") || strings.Contains(result.Content, "NewsArticle") || strings.Contains(result.Content, "onclick") {
+		t.Errorf("Expected sanitized Content, got %q", result.Content)
+	}
+	if !contains(result.Content, "final vote was seven to two") {
+		t.Errorf("Expected article text in Content, got %q", result.Content)
+	}
+
+	result, err = New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.2/news/budget")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.RawContent != "" {
+		t.Errorf("Expected empty RawContent by default, got %q", result.RawContent)
+	}
+}
+
+func TestDomainProfile(t *testing.T) {
+	client := New(
+		WithAllowPrivateNetworks(true),
+		WithDomainProfile("Example.com", WithContentType("markdown"), WithTimeout(5*time.Second)),
+		WithDomainProfile("127.0.0.2", WithContentType("markdown")),
+	)
+
+	t.Run("matches host ignoring www", func(t *testing.T) {
+		for _, u := range []string{"https://example.com/news/budget", "https://www.example.com/news/budget"} {
+			if got := client.forURL(u).buildParserOptions().ContentType; got != "markdown" {
+				t.Errorf("Expected markdown for %s, got %q", u, got)
+			}
+		}
+		for _, u := range []string{"https://other.org/news/budget", "https://blog.example.com/post"} {
+			if got := client.forURL(u).buildParserOptions().ContentType; got != "html" {
+				t.Errorf("Expected html for %s, got %q", u, got)
+			}
+		}
+	})
+
+	t.Run("does not change the base client", func(t *testing.T) {
+		if got := client.forURL("https://example.com/").httpClient.Timeout; got != 5*time.Second {
+			t.Errorf("Expected profile timeout 5s, got %v", got)
+		}
+		if got := client.httpClient.Timeout; got != 30*time.Second {
+			t.Errorf("Expected base timeout 30s, got %v", got)
+		}
+	})
+
+	t.Run("applies to parsing", func(t *testing.T) {
+		html := `<html><head><title>Budget Vote</title></head><body><article>
+<p>The council approved the new budget after a long evening session that ran well past <strong>midnight</strong> on Tuesday.</p>
+</article></body></html>`
+
+		profiled, err := client.ParseHTML(context.Background(), html, "http://127.0.0.2/news/budget")
+		if err != nil {
+			t.Fatalf("ParseHTML failed: %v", err)
+		}
+		if !strings.Contains(profiled.Content, "**midnight**") {
+			t.Errorf("Expected markdown content for profiled domain, got %q", profiled.Content)
+		}
+
+		base, err := client.ParseHTML(context.Background(), html, "http://127.0.0.1/news/budget")
+		if err != nil {
+			t.Fatalf("ParseHTML failed: %v", err)
+		}
+		if !strings.Contains(base.Content, "<strong>midnight</strong>") {
+			t.Errorf("Expected html content for other domains, got %q", base.Content)
+		}
+	})
+}
+
+func TestProseWordCount(t *testing.T) {
+	var rows strings.Builder
+	for i := 0; i < 30; i++ {
+		rows.WriteString("<tr><td>Ward budget line item</td><td>Approved amount in dollars</td></tr>")
+	}
+	html := `<html><head><title>Budget Vote</title></head><body><article>
+<p>The council approved the new budget after a long evening session that ran well past midnight on Tuesday.</p>
+<figure><img src="/chamber.jpg" width="800" height="600"><figcaption>Council members debate the budget in the main chamber late on Tuesday evening</figcaption></figure>
+<p>Road repairs receive the largest share of new spending, while the library wing will open next spring.</p>
+<table>` + rows.String() + `</table>
+<figure><img src="/library.jpg" width="800" height="600"><figcaption>An architect's drawing of the planned library wing seen from the park</figcaption></figure>
+<p>Residents can comment on the spending plan at a public meeting scheduled for next month.</p>
+</article></body></html>`
+
+	inclusive, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/news/budget")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if inclusive.WordCount != inclusive.TotalWordCount {
+		t.Errorf("Expected WordCount to equal TotalWordCount by default, got %d and %d", inclusive.WordCount, inclusive.TotalWordCount)
+	}
+
+	prose, err := New(WithAllowPrivateNetworks(true), WithProseWordCount(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/news/budget")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	// Three paragraphs of 18, 17 and 15 words
+	if prose.WordCount != 50 {
+		t.Errorf("Expected 50 prose words, got %d", prose.WordCount)
+	}
+	if prose.TotalWordCount != inclusive.TotalWordCount {
+		t.Errorf("Expected the inclusive count to stay available, got %d and %d", prose.TotalWordCount, inclusive.TotalWordCount)
+	}
+	if prose.TotalWordCount <= prose.WordCount+200 {
+		t.Errorf("Expected table and captions to add over 200 words, got total %d and prose %d", prose.TotalWordCount, prose.WordCount)
+	}
+}
+
+func TestNestedHeadings(t *testing.T) {
+	html := `<html><head><title>Budget Vote</title></head><body><article><h1>Budget Vote</h1>
+<p>The council approved the new budget after a long evening session that ran well past midnight on Tuesday.</p>
+<h2>Road repairs</h2>
+<p>Road repairs receive the largest share of new spending, with every ward getting at least two resurfaced streets.</p>
+<h3>Timeline for the work</h3>
+<p>Crews start in the spring and the city expects to finish the resurfacing before the end of the autumn.</p>
+</article></body></html>`
+
+	flat, err := New(WithAllowPrivateNetworks(true), WithContentType("markdown")).ParseHTML(context.Background(), html, "http://127.0.0.1/news/budget")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if !strings.Contains(flat.Content, "## Road repairs") || strings.Contains(flat.Content, "### Road repairs") {
+		t.Fatalf("Expected original heading levels by default, got:\n%s", flat.Content)
+	}
+
+	nested, err := New(WithAllowPrivateNetworks(true), WithContentType("markdown"), WithNestedHeadings(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/news/budget")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if !strings.Contains(nested.Content, "### Road repairs") {
+		t.Errorf("Expected h2 demoted to h3, got:\n%s", nested.Content)
+	}
+	if !strings.Contains(nested.Content, "#### Timeline for the work") {
+		t.Errorf("Expected h3 demoted to h4, got:\n%s", nested.Content)
+	}
+	for _, line := range strings.Split(nested.Content, "\n") {
+		if strings.HasPrefix(line, "# ") {
+			t.Errorf("Expected no h1 left in nested content, found %q", line)
+		}
+	}
+}
+
+func TestMarkdownMalformedNestedLists(t *testing.T) {
+	html := `<html><head><title>Workshop Checklist</title></head><body><article>
+<p>Before the first cut, go through the checklist below and make sure every tool is sharp and every guard is in place.</p>
+<ul>
+<li><ul><li><div>Sharpen the chisels</div></li></ul></li>
+<li>Check the saw</li>
+<li><ul><li>Blade tension</li><li>Fence alignment<ul><li><ul><li>Square to the table</li></ul></li></ul></li></ul></li>
+<li></li>
+<li>Sweep the floor</li>
+</ul>
+<p>Finish by switching on the dust extraction and checking the emergency stop works before starting any machine.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true), WithContentType("markdown")).ParseHTML(context.Background(), html, "http://127.0.0.1/workshop/checklist")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	// Indentation of each item, in order, relative to the top level
+	expected := []struct {
+		text   string
+		indent int
+	}{
+		{"Sharpen the chisels", 0},
+		{"Check the saw", 0},
+		{"Blade tension", 1},
+		{"Fence alignment", 1},
+		{"Square to the table", 2},
+		{"Sweep the floor", 0},
+	}
+	var items []string
+	for _, line := range strings.Split(result.Content, "\n") {
+		if strings.HasPrefix(strings.TrimLeft(line, " "), "- ") {
+			items = append(items, line)
+		}
+	}
+	if len(items) != len(expected) {
+		t.Fatalf("Expected %d list items, got %d in:\n%s", len(expected), len(items), result.Content)
+	}
+	unit := 0
+	for i, want := range expected {
+		indent := len(items[i]) - len(strings.TrimLeft(items[i], " "))
+		if want.indent == 1 && unit == 0 {
+			unit = indent
+		}
+		if !strings.HasSuffix(items[i], "- "+want.text) || indent != want.indent*unit {
+			t.Errorf("Expected %q at level %d, got %q", want.text, want.indent, items[i])
+		}
+	}
+	if unit == 0 {
+		t.Errorf("Expected nested items to be indented, got:\n%s", result.Content)
+	}
+}
+
+func TestMinLeadImageSize(t *testing.T) {
+	article := `<p>The council approved the new budget after a long evening session that ran well past midnight on Tuesday.</p>
+<p>Road repairs receive the largest share of new spending, while the library wing will open next spring.</p>`
+	thumbOnly := `<html><head><title>Budget Vote</title></head><body><article>
+<figure><img src="http://127.0.0.1/uploads/thumb-photo.jpg" width="150" height="100" alt="Council chamber"></figure>` + article + `</article></body></html>`
+	withHero := `<html><head><title>Budget Vote</title></head><body><article>
+<figure><img src="http://127.0.0.1/uploads/thumb-photo.jpg" width="150" height="100" alt="Council chamber"></figure>
+<img src="http://127.0.0.1/uploads/hero.jpg" width="1200" height="600">` + article + `</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), thumbOnly, "http://127.0.0.1/news/budget")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if !strings.HasSuffix(result.LeadImageURL, "/uploads/thumb-photo.jpg") {
+		t.Fatalf("Expected the thumbnail as lead image without a minimum, got %q", result.LeadImageURL)
+	}
+
+	client := New(WithAllowPrivateNetworks(true), WithMinLeadImageSize(400, 200))
+	result, err = client.ParseHTML(context.Background(), thumbOnly, "http://127.0.0.1/news/budget")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.LeadImageURL != "" {
+		t.Errorf("Expected no lead image when only thumbnails exist, got %q", result.LeadImageURL)
+	}
+
+	result, err = client.ParseHTML(context.Background(), withHero, "http://127.0.0.1/news/budget")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.LeadImageURL != "http://127.0.0.1/uploads/hero.jpg" {
+		t.Errorf("Expected the hero image as lead image, got %q", result.LeadImageURL)
+	}
+}
+
+func TestFieldSources(t *testing.T) {
+	html := `<html><head><title>Budget Vote - City News</title>
+<meta property="og:title" content="Budget Vote | City News">
+<meta name="dc.creator" content="Jane Reporter">
+<script type="application/ld+json">{"@context":"https://schema.org","@type":"NewsArticle","headline":"Council Approves Budget After Late Session"}</script>
+</head><body><article>
+<p>The council approved the new budget after a long evening session that ran well past midnight on Tuesday.</p>
+<p>Road repairs receive the largest share of new spending, while the library wing will open next spring.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/2024/01/15/budget")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Title != "Council Approves Budget After Late Session" {
+		t.Errorf("Expected the JSON-LD headline as title, got %q", result.Title)
+	}
+
+	expected := map[string]string{
+		"title":          "jsonld",
+		"author":         "meta",
+		"date_published": "url",
+		"content":        "generic-heuristic",
+	}
+	for field, source := range expected {
+		if got := result.FieldSources[field]; got != source {
+			t.Errorf("Expected FieldSources[%q] = %q, got %q", field, source, got)
+		}
+	}
+}
+
+func TestParseDocument(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<html><head><title>Budget Vote</title></head><body>
+<nav><a href="/">Home</a></nav><article></article></body></html>`))
+	if err != nil {
+		t.Fatalf("Failed to build document: %v", err)
+	}
+	// Inject rendered content the way a caller post-processing the DOM would
+	doc.Find("article").SetHtml(`<h1>Council Approves Budget</h1>
+<p>The council approved the new budget after a long evening session that ran well past midnight on Tuesday.</p>
+<p>Road repairs receive the largest share of new spending, while the library wing will open next spring.</p>`)
+	before, _ := doc.Html()
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseDocument(context.Background(), doc, "http://127.0.0.1/news/budget")
+	if err != nil {
+		t.Fatalf("ParseDocument failed: %v", err)
+	}
+	if result.Title != "Council Approves Budget" {
+		t.Errorf("Expected title from the injected heading, got %q", result.Title)
+	}
+	if !strings.Contains(result.Content, "library wing") {
+		t.Errorf("Expected injected paragraphs in content, got %q", result.Content)
+	}
+	if after, _ := doc.Html(); after != before {
+		t.Errorf("Expected the caller's document to be left unchanged")
+	}
+
+	if _, err := New().ParseDocument(context.Background(), nil, "http://127.0.0.1/news/budget"); err == nil {
+		t.Error("Expected an error for a nil document")
+	}
+}
+
+func TestStructuredData(t *testing.T) {
+	html := `<html><head><title>Weekend Pancakes</title>
+<script type="application/ld+json">{"@context":"https://schema.org","@type":"Recipe","name":"Weekend Pancakes",
+"recipeIngredient":["200g flour","2 eggs","300ml milk"],
+"recipeInstructions":[{"@type":"HowToStep","text":"Whisk the flour, eggs and milk."},{"@type":"HowToStep","text":"Fry in a hot buttered pan."}]}</script>
+</head><body><article>
+<p>These pancakes are the ones we make every Saturday morning, light and fluffy with crisp golden edges.</p>
+<p>Whisk everything together, let the batter rest, then fry small rounds in plenty of butter until golden.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/recipes/pancakes")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Structured != nil {
+		t.Errorf("Expected no structured data by default, got %v", result.Structured)
+	}
+
+	result, err = New(WithAllowPrivateNetworks(true), WithStructuredData(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/recipes/pancakes")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	recipe, ok := result.Structured["recipe"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected a recipe in structured data, got %v", result.Structured)
+	}
+	if ingredients := recipe["ingredients"]; !reflect.DeepEqual(ingredients, []string{"200g flour", "2 eggs", "300ml milk"}) {
+		t.Errorf("Unexpected ingredients: %v", ingredients)
+	}
+	if steps := recipe["instructions"]; !reflect.DeepEqual(steps, []string{"Whisk the flour, eggs and milk.", "Fry in a hot buttered pan."}) {
+		t.Errorf("Unexpected instructions: %v", steps)
+	}
+}
+
+func TestContentHint(t *testing.T) {
+	html := `<html><head><title>Harbour Ferry Returns</title></head><body>
+<div class="col-left"><div class="ferry-report">
+<p>The harbour ferry returned to service on Monday after a six week refit, with commuters queueing from early morning.</p>
+<p>Operators said the new engines cut the crossing time by four minutes and should make winter sailings more reliable.</p>
+<p>A second vessel joins the route in the spring, allowing departures every fifteen minutes at peak times, they added.</p>
+</div></div>
+<div class="col-right"><div class="whats-on">
+<p>Elsewhere this week, the farmers market moves indoors, the library extends its opening hours, and roadworks begin on the high street.</p>
+<p>The council is also consulting on new cycle lanes, a refurbished playground at the park, and changes to bin collection days across town.</p>
+<p>Tickets for the autumn festival go on sale on Friday, with discounts for residents, students, and families booking before the end of the month.</p>
+</div></div>
+</body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/news/ferry")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if !strings.Contains(result.Content, "farmers market") || strings.Contains(result.Content, "harbour ferry") {
+		t.Fatalf("Expected the default scoring to prefer the listings block, got:\n%s", result.Content)
+	}
+
+	hinted, err := New(WithAllowPrivateNetworks(true), WithContentHint(".ferry-report")).ParseHTML(context.Background(), html, "http://127.0.0.1/news/ferry")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if !strings.Contains(hinted.Content, "harbour ferry") || strings.Contains(hinted.Content, "farmers market") {
+		t.Errorf("Expected the hint to select the ferry report, got:\n%s", hinted.Content)
+	}
+}
+
+func TestTotalPages(t *testing.T) {
+	body := `<article>
+<p>The second part of our series on river restoration looks at how beavers reshape floodplains over a decade.</p>
+<p>Dams slow the water, widen wetlands and give fish and insects more room to recover after dry summers.</p>
+</article>`
+
+	tests := []struct {
+		name     string
+		html     string
+		expected int
+	}{
+		{
+			name:     "page indicator",
+			html:     `<html><head><title>River Restoration</title></head><body>` + body + `<div class="pager">Page 1 of 4</div></body></html>`,
+			expected: 4,
+		},
+		{
+			name:     "rel last link",
+			html:     `<html><head><title>River Restoration</title><link rel="last" href="http://127.0.0.1/rivers?page=6"></head><body>` + body + `</body></html>`,
+			expected: 6,
+		},
+		{
+			name:     "no pagination",
+			html:     `<html><head><title>River Restoration</title></head><body>` + body + `</body></html>`,
+			expected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), tt.html, "http://127.0.0.1/rivers")
+			if err != nil {
+				t.Fatalf("ParseHTML failed: %v", err)
+			}
+			if result.TotalPages != tt.expected {
+				t.Errorf("Expected %d total pages, got %d", tt.expected, result.TotalPages)
+			}
+		})
+	}
+}
+
+func TestContentSections(t *testing.T) {
+	html := `<html><head><title>Planting a Kitchen Garden</title></head><body><article>
+<p>A small kitchen garden can keep a household in salad leaves and herbs from late spring until the first frosts.</p>
+<h2>Choosing a Site</h2>
+<p>Pick the sunniest spot you have, ideally with six hours of direct light and some shelter from the wind.</p>
+<h2>Preparing the Soil</h2>
+<p>Dig in plenty of compost in autumn so that worms can work it into the ground over the winter months.</p>
+<h3>Raised Beds</h3>
+<p>Raised beds warm up earlier in the year and drain well, which suits carrots, radishes and most herbs.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true), WithContentSections(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/garden")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	var headings []string
+	for _, section := range result.Sections {
+		if section.Heading != "" {
+			headings = append(headings, section.Heading)
+		}
+	}
+	if expected := []string{"Choosing a Site", "Preparing the Soil", "Raised Beds"}; !reflect.DeepEqual(headings, expected) {
+		t.Fatalf("Expected headings %v, got %v", expected, headings)
+	}
+
+	last := result.Sections[len(result.Sections)-1]
+	if !strings.HasPrefix(last.HTML, "<h3>Raised Beds</h3>") || !strings.Contains(last.HTML, "drain well") {
+		t.Errorf("Expected the last section to hold its heading and paragraph, got %q", last.HTML)
+	}
+	if strings.Contains(result.Sections[len(result.Sections)-2].HTML, "drain well") {
+		t.Errorf("Expected the raised beds paragraph only in its own section")
+	}
+	if !strings.Contains(result.Content, "Choosing a Site") || !strings.Contains(result.Content, "drain well") {
+		t.Errorf("Expected Content to remain the full article, got %q", result.Content)
+	}
+
+	plain, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/garden")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if plain.Sections != nil {
+		t.Errorf("Expected no sections by default, got %v", plain.Sections)
+	}
+}
+
+func TestTextEntitiesAndQuotes(t *testing.T) {
+	// The second paragraph is double-escaped, as some CMS exports are
+	html := `<html><head><title>Salt and Pepper</title></head><body><article>
+<p>Salt &amp; pepper are the basics &#8212; my grandmother&#8217;s &#8220;rule&#8221; for every pot on the stove.</p>
+<p>She said &amp;#8220;taste as you go&amp;#8221; &amp;amp; never measured anything, which drove the family mad.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true), WithContentType("text")).ParseHTML(context.Background(), html, "http://127.0.0.1/kitchen")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	for _, expected := range []string{"Salt & pepper", "basics — my grandmother’s “rule”", "“taste as you go” & never"} {
+		if !strings.Contains(result.Content, expected) {
+			t.Errorf("Expected text content to contain %q, got %q", expected, result.Content)
+		}
+	}
+
+	ascii, err := New(WithAllowPrivateNetworks(true), WithContentType("text"), WithASCIIQuotes(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/kitchen")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	for _, expected := range []string{`basics — my grandmother's "rule"`, `"taste as you go" & never`} {
+		if !strings.Contains(ascii.Content, expected) {
+			t.Errorf("Expected ASCII quotes in %q, got %q", expected, ascii.Content)
+		}
+	}
+}
+
+func TestArticleClassification(t *testing.T) {
+	var homepage strings.Builder
+	homepage.WriteString(`<html><head><title>The Daily Ledger</title></head><body>
+<header><h1>The Daily Ledger</h1><nav><a href="/news">News</a> <a href="/sport">Sport</a> <a href="/culture">Culture</a></nav></header>`)
+	for i := 1; i <= 12; i++ {
+		fmt.Fprintf(&homepage, `<div class="teaser"><h1><a href="/story-%d">Council approves new budget for harbour repairs, story %d</a></h1><p>Short summary.</p></div>`, i, i)
+	}
+	homepage.WriteString(`</body></html>`)
+
+	fixture, err := os.ReadFile("internal/fixtures/arstechnica.com.html")
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+
+	undeclared := `<html><head><title>Harbour Repairs Approved</title></head><body>
+<nav><a href="/">Home</a> <a href="/news">News</a></nav>
+<article><h1>Harbour Repairs Approved</h1><time datetime="2024-03-12T09:00:00Z">12 March 2024</time>` +
+		strings.Repeat(`<p>The council approved a budget for repairs to the harbour wall on Tuesday, ending months of debate about how the work should be paid for and when it should start.</p>`, 8) +
+		`</article></body></html>`
+
+	client := New(WithAllowPrivateNetworks(true))
+	tests := []struct {
+		name      string
+		html      string
+		url       string
+		isArticle bool
+	}{
+		{"article fixture", string(fixture), "http://127.0.0.1/test-article", true},
+		{"undeclared article", undeclared, "http://127.0.0.1/news/harbour", true},
+		{"home page", homepage.String(), "http://127.0.0.1/", false},
+		{"og:type article", strings.Replace(homepage.String(), "</title>", `</title><meta property="og:type" content="article">`, 1), "http://127.0.0.1/", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := client.ParseHTML(context.Background(), tt.html, tt.url)
+			if err != nil {
+				t.Fatalf("ParseHTML failed: %v", err)
+			}
+			if result.IsArticle != tt.isArticle {
+				t.Errorf("Expected IsArticle %v, got %v", tt.isArticle, result.IsArticle)
+			}
+		})
+	}
+
+	strict := New(WithAllowPrivateNetworks(true), WithRejectNonArticles(true))
+	if _, err := strict.ParseHTML(context.Background(), homepage.String(), "http://127.0.0.1/"); err == nil {
+		t.Error("Expected ErrNotArticle for the home page")
+	} else if parseErr, ok := err.(*ParseError); !ok || !parseErr.IsNotArticle() {
+		t.Errorf("Expected ErrNotArticle, got %v", err)
+	}
+	if _, err := strict.ParseHTML(context.Background(), undeclared, "http://127.0.0.1/news/harbour"); err != nil {
+		t.Errorf("Expected the article to parse under strict mode, got %v", err)
+	}
+}
+
+func TestMinParagraphWords(t *testing.T) {
+	html := `<html><head><title>Night Trains Return</title></head><body><article>
+<p>Night trains between the capital and the northern coast return this summer after a gap of nearly twenty years.</p>
+<p>Read more</p>
+<p>The operator says sleeper cabins sold out within hours, and it plans to add a second weekly service in autumn.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/travel/trains")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if !strings.Contains(result.Content, "Read more") {
+		t.Fatalf("Expected short paragraphs to be kept by default, got %q", result.Content)
+	}
+
+	filtered, err := New(WithAllowPrivateNetworks(true), WithMinParagraphWords(3)).ParseHTML(context.Background(), html, "http://127.0.0.1/travel/trains")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if strings.Contains(filtered.Content, "Read more") {
+		t.Errorf("Expected the two-word paragraph to be removed, got %q", filtered.Content)
+	}
+	if !strings.Contains(filtered.Content, "sleeper cabins") {
+		t.Errorf("Expected longer paragraphs to stay, got %q", filtered.Content)
+	}
+}
+
+func TestAllowedContentTypes(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/notes.txt" {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.Write([]byte("Plain text notes that are not an HTML article."))
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(`<html><head><title>Allowed Page</title></head><body><p>This page is served as HTML and passes the allowlist.</p></body></html>`))
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	htmlOnly := New(WithAllowPrivateNetworks(true), WithAllowedContentTypes([]string{"text/html"}))
+
+	_, err := htmlOnly.Parse(ctx, ts.URL+"/notes.txt")
+	if parseErr, ok := err.(*ParseError); !ok || !parseErr.IsUnsupportedContentType() {
+		t.Errorf("Expected ErrUnsupportedContentType for text/plain, got %v", err)
+	}
+
+	result, err := htmlOnly.Parse(ctx, ts.URL+"/article")
+	if err != nil {
+		t.Fatalf("Expected text/html to be allowed, got %v", err)
+	}
+	if result.Title != "Allowed Page" {
+		t.Errorf("Expected title 'Allowed Page', got %q", result.Title)
+	}
+
+	// Without an allowlist plain text is still parsed
+	if _, err := New(WithAllowPrivateNetworks(true)).Parse(ctx, ts.URL+"/notes.txt"); err != nil {
+		t.Errorf("Expected text/plain to parse by default, got %v", err)
+	}
+}
+
+func TestHTMLInlineCSSContent(t *testing.T) {
+	html := `<html><head><title>Weekly Digest</title></head><body><article>
+<p style="font-size:40px" onclick="track()">This week the library opened its new reading room, with seating for sixty and late opening on Thursdays.</p>
+<script>document.write("tracking pixel")</script>
+<blockquote>It is the quietest room in the city, one visitor said after spending the afternoon there with a novel.</blockquote>
+<p>Volunteers will run a book swap in the reading room on the first Saturday of every month from next spring onward.</p>
+<img src="http://127.0.0.1/images/reading-room.jpg" alt="Reading room">
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true), WithContentType("html-inline-css")).ParseHTML(context.Background(), html, "http://127.0.0.1/digest")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(result.Content))
+	if err != nil {
+		t.Fatalf("Failed to parse content: %v", err)
+	}
+	doc.Find("p").Each(func(i int, p *goquery.Selection) {
+		if style := p.AttrOr("style", ""); !strings.Contains(style, "margin:") || strings.Contains(style, "font-size") {
+			t.Errorf("Expected paragraph %d to carry only the inline margin style, got %q", i, style)
+		}
+	})
+	if style := doc.Find("blockquote").AttrOr("style", ""); !strings.Contains(style, "border-left:") {
+		t.Errorf("Expected a blockquote border style, got %q", style)
+	}
+	if style := doc.Find("img").AttrOr("style", ""); !strings.Contains(style, "max-width:100%") {
+		t.Errorf("Expected an image max-width style, got %q", style)
+	}
+	if strings.Contains(result.Content, "<script") || strings.Contains(result.Content, "tracking pixel") || strings.Contains(result.Content, "onclick") {
+		t.Errorf("Expected scripts and event handlers to be removed, got %q", result.Content)
+	}
+}
+
+func TestEPUBChapterContent(t *testing.T) {
+	html := `<html lang="en"><head><title>Restoring a Lathe &amp; Other Stories</title></head><body><article>
+<h1>Restoring a Lathe &amp; Other Stories</h1>
+<p>The lathe arrived in pieces, with a cracked headstock casting and a bed covered in forty years of grime.<br>Cleaning it took most of a winter.</p>
+<!-- sidebar ad -->
+<p>Once the ways were scraped flat again, the carriage slid from end to end without the slightest catch.</p>
+<img src="http://127.0.0.1/images/lathe.jpg" alt="The restored lathe">
+<hr>
+<p>Next month: cutting the first thread on the restored machine, and what went wrong along the way.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true), WithContentType("epub-chapter")).ParseHTML(context.Background(), html, "http://127.0.0.1/lathe")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	for _, want := range []string{`alt="The restored lathe"/>`, `xmlns="http://www.w3.org/1999/xhtml"`, `<h1>Restoring a Lathe &amp; Other Stories</h1>`} {
+		if !strings.Contains(result.Content, want) {
+			t.Errorf("Expected chapter to contain %q, got %q", want, result.Content)
+		}
+	}
+	if n := strings.Count(result.Content, "<h1>"); n != 1 {
+		t.Errorf("Expected the title heading once, got %d in %q", n, result.Content)
+	}
+	if strings.Contains(result.Content, "<!--") {
+		t.Errorf("Expected comments to be removed, got %q", result.Content)
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(result.Content))
+	decoder.Strict = true
+	var root xml.Name
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Expected chapter to parse as XML, got %v in %q", err, result.Content)
+		}
+		if start, ok := token.(xml.StartElement); ok && root.Local == "" {
+			root = start.Name
+		}
+	}
+	if root.Space != "http://www.w3.org/1999/xhtml" || root.Local != "html" {
+		t.Errorf("Expected an XHTML html root element, got %+v", root)
+	}
+}
+
+func TestArticleSection(t *testing.T) {
+	body := `<article>
+<p>Council members voted on Tuesday to extend the late-night bus routes that connect the riverside estates to the centre.</p>
+<p>The pilot carried more passengers than expected, and operators say the extra services will pay for themselves within a year.</p>
+</article>`
+
+	tests := []struct {
+		name     string
+		head     string
+		nav      string
+		expected string
+	}{
+		{
+			name:     "article section meta",
+			head:     `<meta property="article:section" content="Local Politics">`,
+			expected: "Local Politics",
+		},
+		{
+			name:     "json-ld article section",
+			head:     `<script type="application/ld+json">{"@type":"NewsArticle","headline":"Night Buses Extended","articleSection":["Transport","City"]}</script>`,
+			expected: "Transport",
+		},
+		{
+			name:     "breadcrumb leaf",
+			nav:      `<nav aria-label="breadcrumb"><ol><li><a href="/">Home</a></li><li><a href="/city">City</a></li><li>Night Buses Extended</li></ol></nav>`,
+			expected: "City",
+		},
+		{
+			name:     "no section",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := `<html><head><title>Night Buses Extended</title>` + tt.head + `</head><body>` + tt.nav + body + `</body></html>`
+			result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/city/night-buses")
+			if err != nil {
+				t.Fatalf("ParseHTML failed: %v", err)
+			}
+			if result.Section != tt.expected {
+				t.Errorf("Expected section %q, got %q", tt.expected, result.Section)
+			}
+		})
+	}
+}
+
+func TestHreflangAlternates(t *testing.T) {
+	html := `<html><head><title>Harvest Festival Returns</title>
+<link rel="alternate" hreflang="en" href="/en/harvest-festival">
+<link rel="alternate" hreflang="fr" href="http://127.0.0.1/fr/fete-des-recoltes">
+<link rel="alternate" hreflang="x-default" href="/harvest-festival">
+</head><body><article>
+<p>The harvest festival returns to the market square this weekend with stalls from more than forty local farms and bakeries.</p>
+<p>Organisers expect record crowds after last year's event was cut short by storms on the Saturday afternoon.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/en/harvest-festival")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	expected := map[string]string{
+		"en":        "http://127.0.0.1/en/harvest-festival",
+		"fr":        "http://127.0.0.1/fr/fete-des-recoltes",
+		"x-default": "http://127.0.0.1/harvest-festival",
+	}
+	if !reflect.DeepEqual(result.Alternates, expected) {
+		t.Errorf("Expected alternates %v, got %v", expected, result.Alternates)
+	}
+}
+
+func TestPublishTimezone(t *testing.T) {
+	body := `<body><article>
+<p>The ferry operator confirmed on Sunday that the winter timetable will start a week earlier than planned this year.</p>
+<p>Passengers on the early crossing have been warned to expect reduced catering while the refit is completed.</p>
+</article></body></html>`
+
+	tests := []struct {
+		name             string
+		head             string
+		expectedTimezone string
+		expectedDate     time.Time
+	}{
+		{
+			name:             "explicit offset",
+			head:             `<meta property="article:published_time" content="2024-11-03T08:15:00+05:30">`,
+			expectedTimezone: "+05:30",
+			expectedDate:     time.Date(2024, 11, 3, 2, 45, 0, 0, time.UTC),
+		},
+		{
+			name:             "no offset",
+			head:             `<meta property="article:published_time" content="2024-11-03T08:15:00">`,
+			expectedTimezone: "",
+			expectedDate:     time.Date(2024, 11, 3, 8, 15, 0, 0, time.UTC),
+		},
+		{
+			name:             "content-language locale",
+			head:             `<meta http-equiv="content-language" content="de-DE"><meta property="article:published_time" content="2024-11-03T08:15:00">`,
+			expectedTimezone: "Europe/Berlin",
+			expectedDate:     time.Date(2024, 11, 3, 8, 15, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := `<html><head><title>Winter Ferry Timetable</title>` + tt.head + `</head>` + body
+			result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/ferry")
+			if err != nil {
+				t.Fatalf("ParseHTML failed: %v", err)
+			}
+			if result.PublishTimezone != tt.expectedTimezone {
+				t.Errorf("Expected timezone %q, got %q", tt.expectedTimezone, result.PublishTimezone)
+			}
+			if result.DatePublished == nil {
+				t.Fatal("Expected a publish date")
+			}
+			if !result.DatePublished.Equal(tt.expectedDate) || result.DatePublished.Location() != time.UTC {
+				t.Errorf("Expected UTC date %v, got %v", tt.expectedDate, result.DatePublished)
+			}
+		})
+	}
+}
+
+func TestResultTransformers(t *testing.T) {
+	html := `<html><head><title>Community Orchard Planted</title></head><body><article>
+<p>Volunteers planted sixty apple and pear trees on the old allotment site, creating the town's first community orchard.</p>
+<p>The trees are heritage varieties grafted from cuttings collected in local gardens over the past two winters.</p>
+</article></body></html>`
+	url := "http://127.0.0.1/orchard"
+
+	t.Run("applied in order", func(t *testing.T) {
+		var order []string
+		client := New(
+			WithAllowPrivateNetworks(true),
+			WithResultTransformer(func(r *Result) error {
+				order = append(order, "upper")
+				r.Title = strings.ToUpper(r.Title)
+				return nil
+			}),
+			WithResultTransformer(func(r *Result) error {
+				order = append(order, "suffix")
+				r.Title += "!"
+				return nil
+			}),
+		)
+
+		result, err := client.ParseHTML(context.Background(), html, url)
+		if err != nil {
+			t.Fatalf("ParseHTML failed: %v", err)
+		}
+		if result.Title != "COMMUNITY ORCHARD PLANTED!" {
+			t.Errorf("Expected transformed title, got %q", result.Title)
+		}
+		if !reflect.DeepEqual(order, []string{"upper", "suffix"}) {
+			t.Errorf("Expected transformers to run in order, got %v", order)
+		}
+	})
+
+	t.Run("error aborts", func(t *testing.T) {
+		errRedact := fmt.Errorf("redaction service unavailable")
+		called := false
+		client := New(
+			WithAllowPrivateNetworks(true),
+			WithResultTransformer(func(r *Result) error { return errRedact }),
+			WithResultTransformer(func(r *Result) error {
+				called = true
+				return nil
+			}),
+		)
+
+		result, err := client.ParseHTML(context.Background(), html, url)
+		if result != nil {
+			t.Errorf("Expected no result, got %+v", result)
+		}
+		parseErr, ok := err.(*ParseError)
+		if !ok {
+			t.Fatalf("Expected *ParseError, got %T: %v", err, err)
+		}
+		if !parseErr.IsTransform() || parseErr.Unwrap() != errRedact {
+			t.Errorf("Expected ErrTransform wrapping the transformer error, got %v", parseErr)
+		}
+		if called {
+			t.Error("Expected later transformers to be skipped after an error")
+		}
+	})
+}
+
+func TestRelatedWidgetRemoved(t *testing.T) {
+	html := `<html><head><title>Lighthouse Reopens</title></head><body><article>
+<p>The lighthouse keeper's cottage has been restored using lime mortar and timber salvaged from the original roof timbers.</p>
+<p>Visitors can now climb the tower on weekends, and the story of the light is told in the old oil store beside the gate.</p>
+<div class="promo-box"><h3>You may also like</h3><ul>
+<li><a href="/walks">Ten coastal walks for autumn</a></li>
+<li><a href="/lightships">The last lightship crews</a></li>
+</ul></div>
+<p>Volunteers hope to reopen the foghorn house next summer once the roof has been made watertight again.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/lighthouse")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if strings.Contains(result.Content, "You may also like") || strings.Contains(result.Content, "coastal walks") {
+		t.Errorf("Expected the recommendation widget to be removed, got %s", result.Content)
+	}
+	for _, prose := range []string{"lime mortar", "old oil store", "foghorn house"} {
+		if !strings.Contains(result.Content, prose) {
+			t.Errorf("Expected content to keep %q, got %s", prose, result.Content)
+		}
+	}
+}
+
+func TestContributors(t *testing.T) {
+	html := `<html><head><title>Inside the Water Board</title>
+<script type="application/ld+json">{"@type":"NewsArticle","headline":"Inside the Water Board","author":[{"@type":"Person","name":"Ana Ruiz"},{"@type":"Person","name":"Tom Hale"}],"editor":{"@type":"Person","name":"Priya Shah"}}</script>
+</head><body><article>
+<p class="byline">Reported by Ana Ruiz and Tom Hale. Edited by Priya Shah. Additional reporting by Jo Kim</p>
+<p>A year-long investigation into the regional water board found that maintenance budgets were cut three years in a row.</p>
+<p>Internal emails show engineers warned that ageing pumps at two treatment works were likely to fail during a dry summer.</p>
+</article></body></html>`
+	url := "http://127.0.0.1/water-board"
+
+	result, err := New(WithAllowPrivateNetworks(true), WithContributors(true)).ParseHTML(context.Background(), html, url)
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	expectedAuthors := []AuthorInfo{{Name: "Ana Ruiz", Role: "author"}, {Name: "Tom Hale", Role: "author"}}
+	if !reflect.DeepEqual(result.Authors, expectedAuthors) {
+		t.Errorf("Expected authors %v, got %v", expectedAuthors, result.Authors)
+	}
+	expectedContributors := []AuthorInfo{{Name: "Priya Shah", Role: "editor"}, {Name: "Jo Kim", Role: "contributor"}}
+	if !reflect.DeepEqual(result.Contributors, expectedContributors) {
+		t.Errorf("Expected contributors %v, got %v", expectedContributors, result.Contributors)
+	}
+
+	// Off by default
+	result, err = New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, url)
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Authors != nil || result.Contributors != nil {
+		t.Errorf("Expected no credits without WithContributors, got %v and %v", result.Authors, result.Contributors)
+	}
+}
+
+func TestMarkdownFrontmatter(t *testing.T) {
+	html := `<html><head><title>Q&amp;A: "Tides" #1 - Why the harbour floods</title>
+<meta name="author" content="Ana Ruiz">
+<meta property="article:published_time" content="2024-02-14T07:30:00Z">
+<meta property="og:image" content="http://127.0.0.1/images/harbour.jpg">
+</head><body><article>
+<h1>Q&amp;A: "Tides" #1 - Why the harbour floods</h1>
+<p class="byline">By Ana Ruiz</p>
+<p>Spring tides and a strong onshore wind pushed water over the harbour wall twice this winter, flooding the fish market.</p>
+<p>Engineers say raising the wall by half a metre would protect the quay for at least another thirty years of storms.</p>
+</article></body></html>`
+	url := "http://127.0.0.1/tides-qa"
+
+	result, err := New(WithAllowPrivateNetworks(true), WithContentType("markdown"), WithMarkdownFrontmatter(true)).ParseHTML(context.Background(), html, url)
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	if !strings.HasPrefix(result.Content, "---\n") {
+		t.Fatalf("Expected content to start with frontmatter, got %q", result.Content)
+	}
+	block, body, found := strings.Cut(strings.TrimPrefix(result.Content, "---\n"), "\n---\n\n")
+	if !found {
+		t.Fatalf("Expected a closing frontmatter delimiter, got %q", result.Content)
+	}
+
+	fields := make(map[string]string)
+	for _, line := range strings.Split(block, "\n") {
+		key, value, ok := strings.Cut(line, ": ")
+		if !ok {
+			t.Fatalf("Malformed frontmatter line %q", line)
+		}
+		// Values are double-quoted YAML scalars written in their JSON-compatible form
+		var decoded string
+		if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+			t.Fatalf("Frontmatter value for %s is not a valid quoted scalar: %v", key, err)
+		}
+		fields[key] = decoded
+	}
+
+	expected := map[string]string{
+		"title":      result.Title,
+		"author":     result.Author,
+		"date":       "2024-02-14T07:30:00Z",
+		"url":        url,
+		"lead_image": "http://127.0.0.1/images/harbour.jpg",
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("Expected frontmatter %v, got %v", expected, fields)
+	}
+	if !strings.Contains(result.Title, `"Tides" #1`) {
+		t.Errorf("Expected the title to keep YAML-special characters, got %q", result.Title)
+	}
+	if !strings.Contains(body, "Spring tides") || strings.HasPrefix(body, "---") {
+		t.Errorf("Expected the markdown body after the frontmatter, got %q", body)
+	}
+
+	// Off by default
+	result, err = New(WithAllowPrivateNetworks(true), WithContentType("markdown")).ParseHTML(context.Background(), html, url)
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if strings.HasPrefix(result.Content, "---") {
+		t.Errorf("Expected no frontmatter by default, got %q", result.Content)
+	}
+}
+
+func TestQuotes(t *testing.T) {
+	html := `<html><head><title>Library Saved After Protest</title></head><body><article>
+<p>The central library will stay open after the council reversed its decision following a month of protests outside the town hall.</p>
+<blockquote><p>We will not close the library while I am mayor.</p><cite>Mayor Ellen Park</cite></blockquote>
+<p>Campaigners, who collected more than eight thousand signatures, said the vote showed that local services still mattered.</p>
+<blockquote><p>This was never about books alone, it was about somewhere warm to go.</p></blockquote>
+<p>The library will now open on Sundays from next month, funded by a small increase in parking charges in the town centre.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/library")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	expected := []string{
+		"We will not close the library while I am mayor. — Mayor Ellen Park",
+		"This was never about books alone, it was about somewhere warm to go.",
+	}
+	if !reflect.DeepEqual(result.Quotes, expected) {
+		t.Errorf("Expected quotes %q, got %q", expected, result.Quotes)
+	}
+	if !strings.Contains(result.Content, "<blockquote>") {
+		t.Errorf("Expected quotes to remain in content, got %s", result.Content)
+	}
+}
+
+func TestTransportTuning(t *testing.T) {
+	config := TransportConfig{
+		MaxIdleConns:        40,
+		MaxIdleConnsPerHost: 20,
+		IdleConnTimeout:     2 * time.Minute,
+		ForceHTTP2:          true,
+		DisableKeepAlives:   true,
+	}
+
+	assertTuned := func(t *testing.T, client *Client) {
+		t.Helper()
+		transport, ok := client.httpClient.Transport.(*http.Transport)
+		if !ok {
+			t.Fatalf("Expected *http.Transport, got %T", client.httpClient.Transport)
+		}
+		if transport.MaxIdleConns != 40 || transport.MaxIdleConnsPerHost != 20 || transport.IdleConnTimeout != 2*time.Minute {
+			t.Errorf("Expected tuned pool settings, got %d/%d/%v", transport.MaxIdleConns, transport.MaxIdleConnsPerHost, transport.IdleConnTimeout)
+		}
+		if !transport.ForceAttemptHTTP2 || !transport.DisableKeepAlives {
+			t.Errorf("Expected HTTP/2 forced and keep-alives disabled, got %v/%v", transport.ForceAttemptHTTP2, transport.DisableKeepAlives)
+		}
+	}
+
+	t.Run("default client", func(t *testing.T) {
+		assertTuned(t, New(WithTransportTuning(config)))
+	})
+
+	t.Run("with timeout", func(t *testing.T) {
+		client := New(WithTimeout(5*time.Second), WithTransportTuning(config))
+		assertTuned(t, client)
+		if client.httpClient.Timeout != 5*time.Second {
+			t.Errorf("Expected timeout to be kept, got %v", client.httpClient.Timeout)
+		}
+	})
+
+	t.Run("zero values keep defaults", func(t *testing.T) {
+		transport := New(WithTransportTuning(TransportConfig{ForceHTTP2: true})).httpClient.Transport.(*http.Transport)
+		if transport.MaxIdleConns != 100 || transport.MaxIdleConnsPerHost != 10 || transport.IdleConnTimeout != 90*time.Second {
+			t.Errorf("Expected default pool settings, got %d/%d/%v", transport.MaxIdleConns, transport.MaxIdleConnsPerHost, transport.IdleConnTimeout)
+		}
+	})
+
+	t.Run("ssrf protection still applies", func(t *testing.T) {
+		_, err := New(WithTransportTuning(config)).Parse(context.Background(), "http://127.0.0.1/admin")
+		parseErr, ok := err.(*ParseError)
+		if !ok || !parseErr.IsSSRF() {
+			t.Errorf("Expected private address to be blocked, got %v", err)
+		}
+	})
+}
+
+// TestSSRFHostLists verifies allowlisted internal hosts are reachable while
+// other private addresses stay blocked, and that the denylist wins
+func TestSSRFHostLists(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><title>Internal Wiki</title></head><body><article><p>Runbooks for the on-call rotation live here and are reviewed every quarter by the platform team.</p></article></body></html>`)
+	}))
+	defer ts.Close()
+
+	assertSSRF := func(t *testing.T, err error) {
+		t.Helper()
+		parseErr, ok := err.(*ParseError)
+		if !ok || !parseErr.IsSSRF() {
+			t.Errorf("Expected SSRF error, got %v", err)
+		}
+	}
+
+	t.Run("allowlisted host is reachable", func(t *testing.T) {
+		client := New(WithSSRFAllowHosts([]string{"127.0.0.1"}))
+		result, err := client.Parse(context.Background(), ts.URL)
+		if err != nil {
+			t.Fatalf("Expected allowlisted host to parse, got %v", err)
+		}
+		if result.Title != "Internal Wiki" {
+			t.Errorf("Expected title 'Internal Wiki', got %q", result.Title)
+		}
+	})
+
+	t.Run("other private hosts stay blocked", func(t *testing.T) {
+		client := New(WithSSRFAllowHosts([]string{"127.0.0.1"}))
+		_, err := client.Parse(context.Background(), "http://10.0.0.1/admin")
+		assertSSRF(t, err)
+	})
+
+	t.Run("allowlisted range", func(t *testing.T) {
+		client := New(WithSSRFAllowHosts([]string{"127.0.0.0/8"}))
+		if _, err := client.Parse(context.Background(), ts.URL); err != nil {
+			t.Errorf("Expected address in allowlisted range to parse, got %v", err)
+		}
+	})
+
+	t.Run("denylist overrides allowlist", func(t *testing.T) {
+		client := New(WithSSRFAllowHosts([]string{"127.0.0.1"}), WithSSRFDenyHosts([]string{"127.0.0.0/8"}))
+		_, err := client.Parse(context.Background(), ts.URL)
+		assertSSRF(t, err)
+	})
+
+	t.Run("denylist overrides private networks", func(t *testing.T) {
+		client := New(WithAllowPrivateNetworks(true), WithSSRFDenyHosts([]string{"127.0.0.1"}))
+		_, err := client.Parse(context.Background(), ts.URL)
+		assertSSRF(t, err)
+	})
+
+	t.Run("denylisted wildcard blocks public hosts", func(t *testing.T) {
+		client := New(WithSSRFDenyHosts([]string{"*.example.com"}))
+		_, err := client.Parse(context.Background(), "https://blog.example.com/post")
+		assertSSRF(t, err)
+	})
+}
+
+// TestAllowDekURLs verifies deks mentioning a URL are dropped unless WithAllowDekURLs is set
+func TestAllowDekURLs(t *testing.T) {
+	html := `<html><head><title>Weekly Letters</title></head><body><article><h1>Weekly Letters</h1>
+		<p class="dek">Read past issues at https://example.com/archive before the next one lands</p>
+		<p>This week we look back at a season of letters from readers who wrote in about their gardens, their neighbours and the long wait for spring.</p>
+		<p>Several of you asked about the archive, which now holds every issue since the first one went out three years ago.</p>
+	</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/letters")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Dek != "" {
+		t.Errorf("Expected dek with a URL to be rejected by default, got %q", result.Dek)
+	}
+
+	result, err = New(WithAllowPrivateNetworks(true), WithAllowDekURLs(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/letters")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	expected := "Read past issues at https://example.com/archive before the next one lands"
+	if result.Dek != expected {
+		t.Errorf("Expected dek %q, got %q", expected, result.Dek)
+	}
+}
+
+// TestCustomContentSelectorOverlap verifies nested matches of custom content
+// selectors contribute each paragraph once
+func TestCustomContentSelectorOverlap(t *testing.T) {
+	// Route 127.0.0.3 to The Verge extractor, whose body component selector can match nested elements
+	extractor := custom.WwwThevergeComExtractor
+	supported := extractor.SupportedDomains
+	extractor.SupportedDomains = append(append([]string{}, supported...), "127.0.0.3")
+	t.Cleanup(func() { extractor.SupportedDomains = supported })
+
+	html := `<html><head><title>Launch Review</title></head><body><h1>Launch Review</h1>
+<div class="duet--article--article-body-component">
+	<p>The rocket lifted off on schedule after a quiet countdown at the coastal pad.</p>
+	<div class="duet--article--article-body-component">
+		<p>Engineers said the second stage performed better than in any previous flight.</p>
+	</div>
+</div>
+<div class="duet--article--article-body-component">
+	<p>The next launch is planned for the autumn, weather permitting.</p>
+</div>
+</body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true), WithContentType("text")).ParseHTML(context.Background(), html, "http://127.0.0.3/launch")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.ExtractorUsed != "custom:www.theverge.com" {
+		t.Fatalf("Expected The Verge extractor to be used, got %q", result.ExtractorUsed)
+	}
+	for _, sentence := range []string{
+		"The rocket lifted off on schedule",
+		"the second stage performed better",
+		"The next launch is planned",
+	} {
+		if count := strings.Count(result.Content, sentence); count != 1 {
+			t.Errorf("Expected %q once in content, found %d times: %q", sentence, count, result.Content)
+		}
+	}
+}
+
+// TestDebugScores verifies WithDebugScores exposes the ranked content
+// candidates with the block the content came from first
+func TestDebugScores(t *testing.T) {
+	html := `<html><head><title>Harbour Dredging</title></head><body>
+<div id="sidebar" class="rail"><p>Most read, today.</p><p>Sign up, it's free.</p></div>
+<div id="story" class="article-body">
+	<p>The harbour authority confirmed on Tuesday that dredging of the main channel will begin next month, ending years of delays, disputes and funding shortfalls.</p>
+	<p>Fishing crews, ferry operators and the marina have all complained that silt has made the approach dangerous at low tide, especially in winter storms.</p>
+	<p>The work is expected to take eleven weeks, and the authority says ferry timetables will change only slightly while the dredgers are working.</p>
+</div>
+</body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true), WithDebugScores(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/news/dredging")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if len(result.DebugScores) == 0 {
+		t.Fatal("Expected debug scores to be returned")
+	}
+	winner := result.DebugScores[0]
+	if !strings.Contains(winner.Path, "div#story.article-body") {
+		t.Errorf("Expected the story block to score highest, got %+v", result.DebugScores)
+	}
+	for _, candidate := range result.DebugScores[1:] {
+		if candidate.Score > winner.Score {
+			t.Errorf("Expected %+v to score no higher than the winner %+v", candidate, winner)
+		}
+	}
+	if !strings.Contains(result.Content, "dredging of the main channel") {
+		t.Errorf("Expected content to come from the story block, got %q", result.Content)
+	}
+
+	plain, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/news/dredging")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if plain.DebugScores != nil {
+		t.Errorf("Expected no debug scores by default, got %+v", plain.DebugScores)
+	}
+}
+
+func TestFreshness(t *testing.T) {
+	page := func(head string) string {
+		return `<html><head><title>Lock Gates Replaced</title>` + head + `</head><body><article>
+	<p>The canal trust replaced the upper lock gates over the weekend, reopening the flight to boats a full week earlier than planned.</p>
+	<p>Volunteers worked through the night on Saturday to seal the new gates, and the first narrowboats passed through on Monday morning.</p>
+</article></body></html>`
+	}
+	published := func(date string) string {
+		return `<meta property="article:published_time" content="` + date + `">`
+	}
+	modified := func(date string) string {
+		return `<meta property="article:modified_time" content="` + date + `">`
+	}
+	now := time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		head     string
+		expected string
+	}{
+		{"breaking", published("2024-03-05T11:30:00Z"), "breaking"},
+		{"breaking across offsets", published("2024-03-05T13:30:00+02:00"), "breaking"},
+		{"today", published("2024-03-05T02:00:00Z"), "today"},
+		{"this week", published("2024-03-01T12:00:00Z"), "this-week"},
+		{"older", published("2024-02-01T12:00:00Z"), "older"},
+		{"recent update wins", published("2024-02-01T12:00:00Z") + modified("2024-03-05T11:45:00Z"), "breaking"},
+		{"update only", modified("2024-03-04T20:00:00Z"), "today"},
+		{"no dates", "", "unknown"},
+		{"far future", published("2024-04-01T12:00:00Z"), "unknown"},
+	}
+
+	client := New(WithAllowPrivateNetworks(true), WithClock(func() time.Time { return now }))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := client.ParseHTML(context.Background(), page(tt.head), "http://127.0.0.1/news/lock-gates")
+			if err != nil {
+				t.Fatalf("ParseHTML failed: %v", err)
+			}
+			if result.Freshness != tt.expected {
+				t.Errorf("Expected freshness %q, got %q (published %v, modified %v)", tt.expected, result.Freshness, result.DatePublished, result.DateModified)
+			}
+		})
+	}
+}
+
+func TestClockResolvesRelativeDates(t *testing.T) {
+	html := `<html><head><title>Ferry Strike Called Off</title></head><body><article>
+	<p class="entry-date">Posted 3 hours ago</p>
+	<p>Ferry crews called off Friday's planned strike late on Thursday after the operator agreed to reopen talks on rosters and overtime pay.</p>
+	<p>Island residents had been stocking up on supplies, and the council had arranged extra sailings on the community boat for hospital appointments.</p>
+</article></body></html>`
+	now := time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC)
+
+	// Repeated parses agree because the clock is pinned
+	for i := 0; i < 2; i++ {
+		result, err := New(WithAllowPrivateNetworks(true), WithClock(func() time.Time { return now })).ParseHTML(context.Background(), html, "http://127.0.0.1/news/ferry-strike")
+		if err != nil {
+			t.Fatalf("ParseHTML failed: %v", err)
+		}
+		expected := now.Add(-3 * time.Hour)
+		if result.DatePublished == nil || !result.DatePublished.Equal(expected) {
+			t.Fatalf("Expected date published %v, got %v", expected, result.DatePublished)
+		}
+		if result.Freshness != "today" {
+			t.Errorf("Expected freshness %q, got %q", "today", result.Freshness)
+		}
+	}
+}
+
+func TestContentHash(t *testing.T) {
+	hash := func(html string) string {
+		t.Helper()
+		result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/news/bridge")
+		if err != nil {
+			t.Fatalf("ParseHTML failed: %v", err)
+		}
+		if result.ContentHash == "" {
+			t.Fatal("Expected a content hash")
+		}
+		return result.ContentHash
+	}
+
+	original := hash(`<html><head><title>Footbridge Opens</title></head><body><article>
+<p>The new footbridge over the railway opened on Saturday, linking the two halves of the town for the first time since the old crossing was demolished.</p>
+<p>Hundreds of residents walked across in the first hour, and the mayor said the bridge would cut the walk to the station by ten minutes.</p>
+</article></body></html>`)
+
+	// Reformatted markup, new classes and an ad block, same article text
+	cosmetic := hash(`<html><head><title>Footbridge Opens</title></head><body><article class="story story--v2">
+<p class="lede">The new   footbridge over the railway opened on Saturday,
+   linking the two halves of the town for the first time since the old crossing was demolished.</p>
+<div class="ad-banner advertisement"><a href="http://ads.example.com/">Advertisement</a></div>
+<p><span>Hundreds of residents walked across in the first hour,</span> and the mayor said the bridge would cut the walk to the station by ten minutes.</p>
+</article></body></html>`)
+
+	edited := hash(`<html><head><title>Footbridge Opens</title></head><body><article>
+<p>The new footbridge over the railway opened on Sunday, linking the two halves of the town for the first time since the old crossing was demolished.</p>
+<p>Hundreds of residents walked across in the first hour, and the mayor said the bridge would cut the walk to the station by ten minutes.</p>
+</article></body></html>`)
+
+	if cosmetic != original {
+		t.Errorf("Expected cosmetic changes to keep the hash, got %s and %s", original, cosmetic)
+	}
+	if edited == original {
+		t.Error("Expected a text change to change the hash")
+	}
+}
+
+func TestPageType(t *testing.T) {
+	story := `<p>The lifeboat crew was called out three times in a single night as storms battered the coast, rescuing two fishermen and a stranded walker.</p>
+	<p>Volunteers said it was the busiest night for the station in twenty years, and thanked the coastguard helicopter crew for their support.</p>`
+
+	tests := []struct {
+		name     string
+		html     string
+		expected string
+	}{
+		{"og video", `<html><head><title>Storm Footage</title><meta property="og:type" content="video.other"></head><body>
+<iframe src="https://www.youtube.com/embed/xyz789"></iframe><article>` + story + `</article></body></html>`, "video"},
+		{"product schema", `<html><head><title>Storm Kettle</title><script type="application/ld+json">{"@context":"https://schema.org","@type":"Product","name":"Storm Kettle","offers":{"@type":"Offer","price":"39.00"}}</script></head><body>
+<article>` + story + `</article></body></html>`, "product"},
+		{"plain article", `<html><head><title>Lifeboat Busy Night</title></head><body><article>` + story + `</article></body></html>`, "article"},
+		{"no content", `<html><head><title>Empty</title></head><body></body></html>`, "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), tt.html, "http://127.0.0.1/news/lifeboat")
+			if err != nil {
+				t.Fatalf("ParseHTML failed: %v", err)
+			}
+			if result.PageType != tt.expected {
+				t.Errorf("Expected page type %q, got %q", tt.expected, result.PageType)
+			}
+		})
+	}
+}
+
+func TestAudioURL(t *testing.T) {
+	story := `<p>This week we talk to the keeper of the last working lighthouse on the estuary about storms, shipwrecks and solitude.</p>
+	<p>She explains how the lamp was converted to solar power and why the foghorn still sounds on the first Sunday of each month.</p>`
+
+	tests := []struct {
+		name     string
+		html     string
+		expected string
+	}{
+		{"audio element", `<html><head><title>Episode 42</title></head><body><article>
+<audio controls><source src="/media/episode-42.mp3" type="audio/mpeg"></audio>` + story + `</article></body></html>`, "http://127.0.0.1/media/episode-42.mp3"},
+		{"og audio", `<html><head><title>Episode 42</title><meta property="og:audio" content="https://cdn.example.com/episode-42.mp3"></head><body>
+<article>` + story + `</article></body></html>`, "https://cdn.example.com/episode-42.mp3"},
+		{"none", `<html><head><title>Episode 42</title></head><body><article>` + story + `</article></body></html>`, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), tt.html, "http://127.0.0.1/podcast/episode-42")
+			if err != nil {
+				t.Fatalf("ParseHTML failed: %v", err)
+			}
+			if result.AudioURL != tt.expected {
+				t.Errorf("Expected audio URL %q, got %q", tt.expected, result.AudioURL)
+			}
+		})
+	}
+}
+
+func TestLocation(t *testing.T) {
+	html := `<html><head><title>Library Reopens</title>
+<script type="application/ld+json">{"@context":"https://schema.org","@type":"NewsArticle","headline":"Library Reopens","contentLocation":{"@type":"Place","name":"Central Library","address":{"@type":"PostalAddress","addressLocality":"Springfield","addressRegion":"IL"},"geo":{"@type":"GeoCoordinates","latitude":39.8,"longitude":-89.64}}}</script>
+</head><body><article>
+<p>The central library reopened on Saturday after a two-year renovation that added a children's wing and a rooftop reading garden.</p>
+<p>Hundreds of residents queued before the doors opened, and the first thousand visitors received a commemorative bookmark.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/news/library")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Location != "Central Library, Springfield, IL" {
+		t.Errorf("Expected location from contentLocation, got %q", result.Location)
+	}
+	if result.Geo == nil || result.Geo.Latitude != 39.8 || result.Geo.Longitude != -89.64 {
+		t.Errorf("Expected coordinates from the place, got %+v", result.Geo)
+	}
+
+	meta := `<html><head><title>Harbour Festival</title><meta name="geo.placename" content="Galway, Ireland"></head><body><article>
+<p>The harbour festival returned this weekend with tall ships, sea shanties and a lantern parade along the quays at dusk.</p>
+<p>Organisers said more than forty thousand people visited over the three days, the highest attendance in the festival's history.</p>
+</article></body></html>`
+	result, err = New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), meta, "http://127.0.0.1/news/festival")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Location != "Galway, Ireland" || result.Geo != nil {
+		t.Errorf("Expected location from geo.placename and no coordinates, got %q and %+v", result.Location, result.Geo)
+	}
+}
+
+func TestStripEmojiAndInvisibleCharacters(t *testing.T) {
+	body := `<article>
+<p>The new ferry 🚢 entered service on Monday, cutting the crossing to the island from forty minutes to twenty-five.</p>
+<p>Commuters welcomed the change, though some said the early sailings still fill up before the` + "\u200B" + ` second bus arrives.</p>
+</article>`
+	emojiTitle := `<html><head><title>🎉 Ferry Launch Day 🎉</title></head><body>` + body + `</body></html>`
+	zeroWidthTitle := "<html><head><title>Ferry\u200B Timetable\u200D Changes\uFEFF</title></head><body>" + body + "</body></html>"
+
+	// Emoji are kept by default, zero-width characters never are
+	result, err := New(WithAllowPrivateNetworks(true), WithContentType("text")).ParseHTML(context.Background(), emojiTitle, "http://127.0.0.1/news/ferry")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Title != "🎉 Ferry Launch Day 🎉" {
+		t.Errorf("Expected emoji kept by default, got %q", result.Title)
+	}
+	if strings.Contains(result.Content, "\u200B") {
+		t.Errorf("Expected zero-width spaces removed from text content, got %q", result.Content)
+	}
+
+	result, err = New(WithAllowPrivateNetworks(true), WithContentType("text")).ParseHTML(context.Background(), zeroWidthTitle, "http://127.0.0.1/news/ferry")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Title != "Ferry Timetable Changes" {
+		t.Errorf("Expected zero-width characters removed from the title, got %q", result.Title)
+	}
+
+	result, err = New(WithAllowPrivateNetworks(true), WithContentType("text"), WithStripEmoji(true)).ParseHTML(context.Background(), emojiTitle, "http://127.0.0.1/news/ferry")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Title != "Ferry Launch Day" {
+		t.Errorf("Expected emoji stripped from the title, got %q", result.Title)
+	}
+	if strings.Contains(result.Content, "🚢") || !strings.Contains(result.Content, "The new ferry entered service") {
+		t.Errorf("Expected emoji stripped from text content, got %q", result.Content)
+	}
+}
+
+func TestMaxExtractionConcurrency(t *testing.T) {
+	html := `<html><head>
+<meta name="description" content="The island ferry now runs every half hour.">
+<meta name="author" content="Dana Reyes">
+<title>Ferry Timetable Changes | Harbor Gazette</title>
+</head><body><article>
+<h1>Ferry Timetable Changes</h1>
+<p>The new ferry entered service on Monday, cutting the crossing to the island from forty minutes to twenty-five.</p>
+<p>Commuters welcomed the change, though some said the early sailings still fill up before the second bus arrives.</p>
+</article></body></html>`
+
+	parallel, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/news/ferry")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	// Sequential and bounded extraction produce the same result as unbounded
+	for _, n := range []int{1, 3} {
+		result, err := New(WithAllowPrivateNetworks(true), WithMaxExtractionConcurrency(n)).ParseHTML(context.Background(), html, "http://127.0.0.1/news/ferry")
+		if err != nil {
+			t.Fatalf("ParseHTML with limit %d failed: %v", n, err)
+		}
+		if result.Title != parallel.Title || result.Author != parallel.Author || result.SiteName != parallel.SiteName ||
+			result.Description != parallel.Description || result.Content != parallel.Content {
+			t.Errorf("limit %d: expected the same result as unbounded extraction, got title %q and author %q",
+				n, result.Title, result.Author)
+		}
+	}
+	if parallel.Title != "Ferry Timetable Changes" || !strings.Contains(parallel.Content, "forty minutes") {
+		t.Errorf("Expected title and content extracted, got %q and %q", parallel.Title, parallel.Content)
+	}
+}
+
+func TestLeadImageDimensions(t *testing.T) {
+	body := `<article>
+<p>The new ferry entered service on Monday, cutting the crossing to the island from forty minutes to twenty-five.</p>
+<figure><img src="http://127.0.0.1/photos/ferry-large.jpg" width="1024" height="683" alt="The new ferry"></figure>
+<p>Commuters welcomed the change, though some said the early sailings still fill up before the second bus arrives.</p>
+</article>`
+
+	meta := `<html><head><title>Ferry Timetable Changes</title>
+<meta property="og:image" content="http://127.0.0.1/photos/ferry-card.jpg">
+<meta property="og:image:width" content="1200">
+<meta property="og:image:height" content="630">
+</head><body>` + body + `</body></html>`
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), meta, "http://127.0.0.1/news/ferry")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.LeadImageURL != "http://127.0.0.1/photos/ferry-card.jpg" || result.LeadImageWidth != 1200 || result.LeadImageHeight != 630 {
+		t.Errorf("Expected the og:image at 1200x630, got %q at %dx%d", result.LeadImageURL, result.LeadImageWidth, result.LeadImageHeight)
+	}
+
+	content := `<html><head><title>Ferry Timetable Changes</title></head><body>` + body + `</body></html>`
+	result, err = New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), content, "http://127.0.0.1/news/ferry")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.LeadImageURL != "http://127.0.0.1/photos/ferry-large.jpg" || result.LeadImageWidth != 1024 || result.LeadImageHeight != 683 {
+		t.Errorf("Expected the content image at 1024x683, got %q at %dx%d", result.LeadImageURL, result.LeadImageWidth, result.LeadImageHeight)
+	}
+}
+
+func TestKeepEmbedHosts(t *testing.T) {
+	html := `<html><head><title>Ferry Timetable Changes</title></head><body><article>
+<p>The new ferry entered service on Monday, cutting the crossing to the island from forty minutes to twenty-five.</p>
+<iframe src="https://platform.twitter.com/embed/Tweet.html?id=1234567890" width="550" height="400"></iframe>
+<p>Commuters welcomed the change, though some said the early sailings still fill up before the second bus arrives.</p>
+<iframe src="https://tracker.adnetwork.example/frame?campaign=ferry" width="1" height="1"></iframe>
+<iframe src="https://datawrapper.dwcdn.net/AbCdE/1/" width="600" height="400"></iframe>
+<p>The operator plans to add a late sailing on Fridays during the summer season, pending a review of demand.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/news/ferry")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if !strings.Contains(result.Content, `src="https://platform.twitter.com/embed/Tweet.html?id=1234567890"`) {
+		t.Errorf("Expected the Twitter embed kept, got %q", result.Content)
+	}
+	if strings.Contains(result.Content, "adnetwork") {
+		t.Errorf("Expected the tracking iframe dropped, got %q", result.Content)
+	}
+	if strings.Contains(result.Content, "datawrapper") {
+		t.Errorf("Expected an unlisted embed dropped by default, got %q", result.Content)
+	}
+
+	result, err = New(WithAllowPrivateNetworks(true), WithKeepEmbedHosts([]string{"dwcdn.net"})).ParseHTML(context.Background(), html, "http://127.0.0.1/news/ferry")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if !strings.Contains(result.Content, `src="https://datawrapper.dwcdn.net/AbCdE/1/"`) || !strings.Contains(result.Content, "platform.twitter.com") {
+		t.Errorf("Expected the configured and built-in embeds kept, got %q", result.Content)
+	}
+	if strings.Contains(result.Content, "adnetwork") {
+		t.Errorf("Expected the tracking iframe still dropped, got %q", result.Content)
+	}
+}
+
+func TestFaviconFallback(t *testing.T) {
+	var headRequests int32
+	withIcon := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/favicon.ico" {
+			if r.Method == http.MethodHead {
+				atomic.AddInt32(&headRequests, 1)
+			}
+			w.Header().Set("Content-Type", "image/x-icon")
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer withIcon.Close()
+	withoutIcon := httptest.NewServer(http.NotFoundHandler())
+	defer withoutIcon.Close()
+
+	undeclared := `<html><head><title>Harbour Works</title></head><body><article><p>Repairs to the harbour wall will close the north pier for six weeks from the start of next month.</p></article></body></html>`
+	declared := `<html><head><title>Harbour Works</title><link rel="icon" href="https://cdn.example.com/harbour/icon.png"></head><body><article><p>Repairs to the harbour wall will close the north pier for six weeks from the start of next month.</p></article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), undeclared, withoutIcon.URL+"/news/harbour")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Favicon != withoutIcon.URL+"/favicon.ico" {
+		t.Errorf("Expected the unverified fallback %q, got %q", withoutIcon.URL+"/favicon.ico", result.Favicon)
+	}
+
+	verifying := New(WithAllowPrivateNetworks(true), WithVerifyFavicon(true))
+	result, err = verifying.ParseHTML(context.Background(), undeclared, withIcon.URL+"/news/harbour")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Favicon != withIcon.URL+"/favicon.ico" {
+		t.Errorf("Expected the verified fallback %q, got %q", withIcon.URL+"/favicon.ico", result.Favicon)
+	}
+	if atomic.LoadInt32(&headRequests) != 1 {
+		t.Errorf("Expected one HEAD request for the fallback, got %d", headRequests)
+	}
+
+	result, err = verifying.ParseHTML(context.Background(), undeclared, withoutIcon.URL+"/news/harbour")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Favicon != "" {
+		t.Errorf("Expected a missing fallback dropped, got %q", result.Favicon)
+	}
+
+	result, err = verifying.ParseHTML(context.Background(), declared, withIcon.URL+"/news/harbour")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Favicon != "https://cdn.example.com/harbour/icon.png" {
+		t.Errorf("Expected the declared icon unchanged, got %q", result.Favicon)
+	}
+	if atomic.LoadInt32(&headRequests) != 1 {
+		t.Errorf("Expected declared icons not to be requested, got %d HEAD requests", headRequests)
+	}
+}
+
+func TestThemeColor(t *testing.T) {
+	html := `<html><head><title>Tide Tables</title>
+<meta name="theme-color" media="(prefers-color-scheme: dark)" content="#0b1f33">
+<meta name="theme-color" media="(prefers-color-scheme: light)" content="#e6f2ff">
+</head><body><article><p>High tide on Saturday falls just after seven in the morning, with a second peak shortly before eight in the evening.</p></article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), html, "http://127.0.0.1/tides")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.ThemeColor != "#e6f2ff" {
+		t.Errorf("Expected the light scheme theme color, got %q", result.ThemeColor)
+	}
+
+	result, err = New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), `<html><head><meta name="theme-color" content="not-a-color"></head><body><p>Tides</p></body></html>`, "http://127.0.0.1/tides")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.ThemeColor != "" {
+		t.Errorf("Expected an invalid theme color dropped, got %q", result.ThemeColor)
+	}
+}
+
+func TestTextSentencesContentType(t *testing.T) {
+	html := `<html><head><title>Bridge Reopens</title></head><body><article>
+<p>Dr. Patel cut the ribbon at 9 a.m. on Monday. The bridge had been closed for two years! Did anyone expect it to reopen on time?</p>
+<p>Traffic was light for most of the morning, according to the council, and the first buses crossed shortly after ten.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true), WithContentType("text-sentences")).ParseHTML(context.Background(), html, "http://127.0.0.1/news/bridge")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+
+	expected := "Dr. Patel cut the ribbon at 9 a.m. on Monday.\n" +
+		"The bridge had been closed for two years!\n" +
+		"Did anyone expect it to reopen on time?\n\n" +
+		"Traffic was light for most of the morning, according to the council, and the first buses crossed shortly after ten."
+	if result.Content != expected {
+		t.Errorf("Expected one sentence per line:\n%q\ngot:\n%q", expected, result.Content)
+	}
+	if result.WordCount == 0 {
+		t.Error("Expected a word count for sentence output")
+	}
+}
+
+func TestImageAndEmbedCounts(t *testing.T) {
+	html := `<html><head><title>Coastal Path Guide</title></head><body><article>
+<p>The coastal path runs for twelve miles between the two harbours, with steep climbs near the lighthouse and gentle stretches through the dunes.</p>
+<img src="http://127.0.0.1/images/cliffs.jpg" width="800" height="600" alt="Cliffs above the path">
+<p>Walkers should allow most of a day, and the cafe at the halfway point closes at four outside the summer season.</p>
+<iframe src="https://www.youtube.com/embed/dQw4w9WgXcQ" width="560" height="315"></iframe>
+<img src="http://127.0.0.1/images/dunes.jpg" width="800" height="600" alt="Dunes at low tide">
+<iframe src="https://tracker.adnetwork.example/frame?campaign=coast" width="1" height="1"></iframe>
+<p>Parking is limited at both ends, so the council recommends taking the coastal bus, which runs every hour in both directions.</p>
+</article></body></html>`
+
+	for _, contentType := range []string{"html", "text"} {
+		result, err := New(WithAllowPrivateNetworks(true), WithContentType(contentType)).ParseHTML(context.Background(), html, "http://127.0.0.1/guides/coastal-path")
+		if err != nil {
+			t.Fatalf("ParseHTML failed: %v", err)
+		}
+		if result.ImageCount != 2 {
+			t.Errorf("%s: expected 2 images, got %d", contentType, result.ImageCount)
+		}
+		if result.EmbedCount != 1 {
+			t.Errorf("%s: expected the YouTube embed counted and the tracking frame not, got %d embeds", contentType, result.EmbedCount)
+		}
+	}
+}
+
+func TestWithFallback(t *testing.T) {
+	html := `<html><head><title>Service Status</title></head><body><main>All systems normal</main></body></html>`
+
+	tests := []struct {
+		name     string
+		opts     []Option
+		expected string
+	}{
+		{"default", nil, ""},
+		{"enabled", []Option{WithFallback(true)}, "All systems normal"},
+		{"disabled", []Option{WithFallback(false)}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := New(append([]Option{WithAllowPrivateNetworks(true)}, tt.opts...)...)
+			result, err := client.ParseHTML(context.Background(), html, "http://127.0.0.1/status")
+			if err != nil {
+				t.Fatalf("ParseHTML failed: %v", err)
+			}
+			if result.Content != tt.expected {
+				t.Errorf("Expected content %q, got %q", tt.expected, result.Content)
+			}
+		})
+	}
+}
+
+func TestWarnings(t *testing.T) {
+	mismatched := `<html lang="en" dir="ltr"><head><title>خطة الميناء الجديدة</title></head><body><article>
+<p>وافق المجلس البلدي مساء الثلاثاء على خطة الميناء الجديدة بعد أشهر من النقاش، ومن المتوقع أن تبدأ أعمال البناء في الربيع المقبل.</p>
+<p>وقال سكان حضروا الجلسة إن الخطة تغيرت كثيرا منذ المسودة الأولى، لكن أسئلة كثيرة لا تزال دون إجابة حول التكلفة والجدول الزمني.</p>
+</article></body></html>`
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), mismatched, "http://127.0.0.1/news/harbour")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	expected := []string{
+		`declared language "en" does not match content written in Arabic script`,
+		`declared direction "ltr" does not match content written in Arabic script`,
+	}
+	if !reflect.DeepEqual(result.Warnings, expected) {
+		t.Errorf("Expected warnings %q, got %q", expected, result.Warnings)
+	}
+
+	matched := `<html lang="en"><head><title>New Harbour Plan Approved</title></head><body><article>
+<p>The council approved the new harbour plan on Tuesday evening after months of debate, and construction is expected to begin next spring.</p>
+<p>Residents who attended the meeting said the plan had changed considerably since the first draft, though questions remain about cost.</p>
+</article></body></html>`
+
+	result, err = New(WithAllowPrivateNetworks(true)).ParseHTML(context.Background(), matched, "http://127.0.0.1/news/harbour")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if len(result.Warnings) != 0 {
+		t.Errorf("Expected no warnings, got %q", result.Warnings)
+	}
+
+	result, err = New(WithAllowPrivateNetworks(true), WithFallback(true)).ParseHTML(context.Background(), `<html><head><title>Service Status</title></head><body><main>All systems normal</main></body></html>`, "http://127.0.0.1/status")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if !reflect.DeepEqual(result.Warnings, []string{"content extracted by fallback"}) {
+		t.Errorf("Expected a fallback warning, got %q", result.Warnings)
+	}
+}
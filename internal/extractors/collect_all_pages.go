@@ -7,7 +7,11 @@
 package extractors
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/BumpyClock/hermes/internal/extractors/generic"
@@ -19,6 +23,15 @@ type ResourceInterface interface {
 	Create(url string, preparedResponse string, parsedURL interface{}, headers map[string]string) (*goquery.Document, error)
 }
 
+// ContextResourceInterface is implemented by resources that can abandon a fetch when its context ends
+type ContextResourceInterface interface {
+	CreateWithContext(ctx context.Context, url string, preparedResponse string, parsedURL interface{}, headers map[string]string) (*goquery.Document, error)
+}
+
+// ErrResourceNotCancellable is returned when PageTimeout is set but the resource
+// does not implement ContextResourceInterface
+var ErrResourceNotCancellable = errors.New("page timeout requires a resource implementing CreateWithContext")
+
 // Use existing RootExtractorInterface from root_extractor.go
 
 // CollectAllPagesOptions contains all parameters needed for multi-page collection
@@ -35,7 +48,17 @@ type CollectAllPagesOptions struct {
 	Resource      ResourceInterface
 	RootExtractor *RootExtractorInterface
 	
-	// Placeholder for future enhancements
+	// Context bounds the whole collection, nil means no deadline
+	Context context.Context
+	// PageTimeout bounds each page fetch; a page that exceeds it is skipped, 0 disables
+	PageTimeout time.Duration
+	// PageSeparator returns the HTML placed before page pageNum, nil uses DefaultPageSeparator
+	PageSeparator func(pageNum int) string
+}
+
+// DefaultPageSeparator is the JavaScript-compatible separator placed between merged pages
+func DefaultPageSeparator(pageNum int) string {
+	return fmt.Sprintf("<hr><h4>Page %d</h4>", pageNum)
 }
 
 // CollectAllPages collects and merges content from multiple pages of an article
@@ -43,13 +66,24 @@ type CollectAllPagesOptions struct {
 // - Page counter starting at 1 (first page already fetched) 
 // - 26-page safety limit to prevent infinite loops
 // - URL deduplication using RemoveAnchor utility
-// - Progressive content concatenation with <hr><h4>Page N</h4> separators
+// - Progressive content concatenation with <hr><h4>Page N</h4> separators (see PageSeparator)
 // - Final word count calculation for combined content
 func CollectAllPages(opts CollectAllPagesOptions) map[string]interface{} {
 	
 	// Otherwise, use the original JavaScript-compatible implementation
 	// At this point, we've fetched just the first page
 	pages := 1
+	renderedPages := 1
+	
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	
+	separator := opts.PageSeparator
+	if separator == nil {
+		separator = DefaultPageSeparator
+	}
 	
 	// Track previous URLs to prevent cycles - use RemoveAnchor for consistency
 	previousUrls := []string{text.RemoveAnchor(opts.URL)}
@@ -66,12 +100,24 @@ func CollectAllPages(opts CollectAllPagesOptions) map[string]interface{} {
 	// If we've gone over 26 pages, something has likely gone wrong.
 	// This matches the JavaScript safety limit exactly
 	for nextPageURL != "" && pages < 26 {
+		// The overall deadline ends collection with whatever has been merged so far
+		if ctx.Err() != nil {
+			break
+		}
+		
 		pages++ // Increment page counter (JavaScript: pages += 1)
 		
 		// Fetch the next page using the resource interface
 		// This matches JavaScript: $ = await Resource.create(next_page_url)
-		doc, err := opts.Resource.Create(nextPageURL, "", nil, nil)
+		doc, err := fetchPage(ctx, opts, nextPageURL)
 		if err != nil {
+			// A stalled page is skipped when the following page's URL can be inferred
+			if ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded) {
+				previousUrls = append(previousUrls, text.RemoveAnchor(nextPageURL))
+				if nextPageURL = incrementPageNumber(nextPageURL); nextPageURL != "" {
+					continue
+				}
+			}
 			// If resource fetch fails, break the loop and return what we have
 			break
 		}
@@ -120,9 +166,10 @@ func CollectAllPages(opts CollectAllPagesOptions) map[string]interface{} {
 			nextContent = content
 		}
 		
-		// Format: current_content + <hr><h4>Page N</h4> + next_page_content
-		mergedContent := fmt.Sprintf("%s<hr><h4>Page %d</h4>%s", currentContent, pages, nextContent)
+		// Format: current_content + separator (<hr><h4>Page N</h4> by default) + next_page_content
+		mergedContent := currentContent + separator(pages) + nextContent
 		result["content"] = mergedContent
+		renderedPages++
 		
 		// Get next page URL for the loop
 		// JavaScript: next_page_url = nextPageResult.next_page_url
@@ -178,8 +225,49 @@ func CollectAllPages(opts CollectAllPagesOptions) map[string]interface{} {
 		
 		// Add pagination-specific fields
 		"total_pages":    pages,
-		"rendered_pages": pages,
+		"rendered_pages": renderedPages,
 		"word_count":     wordCount,
 	}
 }
 
+
+// fetchPage fetches a single page, bounded by PageTimeout when one is set.
+// Resources without context support are abandoned in the background once the deadline passes.
+func fetchPage(ctx context.Context, opts CollectAllPagesOptions, pageURL string) (*goquery.Document, error) {
+	if opts.PageTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.PageTimeout)
+		defer cancel()
+	}
+	
+	if resource, ok := opts.Resource.(ContextResourceInterface); ok {
+		return resource.CreateWithContext(ctx, pageURL, "", nil, nil)
+	}
+	// A plain resource cannot be stopped once started, so a deadline could only
+	// be honored by leaking the fetch in a goroutine
+	if opts.PageTimeout > 0 {
+		return nil, ErrResourceNotCancellable
+	}
+	return opts.Resource.Create(pageURL, "", nil, nil)
+}
+
+// incrementPageNumber guesses the URL of the page after pageURL from its page number,
+// e.g. ?page=2 becomes ?page=3. Returns "" when the URL carries no page number.
+func incrementPageNumber(pageURL string) string {
+	loc := text.PAGE_IN_HREF_RE.FindAllStringSubmatchIndex(pageURL, -1)
+	if len(loc) == 0 {
+		return ""
+	}
+	// The page number is the last match's final capture group. Bare numbers such as
+	// date segments in /2024/05/ carry no page label and are never incremented.
+	match := loc[len(loc)-1]
+	start, end := match[12], match[13]
+	if start < 0 || match[2] < 0 || match[2] == match[3] {
+		return ""
+	}
+	num, err := strconv.Atoi(pageURL[start:end])
+	if err != nil {
+		return ""
+	}
+	return pageURL[:start] + strconv.Itoa(num+1) + pageURL[end:]
+}
@@ -4,9 +4,11 @@
 package extractors
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/stretchr/testify/assert"
@@ -473,4 +475,169 @@ func TestCollectAllPages_ErrorHandling(t *testing.T) {
 		// Resource should have been called but failed
 		assert.Equal(t, 1, mockResource.CallCount)
 	})
-}
\ No newline at end of file
+}
+
+// SlowResource delays selected pages to simulate a stalled server
+type SlowResource struct {
+	MockResource
+	Delays map[string]time.Duration
+}
+
+func (s *SlowResource) CreateWithContext(ctx context.Context, url string, preparedResponse string, parsedURL interface{}, headers map[string]string) (*goquery.Document, error) {
+	select {
+	case <-time.After(s.Delays[url]):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return s.MockResource.Create(url, preparedResponse, parsedURL, headers)
+}
+
+func paginatedPageHTML(page int) string {
+	paragraph := fmt.Sprintf("<p>Page %d of the serialized story continues the narrative with enough prose, detail, and commas, that the content scorer treats it as the body of the article.</p>", page)
+	return fmt.Sprintf(`<html><head><title>Serialized Story</title></head><body>
+		<article class="article-content">%s%s%s</article>
+	</body></html>`, paragraph, paragraph, paragraph)
+}
+
+func TestCollectAllPages_PageTimeout(t *testing.T) {
+	t.Run("should skip a stalled page and continue with the next", func(t *testing.T) {
+		mockResource := &SlowResource{
+			MockResource: MockResource{
+				PageResponses: map[string]string{
+					"http://example.com/story?page=2": paginatedPageHTML(2),
+					"http://example.com/story?page=3": paginatedPageHTML(3),
+				},
+			},
+			Delays: map[string]time.Duration{
+				"http://example.com/story?page=2": 2 * time.Second,
+			},
+		}
+
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(paginatedPageHTML(1)))
+		require.NoError(t, err)
+
+		start := time.Now()
+		result := CollectAllPages(CollectAllPagesOptions{
+			NextPageURL: "http://example.com/story?page=2",
+			Doc:         doc,
+			Result: map[string]interface{}{
+				"title":   "Serialized Story",
+				"content": "<p>Page 1 of the serialized story.</p>",
+			},
+			Extractor:     map[string]interface{}{"domain": "*"},
+			URL:           "http://example.com/story",
+			Resource:      mockResource,
+			RootExtractor: &RootExtractorInterface{},
+			PageTimeout:   100 * time.Millisecond,
+		})
+
+		assert.Less(t, time.Since(start), time.Second, "stalled page should be abandoned")
+
+		content := result["content"].(string)
+		assert.Contains(t, content, "Page 1 of the serialized story")
+		assert.NotContains(t, content, "Page 2 of the serialized story")
+		assert.Contains(t, content, "<hr><h4>Page 3</h4>")
+		assert.Contains(t, content, "Page 3 of the serialized story")
+		assert.Equal(t, 3, result["total_pages"])
+		assert.Equal(t, 2, result["rendered_pages"])
+	})
+
+	t.Run("overall deadline stops collection", func(t *testing.T) {
+		mockResource := &MockResource{
+			PageResponses: map[string]string{
+				"http://example.com/story?page=2": paginatedPageHTML(2),
+			},
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		result := CollectAllPages(CollectAllPagesOptions{
+			NextPageURL:   "http://example.com/story?page=2",
+			Result:        map[string]interface{}{"content": "<p>Page 1</p>"},
+			Extractor:     map[string]interface{}{"domain": "*"},
+			URL:           "http://example.com/story",
+			Resource:      mockResource,
+			RootExtractor: &RootExtractorInterface{},
+			Context:       ctx,
+			PageTimeout:   time.Second,
+		})
+
+		assert.Equal(t, 0, mockResource.CallCount)
+		assert.Equal(t, "<p>Page 1</p>", result["content"])
+		assert.Equal(t, 1, result["total_pages"])
+	})
+
+	t.Run("resource without context support is not fetched", func(t *testing.T) {
+		mockResource := &MockResource{
+			PageResponses: map[string]string{
+				"http://example.com/story?page=2": paginatedPageHTML(2),
+			},
+		}
+
+		_, err := fetchPage(context.Background(), CollectAllPagesOptions{
+			Resource:    mockResource,
+			PageTimeout: time.Second,
+		}, "http://example.com/story?page=2")
+
+		assert.ErrorIs(t, err, ErrResourceNotCancellable)
+		assert.Equal(t, 0, mockResource.CallCount)
+	})
+}
+
+func TestCollectAllPages_PageSeparator(t *testing.T) {
+	collect := func(separator func(int) string) string {
+		mockResource := &MockResource{
+			PageResponses: map[string]string{
+				"http://example.com/story?page=2": paginatedPageHTML(2),
+			},
+		}
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(paginatedPageHTML(1)))
+		require.NoError(t, err)
+
+		result := CollectAllPages(CollectAllPagesOptions{
+			NextPageURL:   "http://example.com/story?page=2",
+			Doc:           doc,
+			Result:        map[string]interface{}{"content": "<p>Page 1 of the serialized story.</p>"},
+			Extractor:     map[string]interface{}{"domain": "*"},
+			URL:           "http://example.com/story",
+			Resource:      mockResource,
+			RootExtractor: &RootExtractorInterface{},
+			PageSeparator: separator,
+		})
+		return result["content"].(string)
+	}
+
+	t.Run("default separator", func(t *testing.T) {
+		assert.Contains(t, collect(nil), "<p>Page 1 of the serialized story.</p><hr><h4>Page 2</h4>")
+	})
+
+	t.Run("custom separator", func(t *testing.T) {
+		content := collect(func(pageNum int) string {
+			return fmt.Sprintf(`<div class="page-break" data-page="%d"></div>`, pageNum)
+		})
+		assert.Contains(t, content, `<p>Page 1 of the serialized story.</p><div class="page-break" data-page="2"></div>`)
+		assert.NotContains(t, content, "<h4>Page 2</h4>")
+	})
+
+	t.Run("empty separator concatenates pages", func(t *testing.T) {
+		content := collect(func(int) string { return "" })
+		assert.NotContains(t, content, "<hr>")
+		assert.Regexp(t, `^<p>Page 1 of the serialized story\.</p><[a-z]`, content)
+		assert.Contains(t, content, "Page 2 of the serialized story")
+	})
+}
+
+func TestIncrementPageNumber(t *testing.T) {
+	tests := map[string]string{
+		"http://example.com/story?page=2":            "http://example.com/story?page=3",
+		"http://example.com/story/page/9":            "http://example.com/story/page/10",
+		"http://example.com/2024/05/story?pagenum=4": "http://example.com/2024/05/story?pagenum=5",
+		"http://example.com/2024/05/story":           "",
+		"http://example.com/story-without-pages":     "",
+	}
+
+	for input, expected := range tests {
+		assert.Equal(t, expected, incrementPageNumber(input), input)
+	}
+}
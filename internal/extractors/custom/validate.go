@@ -0,0 +1,235 @@
+// ABOUTME: Static validation for custom extractor definitions so selector typos surface before runtime
+// ABOUTME: Checks required fields, selector shapes, CSS syntax of selectors and clean rules, and transform targets
+
+package custom
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/andybalholm/cascadia"
+	"golang.org/x/net/html/atom"
+)
+
+// Tag names must be a bare lowercase element name like "div" or "h1"
+var tagNameRE = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+// ValidateExtractor lints a custom extractor definition and returns every problem found.
+// An empty result means the extractor is well-formed.
+func ValidateExtractor(e *CustomExtractor) []error {
+	if e == nil {
+		return []error{fmt.Errorf("extractor is nil")}
+	}
+
+	var errs []error
+	if strings.TrimSpace(e.Domain) == "" {
+		errs = append(errs, fmt.Errorf("domain: required"))
+	}
+	for i, domain := range e.SupportedDomains {
+		if strings.TrimSpace(domain) == "" {
+			errs = append(errs, fmt.Errorf("supportedDomains[%d]: empty domain", i))
+		}
+	}
+
+	if e.Title == nil && e.Content == nil {
+		errs = append(errs, fmt.Errorf("extractor defines neither title nor content"))
+	}
+
+	fields := []struct {
+		name  string
+		field *FieldExtractor
+	}{
+		{"title", e.Title},
+		{"author", e.Author},
+		{"date_published", e.DatePublished},
+		{"lead_image_url", e.LeadImageURL},
+		{"dek", e.Dek},
+		{"next_page_url", e.NextPageURL},
+		{"excerpt", e.Excerpt},
+	}
+	for _, f := range fields {
+		if f.field != nil {
+			errs = append(errs, validateSelectors(f.name, f.field.Selectors, false)...)
+		}
+	}
+
+	// Sort extend keys so the report is stable
+	extendNames := make([]string, 0, len(e.Extend))
+	for name := range e.Extend {
+		extendNames = append(extendNames, name)
+	}
+	sort.Strings(extendNames)
+	for _, name := range extendNames {
+		if field := e.Extend[name]; field != nil {
+			errs = append(errs, validateSelectors("extend."+name, field.Selectors, false)...)
+		}
+	}
+
+	if e.Content != nil {
+		errs = append(errs, validateContent(e.Content)...)
+	}
+
+	return errs
+}
+
+// validateContent checks content selectors, clean selectors and transforms
+func validateContent(content *ContentExtractor) []error {
+	var errs []error
+	if content.FieldExtractor == nil || len(content.Selectors) == 0 {
+		errs = append(errs, fmt.Errorf("content: selectors required"))
+	} else {
+		errs = append(errs, validateSelectors("content", content.Selectors, true)...)
+	}
+
+	for i, selector := range content.Clean {
+		if err := validateCSS(selector); err != nil {
+			errs = append(errs, fmt.Errorf("content.clean[%d]: %w", i, err))
+		}
+	}
+
+	selectors := make([]string, 0, len(content.Transforms))
+	for selector := range content.Transforms {
+		selectors = append(selectors, selector)
+	}
+	sort.Strings(selectors)
+	for _, selector := range selectors {
+		if err := validateCSS(selector); err != nil {
+			errs = append(errs, fmt.Errorf("content.transforms[%q]: %w", selector, err))
+		}
+		switch transform := content.Transforms[selector].(type) {
+		case nil:
+			errs = append(errs, fmt.Errorf("content.transforms[%q]: transform is nil", selector))
+		case *StringTransform:
+			if !isValidTagName(transform.TargetTag) {
+				errs = append(errs, fmt.Errorf("content.transforms[%q]: invalid target tag %q", selector, transform.TargetTag))
+			}
+		case *FunctionTransform:
+			if transform.Fn == nil {
+				errs = append(errs, fmt.Errorf("content.transforms[%q]: function is nil", selector))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateSelectors checks each selector entry. Field selectors are either a CSS string
+// or a [selector, attribute] pair; content selectors may also be a multi-match list.
+// An empty list disables the field and is allowed.
+func validateSelectors(field string, selectors []interface{}, isContent bool) []error {
+	var errs []error
+	for i, entry := range selectors {
+		prefix := fmt.Sprintf("%s.selectors[%d]", field, i)
+
+		var parts []string
+		switch sel := entry.(type) {
+		case string:
+			if err := validateCSS(sel); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", prefix, err))
+			}
+			continue
+		case []string:
+			parts = sel
+		case []interface{}:
+			// A third element may post-process the attribute value
+			if !isContent && len(sel) == 3 {
+				if _, ok := sel[2].(func(string) string); ok {
+					sel = sel[:2]
+				}
+			}
+			parts = make([]string, 0, len(sel))
+			for j, part := range sel {
+				str, ok := part.(string)
+				if !ok {
+					errs = append(errs, fmt.Errorf("%s[%d]: expected string, got %T", prefix, j, part))
+					continue
+				}
+				parts = append(parts, str)
+			}
+			if len(parts) != len(sel) {
+				continue
+			}
+		default:
+			errs = append(errs, fmt.Errorf("%s: expected string or array, got %T", prefix, entry))
+			continue
+		}
+
+		if isContent {
+			// Content arrays list selectors that must all match
+			if len(parts) == 0 {
+				errs = append(errs, fmt.Errorf("%s: empty selector array", prefix))
+			}
+			for j, part := range parts {
+				if err := validateCSS(part); err != nil {
+					errs = append(errs, fmt.Errorf("%s[%d]: %w", prefix, j, err))
+				}
+			}
+			continue
+		}
+
+		if len(parts) != 2 {
+			errs = append(errs, fmt.Errorf("%s: [selector, attribute] pair must have 2 elements, got %d", prefix, len(parts)))
+			continue
+		}
+		if err := validateCSS(parts[0]); err != nil {
+			errs = append(errs, fmt.Errorf("%s[0]: %w", prefix, err))
+		}
+		if strings.TrimSpace(parts[1]) == "" {
+			errs = append(errs, fmt.Errorf("%s[1]: empty attribute name", prefix))
+		}
+	}
+
+	return errs
+}
+
+// validateCSS reports whether selector is syntactically valid CSS
+func validateCSS(selector string) error {
+	if strings.TrimSpace(selector) == "" {
+		return fmt.Errorf("empty selector")
+	}
+	if _, err := cascadia.Compile(selector); err != nil {
+		return fmt.Errorf("invalid CSS selector %q: %w", selector, err)
+	}
+	return nil
+}
+
+// isValidTagName reports whether tag is a known HTML element name
+func isValidTagName(tag string) bool {
+	return tagNameRE.MatchString(tag) && atom.Lookup([]byte(tag)) != 0
+}
+
+// ParseExtractorJSON decodes a JSON extractor definition for validation.
+// Content transforms are given as "selector": "tag" pairs, the only form JSON can express.
+func ParseExtractorJSON(data []byte) (*CustomExtractor, error) {
+	var raw struct {
+		CustomExtractor
+		Content *struct {
+			FieldExtractor
+			Clean          []string          `json:"clean"`
+			Transforms     map[string]string `json:"transforms"`
+			DefaultCleaner bool              `json:"defaultCleaner"`
+		} `json:"content,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid extractor JSON: %w", err)
+	}
+
+	extractor := raw.CustomExtractor
+	if raw.Content != nil {
+		field := raw.Content.FieldExtractor
+		extractor.Content = &ContentExtractor{
+			FieldExtractor: &field,
+			Clean:          raw.Content.Clean,
+			Transforms:     make(map[string]TransformFunction, len(raw.Content.Transforms)),
+			DefaultCleaner: raw.Content.DefaultCleaner,
+		}
+		for selector, tag := range raw.Content.Transforms {
+			extractor.Content.Transforms[selector] = &StringTransform{TargetTag: tag}
+		}
+	}
+
+	return &extractor, nil
+}
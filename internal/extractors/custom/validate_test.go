@@ -0,0 +1,216 @@
+// ABOUTME: Test suite for custom extractor validation
+// ABOUTME: Covers a well-formed extractor, malformed selector definitions and JSON extractor loading
+
+package custom
+
+import (
+	"strings"
+	"testing"
+)
+
+func validExtractor() *CustomExtractor {
+	return &CustomExtractor{
+		Domain: "example.com",
+		Title: &FieldExtractor{
+			Selectors: []interface{}{"h1.headline", "title"},
+		},
+		DatePublished: &FieldExtractor{
+			Selectors: []interface{}{
+				[]string{`meta[name="article:published_time"]`, "value"},
+			},
+		},
+		LeadImageURL: &FieldExtractor{
+			Selectors: []interface{}{
+				[]interface{}{"img.hero", "src", func(s string) string { return s }},
+			},
+		},
+		Content: &ContentExtractor{
+			FieldExtractor: &FieldExtractor{
+				Selectors: []interface{}{
+					[]string{".lede", ".article-body"},
+					".article-body",
+				},
+			},
+			Clean: []string{".ad", "aside.related"},
+			Transforms: map[string]TransformFunction{
+				"noscript": &StringTransform{TargetTag: "div"},
+			},
+		},
+	}
+}
+
+func TestValidateExtractor_Valid(t *testing.T) {
+	if errs := ValidateExtractor(validExtractor()); len(errs) != 0 {
+		t.Errorf("Expected no errors, got %v", errs)
+	}
+}
+
+func TestValidateExtractor_BuiltInExtractors(t *testing.T) {
+	// Disabled fields (empty selector lists) must not be reported
+	for name, extractor := range map[string]*CustomExtractor{
+		"MediumExtractor":  GetMediumExtractor(),
+		"BloggerExtractor": GetBloggerExtractor(),
+		"GeniusExtractor":  GetGeniusExtractor(),
+	} {
+		if errs := ValidateExtractor(extractor); len(errs) != 0 {
+			t.Errorf("%s: expected no errors, got %v", name, errs)
+		}
+	}
+}
+
+func TestValidateExtractor_Malformed(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(e *CustomExtractor)
+		expected string
+	}{
+		{
+			name:     "missing domain",
+			modify:   func(e *CustomExtractor) { e.Domain = "" },
+			expected: "domain: required",
+		},
+		{
+			name: "no title or content",
+			modify: func(e *CustomExtractor) {
+				e.Title = nil
+				e.Content = nil
+			},
+			expected: "neither title nor content",
+		},
+		{
+			name: "attribute pair with one element",
+			modify: func(e *CustomExtractor) {
+				e.DatePublished.Selectors = []interface{}{[]string{"time"}}
+			},
+			expected: "date_published.selectors[0]: [selector, attribute] pair must have 2 elements, got 1",
+		},
+		{
+			name: "attribute pair with three strings",
+			modify: func(e *CustomExtractor) {
+				e.Author = &FieldExtractor{Selectors: []interface{}{[]interface{}{".byline", "content", "extra"}}}
+			},
+			expected: "author.selectors[0]: [selector, attribute] pair must have 2 elements, got 3",
+		},
+		{
+			name: "empty attribute name",
+			modify: func(e *CustomExtractor) {
+				e.DatePublished.Selectors = []interface{}{[]string{"time", " "}}
+			},
+			expected: "date_published.selectors[0][1]: empty attribute name",
+		},
+		{
+			name: "non-string selector",
+			modify: func(e *CustomExtractor) {
+				e.Title.Selectors = []interface{}{42}
+			},
+			expected: "title.selectors[0]: expected string or array, got int",
+		},
+		{
+			name: "invalid CSS in field selector",
+			modify: func(e *CustomExtractor) {
+				e.Title.Selectors = []interface{}{"h1[class"}
+			},
+			expected: `title.selectors[0]: invalid CSS selector "h1[class"`,
+		},
+		{
+			name: "invalid CSS in extend field",
+			modify: func(e *CustomExtractor) {
+				e.Extend = map[string]*FieldExtractor{"tags": {Selectors: []interface{}{".tag >"}}}
+			},
+			expected: "extend.tags.selectors[0]: invalid CSS selector",
+		},
+		{
+			name: "content without selectors",
+			modify: func(e *CustomExtractor) {
+				e.Content.Selectors = nil
+			},
+			expected: "content: selectors required",
+		},
+		{
+			name: "invalid clean selector",
+			modify: func(e *CustomExtractor) {
+				e.Content.Clean = []string{".ad", "div::"}
+			},
+			expected: "content.clean[1]: invalid CSS selector",
+		},
+		{
+			name: "transform to unknown tag",
+			modify: func(e *CustomExtractor) {
+				e.Content.Transforms["noscript"] = &StringTransform{TargetTag: "dvi"}
+			},
+			expected: `content.transforms["noscript"]: invalid target tag "dvi"`,
+		},
+		{
+			name: "transform with invalid selector",
+			modify: func(e *CustomExtractor) {
+				e.Content.Transforms["figure >"] = &StringTransform{TargetTag: "div"}
+			},
+			expected: `content.transforms["figure >"]: invalid CSS selector`,
+		},
+		{
+			name: "nil function transform",
+			modify: func(e *CustomExtractor) {
+				e.Content.Transforms["h2"] = &FunctionTransform{}
+			},
+			expected: `content.transforms["h2"]: function is nil`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extractor := validExtractor()
+			tt.modify(extractor)
+
+			errs := ValidateExtractor(extractor)
+			if len(errs) != 1 {
+				t.Fatalf("Expected exactly one error, got %v", errs)
+			}
+			if !strings.Contains(errs[0].Error(), tt.expected) {
+				t.Errorf("Expected error containing %q, got %q", tt.expected, errs[0])
+			}
+		})
+	}
+}
+
+func TestValidateExtractor_ReportsAllErrors(t *testing.T) {
+	extractor := validExtractor()
+	extractor.Domain = ""
+	extractor.Title.Selectors = []interface{}{"h1[", "h2"}
+	extractor.Content.Clean = []string{""}
+
+	if errs := ValidateExtractor(extractor); len(errs) != 3 {
+		t.Errorf("Expected 3 errors, got %d: %v", len(errs), errs)
+	}
+}
+
+func TestParseExtractorJSON(t *testing.T) {
+	data := []byte(`{
+		"domain": "example.com",
+		"title": {"selectors": ["h1", ["meta[name=\"og:title\"]", "value"]]},
+		"content": {
+			"selectors": [[".lede", ".body"], ".body"],
+			"clean": [".ad"],
+			"transforms": {"noscript": "div", "h1": "h9"}
+		}
+	}`)
+
+	extractor, err := ParseExtractorJSON(data)
+	if err != nil {
+		t.Fatalf("ParseExtractorJSON failed: %v", err)
+	}
+	if extractor.Domain != "example.com" || len(extractor.Title.Selectors) != 2 {
+		t.Errorf("Unexpected extractor: %+v", extractor)
+	}
+	if len(extractor.Content.Selectors) != 2 || len(extractor.Content.Clean) != 1 {
+		t.Errorf("Unexpected content extractor: %+v", extractor.Content)
+	}
+
+	errs := ValidateExtractor(extractor)
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), `invalid target tag "h9"`) {
+		t.Errorf("Expected only the h9 transform to be reported, got %v", errs)
+	}
+
+	if _, err := ParseExtractorJSON([]byte(`{"domain": `)); err == nil {
+		t.Error("Expected error for malformed JSON")
+	}
+}
@@ -4,9 +4,13 @@
 package fields
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 // ExtendedFieldType represents different types of extended fields
@@ -122,16 +126,38 @@ func (bfe *BaseFieldExtractor) Confidence() float64 {
 	return bfe.confidence
 }
 
+// Confidence reported when the primary category comes from the page's declared
+// section (article:section, JSON-LD articleSection or breadcrumbs)
+const sectionCategoryConfidence = 0.95
+
+// DefaultCategoryAnalysisBytes is how much content keyword analysis reads by
+// default. Category keywords show up early, so longer articles are sampled.
+const DefaultCategoryAnalysisBytes = 256 * 1024
+
+// Keyword analysis checks for cancellation after every this many bytes
+const categoryScanChunk = 4096
+
 // CategoryExtractor extracts article categories
 type CategoryExtractor struct {
 	BaseFieldExtractor
 	categoryMappings map[string]string
 	keywordMappings  map[string][]string
+	keywordIndex     *[256][]categoryKeyword
+
+	// MaxAnalysisBytes caps how much content keyword analysis reads; 0 or
+	// less analyzes the whole content
+	MaxAnalysisBytes int
+}
+
+// categoryKeyword is a lowercased keyword and the category it scores for
+type categoryKeyword struct {
+	keyword  string
+	category string
 }
 
 // NewCategoryExtractor creates a new category extractor
 func NewCategoryExtractor() *CategoryExtractor {
-	return &CategoryExtractor{
+	ce := &CategoryExtractor{
 		BaseFieldExtractor: BaseFieldExtractor{
 			fieldType:  FieldTypeCategory,
 			name:       "category_extractor",
@@ -156,12 +182,71 @@ func NewCategoryExtractor() *CategoryExtractor {
 			"Sports":     {"game", "match", "team", "player", "score", "tournament", "championship"},
 			"Business":   {"company", "market", "finance", "economy", "stock", "investment", "corporate"},
 		},
+		MaxAnalysisBytes: DefaultCategoryAnalysisBytes,
+	}
+	ce.keywordIndex = indexKeywords(ce.keywordMappings)
+	return ce
+}
+
+// indexKeywords groups lowercased keywords by first byte so content can be
+// scanned once instead of once per keyword
+func indexKeywords(mappings map[string][]string) *[256][]categoryKeyword {
+	index := new([256][]categoryKeyword)
+	for category, keywords := range mappings {
+		for _, keyword := range keywords {
+			lower := strings.ToLower(keyword)
+			if lower == "" {
+				continue
+			}
+			entry := categoryKeyword{keyword: lower, category: category}
+			index[lower[0]] = append(index[lower[0]], entry)
+			if upper := asciiUpper(lower[0]); upper != lower[0] {
+				index[upper] = append(index[upper], entry)
+			}
+		}
 	}
+	return index
 }
 
-// Extract extracts categories from various data sources
+// asciiUpper returns the upper-case form of an ASCII letter, other bytes unchanged
+func asciiUpper(b byte) byte {
+	if b >= 'a' && b <= 'z' {
+		return b - ('a' - 'A')
+	}
+	return b
+}
+
+// hasPrefixFold reports whether s starts with the lowercase ASCII keyword,
+// ignoring ASCII case, without lowercasing s
+func hasPrefixFold(s, keyword string) bool {
+	if len(s) < len(keyword) {
+		return false
+	}
+	for i := 0; i < len(keyword); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		if c != keyword[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Extract extracts categories from various data sources. A map may carry a
+// "section" string, which becomes the primary category with high confidence;
+// "categories" and keyword analysis of "content" then fill Secondary.
 func (ce *CategoryExtractor) Extract(data interface{}) interface{} {
+	field, _ := ce.ExtractWithContext(context.Background(), data)
+	return field
+}
+
+// ExtractWithContext is Extract with cancellation of the content keyword
+// analysis. It returns the context's error if ctx is done mid-scan.
+func (ce *CategoryExtractor) ExtractWithContext(ctx context.Context, data interface{}) (interface{}, error) {
 	categories := make([]string, 0)
+	section := ""
 	
 	switch v := data.(type) {
 	case []string:
@@ -177,10 +262,18 @@ func (ce *CategoryExtractor) Extract(data interface{}) interface{} {
 			categories = append(categories, normalized)
 		} else {
 			// Analyze content for category keywords
-			categories = ce.extractFromContent(v)
+			found, err := ce.extractFromContent(ctx, v)
+			if err != nil {
+				return nil, err
+			}
+			categories = found
 		}
 	case map[string]interface{}:
-		// Structured data with multiple sources
+		// Structured data with multiple sources; a declared section is the
+		// publisher's own filing and outranks anything inferred
+		if sec, ok := v["section"].(string); ok {
+			section = ce.normalizeCategory(sec)
+		}
 		if cats, ok := v["categories"].([]string); ok {
 			for _, cat := range cats {
 				if normalized := ce.normalizeCategory(cat); normalized != "" {
@@ -189,12 +282,30 @@ func (ce *CategoryExtractor) Extract(data interface{}) interface{} {
 			}
 		}
 		if content, ok := v["content"].(string); ok {
-			categories = append(categories, ce.extractFromContent(content)...)
+			found, err := ce.extractFromContent(ctx, content)
+			if err != nil {
+				return nil, err
+			}
+			categories = append(categories, found...)
 		}
 	}
 	
+	if section != "" {
+		secondary := make([]string, 0, len(categories))
+		for _, cat := range categories {
+			if cat != section {
+				secondary = append(secondary, cat)
+			}
+		}
+		return CategoryField{
+			Primary:    section,
+			Secondary:  secondary,
+			Confidence: sectionCategoryConfidence,
+		}, nil
+	}
+	
 	if len(categories) == 0 {
-		return CategoryField{Primary: "General", Confidence: 0.5}
+		return CategoryField{Primary: "General", Confidence: 0.5}, nil
 	}
 	
 	// Return primary category and secondary categories
@@ -205,7 +316,7 @@ func (ce *CategoryExtractor) Extract(data interface{}) interface{} {
 		Primary:    primary,
 		Secondary:  secondary,
 		Confidence: ce.confidence,
-	}
+	}, nil
 }
 
 // normalizeCategory normalizes a category name
@@ -226,18 +337,31 @@ func (ce *CategoryExtractor) normalizeCategory(category string) string {
 	return strings.Join(words, " ")
 }
 
-// extractFromContent analyzes content to determine categories
-func (ce *CategoryExtractor) extractFromContent(content string) []string {
-	content = strings.ToLower(content)
+// extractFromContent analyzes content to determine categories, highest
+// keyword score first. Content beyond MaxAnalysisBytes is not read.
+func (ce *CategoryExtractor) extractFromContent(ctx context.Context, content string) ([]string, error) {
+	if ce.MaxAnalysisBytes > 0 && len(content) > ce.MaxAnalysisBytes {
+		cut := ce.MaxAnalysisBytes
+		// Back up to a rune boundary so the sample stays valid UTF-8
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		content = content[:cut]
+	}
 	categoryScores := make(map[string]int)
 	
-	for category, keywords := range ce.keywordMappings {
-		score := 0
-		for _, keyword := range keywords {
-			score += strings.Count(content, strings.ToLower(keyword))
+	// Single pass over the raw bytes: at each byte, try only the keywords
+	// starting with it, folding ASCII case instead of copying the content
+	for i := 0; i < len(content); i++ {
+		if i%categoryScanChunk == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
 		}
-		if score > 0 {
-			categoryScores[category] = score
+		for _, kw := range ce.keywordIndex[content[i]] {
+			if hasPrefixFold(content[i:], kw.keyword) {
+				categoryScores[kw.category]++
+			}
 		}
 	}
 	
@@ -248,24 +372,57 @@ func (ce *CategoryExtractor) extractFromContent(content string) []string {
 			categories = append(categories, category)
 		}
 	}
+	sort.Slice(categories, func(i, j int) bool {
+		if categoryScores[categories[i]] != categoryScores[categories[j]] {
+			return categoryScores[categories[i]] > categoryScores[categories[j]]
+		}
+		return categories[i] < categories[j]
+	})
 	
-	return categories
+	return categories, nil
+}
+
+// DefaultStopWordLanguage is the language whose stop words are used when the
+// tags' language is unknown or has no list of its own
+const DefaultStopWordLanguage = "en"
+
+// englishStopWords are the built-in stop words for DefaultStopWordLanguage
+var englishStopWords = []string{
+	"a", "an", "and", "are", "as", "at",
+	"be", "been", "by", "for", "from", "has",
+	"he", "in", "is", "it", "its", "of",
+	"on", "that", "the", "to", "was", "will",
+	"with", "this", "these", "they", "we", "you",
 }
 
 // TagsExtractor extracts and normalizes article tags
 type TagsExtractor struct {
 	BaseFieldExtractor
-	stopWords map[string]bool
+	stopWords map[string]map[string]bool // Keyed by primary language subtag, e.g. "fr"
 }
 
 // NewTagsExtractor creates a new tags extractor
 func NewTagsExtractor() *TagsExtractor {
-	stopWords := map[string]bool{
-		"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
-		"be": true, "been": true, "by": true, "for": true, "from": true, "has": true,
-		"he": true, "in": true, "is": true, "it": true, "its": true, "of": true,
-		"on": true, "that": true, "the": true, "to": true, "was": true, "will": true,
-		"with": true, "this": true, "these": true, "they": true, "we": true, "you": true,
+	return NewTagsExtractorWithStopWords(nil)
+}
+
+// NewTagsExtractorWithStopWords creates a tags extractor that drops the stop
+// words listed for the tags' language. Lists are keyed by language code, such
+// as "fr" or "pt-BR", and matched on the primary subtag. Languages without a
+// list use the English stop words; a list for "en" replaces the built-in one.
+//
+// Example:
+//
+//	extractor := fields.NewTagsExtractorWithStopWords(map[string][]string{
+//	    "fr": {"le", "la", "les", "de", "des", "et"},
+//	})
+//	tags := extractor.Extract(map[string]interface{}{"tags": "la, politique", "language": "fr"})
+func NewTagsExtractorWithStopWords(stopWords map[string][]string) *TagsExtractor {
+	lists := map[string]map[string]bool{
+		DefaultStopWordLanguage: stopWordSet(englishStopWords),
+	}
+	for language, words := range stopWords {
+		lists[primaryLanguage(language)] = stopWordSet(words)
 	}
 	
 	return &TagsExtractor{
@@ -274,13 +431,34 @@ func NewTagsExtractor() *TagsExtractor {
 			name:       "tags_extractor",
 			confidence: 0.9,
 		},
-		stopWords: stopWords,
+		stopWords: lists,
 	}
 }
 
-// Extract extracts and normalizes tags
+// stopWordSet lowercases words into a lookup set
+func stopWordSet(words []string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[strings.ToLower(strings.TrimSpace(word))] = true
+	}
+	return set
+}
+
+// primaryLanguage reduces a language tag such as "fr-CA" or "pt_BR" to its lowercase primary subtag
+func primaryLanguage(language string) string {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if i := strings.IndexAny(language, "-_"); i >= 0 {
+		language = language[:i]
+	}
+	return language
+}
+
+// Extract extracts and normalizes tags. data is a tag list, a delimited tag
+// string, or a map with "tags" as either and an optional "language" code,
+// declared or detected, selecting the stop words to drop.
 func (te *TagsExtractor) Extract(data interface{}) interface{} {
 	var rawTags []string
+	language := DefaultStopWordLanguage
 	
 	switch v := data.(type) {
 	case []string:
@@ -289,14 +467,21 @@ func (te *TagsExtractor) Extract(data interface{}) interface{} {
 		// Split on common delimiters
 		rawTags = te.splitTags(v)
 	case map[string]interface{}:
-		if tags, ok := v["tags"].([]string); ok {
+		switch tags := v["tags"].(type) {
+		case []string:
 			rawTags = tags
+		case string:
+			rawTags = te.splitTags(tags)
+		}
+		if declared, ok := v["language"].(string); ok && declared != "" {
+			language = declared
 		}
 	}
 	
+	stopWords := te.stopWordsFor(language)
 	var normalizedTags []string
 	for _, tag := range rawTags {
-		if normalized := te.normalizeTag(tag); normalized != "" {
+		if normalized := te.normalizeTag(tag, stopWords); normalized != "" {
 			normalizedTags = append(normalizedTags, normalized)
 		}
 	}
@@ -304,8 +489,16 @@ func (te *TagsExtractor) Extract(data interface{}) interface{} {
 	return normalizedTags
 }
 
-// normalizeTag normalizes a single tag
-func (te *TagsExtractor) normalizeTag(tag string) string {
+// stopWordsFor returns the stop words for language, falling back to English
+func (te *TagsExtractor) stopWordsFor(language string) map[string]bool {
+	if words, ok := te.stopWords[primaryLanguage(language)]; ok {
+		return words
+	}
+	return te.stopWords[DefaultStopWordLanguage]
+}
+
+// normalizeTag normalizes a single tag, dropping it when it is one of stopWords
+func (te *TagsExtractor) normalizeTag(tag string, stopWords map[string]bool) string {
 	// Trim and convert to lowercase
 	tag = strings.TrimSpace(strings.ToLower(tag))
 	
@@ -315,7 +508,7 @@ func (te *TagsExtractor) normalizeTag(tag string) string {
 	}
 	
 	// Skip stop words
-	if te.stopWords[tag] {
+	if stopWords[tag] {
 		return ""
 	}
 	
@@ -323,10 +516,10 @@ func (te *TagsExtractor) normalizeTag(tag string) string {
 	tag = strings.ReplaceAll(tag, " ", "-")
 	tag = strings.ReplaceAll(tag, "_", "-")
 	
-	// Remove special characters except hyphens
+	// Remove special characters except hyphens, keeping accented and non-Latin letters
 	var result strings.Builder
 	for _, char := range tag {
-		if (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') || char == '-' {
+		if unicode.IsLetter(char) || unicode.IsDigit(char) || char == '-' {
 			result.WriteRune(char)
 		}
 	}
@@ -0,0 +1,190 @@
+// ABOUTME: Test suite for extended field extractors
+// ABOUTME: Validates category extraction from sections, lists, keywords and bounded analysis, and per-language tag stop words
+
+package fields
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestCategoryExtractorSection(t *testing.T) {
+	extractor := NewCategoryExtractor()
+
+	tests := []struct {
+		name     string
+		data     map[string]interface{}
+		expected CategoryField
+	}{
+		{
+			name: "section wins over categories and content",
+			data: map[string]interface{}{
+				"section":    "politics",
+				"categories": []string{"News", "Politics"},
+				"content":    "The company said the market and the economy drove the stock investment.",
+			},
+			expected: CategoryField{Primary: "Politics", Secondary: []string{"News", "Business"}, Confidence: sectionCategoryConfidence},
+		},
+		{
+			name: "keyword fallback without section",
+			data: map[string]interface{}{
+				"section": "",
+				"content": "The team won the match after the player scored in the tournament.",
+			},
+			expected: CategoryField{Primary: "Sports", Secondary: []string{}, Confidence: 0.8},
+		},
+		{
+			name:     "nothing to go on",
+			data:     map[string]interface{}{},
+			expected: CategoryField{Primary: "General", Confidence: 0.5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := extractor.Extract(tt.data)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// naiveCategoryScores is the per-keyword strings.Count scan the single-pass
+// analysis replaced, kept as a reference for results and benchmarks
+func naiveCategoryScores(ce *CategoryExtractor, content string) []string {
+	content = strings.ToLower(content)
+	var categories []string
+	for category, keywords := range ce.keywordMappings {
+		score := 0
+		for _, keyword := range keywords {
+			score += strings.Count(content, strings.ToLower(keyword))
+		}
+		if score >= 2 {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+const categorySampleText = `Researchers published a study on machine learning software this week.
+The company said the AI research could move the stock market, and the team behind the
+experiment plans a press release after the next game of the season. `
+
+func TestCategoryExtractorMatchesNaiveScan(t *testing.T) {
+	extractor := NewCategoryExtractor()
+	content := strings.Repeat(categorySampleText, 20)
+
+	got, err := extractor.extractFromContent(context.Background(), content)
+	if err != nil {
+		t.Fatalf("extractFromContent failed: %v", err)
+	}
+	expected := naiveCategoryScores(extractor, content)
+
+	sortedGot := append([]string(nil), got...)
+	sort.Strings(sortedGot)
+	sort.Strings(expected)
+	if !reflect.DeepEqual(sortedGot, expected) {
+		t.Errorf("Expected categories %v, got %v", expected, got)
+	}
+}
+
+func TestCategoryExtractorBoundedAnalysis(t *testing.T) {
+	extractor := NewCategoryExtractor()
+	extractor.MaxAnalysisBytes = 64
+
+	// Sports keywords only appear past the analysis bound
+	content := strings.Repeat("é", 40) + strings.Repeat(" team match player score", 10)
+	field := extractor.Extract(map[string]interface{}{"content": content}).(CategoryField)
+	if field.Primary != "General" {
+		t.Errorf("Expected content past the bound to be ignored, got %+v", field)
+	}
+
+	extractor.MaxAnalysisBytes = 0
+	field = extractor.Extract(map[string]interface{}{"content": content}).(CategoryField)
+	if field.Primary != "Sports" {
+		t.Errorf("Expected Sports with unbounded analysis, got %+v", field)
+	}
+}
+
+func TestCategoryExtractorCancellation(t *testing.T) {
+	extractor := NewCategoryExtractor()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := extractor.ExtractWithContext(ctx, map[string]interface{}{"content": categorySampleText})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func BenchmarkCategoryExtractFromContent(b *testing.B) {
+	extractor := NewCategoryExtractor()
+	extractor.MaxAnalysisBytes = 0
+	content := strings.Repeat(categorySampleText, 5000) // ~1MB
+	ctx := context.Background()
+
+	b.SetBytes(int64(len(content)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := extractor.extractFromContent(ctx, content); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCategoryExtractFromContentBounded(b *testing.B) {
+	extractor := NewCategoryExtractor()
+	content := strings.Repeat(categorySampleText, 5000)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := extractor.extractFromContent(ctx, content); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCategoryExtractFromContentNaive(b *testing.B) {
+	extractor := NewCategoryExtractor()
+	content := strings.Repeat(categorySampleText, 5000)
+
+	b.SetBytes(int64(len(content)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		naiveCategoryScores(extractor, content)
+	}
+}
+
+func TestTagsExtractorLanguageStopWords(t *testing.T) {
+	extractor := NewTagsExtractorWithStopWords(map[string][]string{
+		"fr": {"le", "la", "les", "de", "des", "du", "et", "en", "pour"},
+	})
+
+	tags := extractor.Extract(map[string]interface{}{
+		"tags":     "la, politique, Élections régionales, les, et, pour, Économie, the",
+		"language": "fr-FR",
+	})
+	expected := []string{"politique", "élections-régionales", "économie", "the"}
+	if !reflect.DeepEqual(tags, expected) {
+		t.Errorf("Expected French stop words removed, got %v", tags)
+	}
+
+	// Languages without a list fall back to the English stop words
+	tags = extractor.Extract(map[string]interface{}{
+		"tags":     []string{"the", "la", "Politics"},
+		"language": "de",
+	})
+	if !reflect.DeepEqual(tags, []string{"la", "politics"}) {
+		t.Errorf("Expected English stop words for an unlisted language, got %v", tags)
+	}
+
+	if tags := NewTagsExtractor().Extract("and, climate, with"); !reflect.DeepEqual(tags, []string{"climate"}) {
+		t.Errorf("Expected English stop words by default, got %v", tags)
+	}
+}
@@ -0,0 +1,63 @@
+// ABOUTME: GenericAlternatesExtractor collects hreflang translations of the page
+// ABOUTME: Maps each link[rel=alternate][hreflang] code, including x-default, to an absolute URL
+
+package generic
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GenericAlternatesExtractor extracts language alternates declared with hreflang
+type GenericAlternatesExtractor struct{}
+
+// Extract returns hreflang codes mapped to absolute URLs, or nil when the page
+// declares no alternates. Codes are lowercased; the first link for a code wins.
+func (extractor *GenericAlternatesExtractor) Extract(selection *goquery.Selection, pageURL string) map[string]string {
+	base, _ := url.Parse(pageURL)
+
+	var alternates map[string]string
+	selection.Find("link[rel][hreflang][href]").Each(func(i int, s *goquery.Selection) {
+		if !hasRelToken(s.AttrOr("rel", ""), "alternate") {
+			return
+		}
+
+		lang := strings.ToLower(strings.TrimSpace(s.AttrOr("hreflang", "")))
+		if lang == "" {
+			return
+		}
+		if _, exists := alternates[lang]; exists {
+			return
+		}
+
+		href, err := url.Parse(strings.TrimSpace(s.AttrOr("href", "")))
+		if err != nil {
+			return
+		}
+		if base != nil {
+			href = base.ResolveReference(href)
+		}
+		if href.Scheme != "http" && href.Scheme != "https" {
+			return
+		}
+
+		if alternates == nil {
+			alternates = make(map[string]string)
+		}
+		alternates[lang] = href.String()
+	})
+
+	return alternates
+}
+
+// hasRelToken reports whether a rel attribute contains token
+func hasRelToken(rel, token string) bool {
+	for _, t := range strings.Fields(strings.ToLower(rel)) {
+		if t == token {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+// ABOUTME: Tests for GenericAlternatesExtractor
+// ABOUTME: Covers hreflang alternates, x-default, relative URL resolution and pages without alternates
+
+package generic
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericAlternatesExtractor(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected map[string]string
+	}{
+		{
+			name: "translations with x-default",
+			html: `<link rel="alternate" hreflang="en" href="https://example.com/en/story">
+<link rel="alternate" hreflang="FR" href="/fr/story">
+<link rel="alternate" hreflang="x-default" href="https://example.com/story">
+<link rel="alternate" type="application/rss+xml" href="/feed">
+<link rel="canonical" hreflang="de" href="/de/story">`,
+			expected: map[string]string{
+				"en":        "https://example.com/en/story",
+				"fr":        "https://example.com/fr/story",
+				"x-default": "https://example.com/story",
+			},
+		},
+		{
+			name:     "no alternates",
+			html:     `<link rel="alternate" type="application/rss+xml" href="/feed">`,
+			expected: nil,
+		},
+	}
+
+	extractor := &GenericAlternatesExtractor{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html><head>" + tt.html + "</head><body></body></html>"))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+			got := extractor.Extract(doc.Selection, "https://example.com/story")
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// ABOUTME: GenericAudioExtractor finds the audio or podcast enclosure of a page
+// ABOUTME: Reads <audio> sources, then JSON-LD AudioObject and associatedMedia, then og:audio
+
+package generic
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Meta tags naming the page's audio file, most specific first
+var AUDIO_META_TAGS = []string{
+	"og:audio:secure_url",
+	"og:audio:url",
+	"og:audio",
+}
+
+// GenericAudioExtractor extracts the URL of the page's audio file
+type GenericAudioExtractor struct{}
+
+// Extract returns the absolute URL of the first audio file the page offers,
+// or "" when it has none. Players in the page come first, then JSON-LD
+// AudioObject entries, then og:audio meta tags.
+func (extractor *GenericAudioExtractor) Extract(selection *goquery.Selection, pageURL string) string {
+	base, _ := url.Parse(pageURL)
+
+	var audioURL string
+	selection.Find("audio, audio source").EachWithBreak(func(i int, s *goquery.Selection) bool {
+		audioURL = resolveMediaURL(strings.TrimSpace(s.AttrOr("src", s.AttrOr("data-src", ""))), base)
+		return audioURL == ""
+	})
+	if audioURL != "" {
+		return audioURL
+	}
+
+	selection.Find(`script[type="application/ld+json"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+		var data interface{}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(s.Text())), &data); err != nil {
+			return true // Skip invalid JSON
+		}
+		walkJSONLDObjects(data, func(obj map[string]interface{}) {
+			if audioURL == "" {
+				audioURL = resolveMediaURL(audioFromJSONLD(obj), base)
+			}
+		})
+		return audioURL == ""
+	})
+	if audioURL != "" {
+		return audioURL
+	}
+
+	for _, name := range AUDIO_META_TAGS {
+		selection.Find(`meta[name="` + name + `"], meta[property="` + name + `"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+			audioURL = resolveMediaURL(strings.TrimSpace(s.AttrOr("value", s.AttrOr("content", ""))), base)
+			return audioURL == ""
+		})
+		if audioURL != "" {
+			return audioURL
+		}
+	}
+	return ""
+}
+
+// audioFromJSONLD returns the contentUrl of obj when it is an AudioObject, or
+// of the first AudioObject in its associatedMedia or audio properties, as
+// podcast episodes and audio articles nest them
+func audioFromJSONLD(obj map[string]interface{}) string {
+	if hasJSONLDType(obj["@type"], "AudioObject") {
+		if contentURL := jsonLDURL(obj["contentUrl"]); contentURL != "" {
+			return contentURL
+		}
+	}
+	for _, key := range []string{"associatedMedia", "audio"} {
+		var media []interface{}
+		switch v := obj[key].(type) {
+		case []interface{}:
+			media = v
+		case map[string]interface{}:
+			media = []interface{}{v}
+		}
+		for _, item := range media {
+			nested, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			// associatedMedia is often a bare MediaObject; only audio counts
+			if key == "audio" || hasJSONLDType(nested["@type"], "AudioObject") || isAudioEncoding(nested["encodingFormat"]) {
+				if contentURL := jsonLDURL(nested["contentUrl"]); contentURL != "" {
+					return contentURL
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// jsonLDURL reads a URL given as a string or as the first of a list
+func jsonLDURL(value interface{}) string {
+	if list, ok := value.([]interface{}); ok && len(list) > 0 {
+		value = list[0]
+	}
+	return jsonLDString(value)
+}
+
+// isAudioEncoding reports whether a JSON-LD encodingFormat is an audio media type
+func isAudioEncoding(value interface{}) bool {
+	return strings.HasPrefix(strings.ToLower(jsonLDString(value)), "audio/")
+}
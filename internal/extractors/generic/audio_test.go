@@ -0,0 +1,44 @@
+// ABOUTME: Tests for GenericAudioExtractor
+// ABOUTME: Covers <audio> players, JSON-LD AudioObject and associatedMedia, og:audio and URL resolution
+
+package generic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericAudioExtractor(t *testing.T) {
+	tests := []struct {
+		name     string
+		head     string
+		body     string
+		expected string
+	}{
+		{"audio src", "", `<audio controls src="/media/episode-42.mp3"></audio>`, "https://podcast.example.com/media/episode-42.mp3"},
+		{"audio source", "", `<audio controls><source src="episode-42.ogg" type="audio/ogg"><source src="episode-42.mp3" type="audio/mpeg"></audio>`, "https://podcast.example.com/shows/episode-42.ogg"},
+		{"player before og:audio", `<meta property="og:audio" content="https://cdn.example.com/og.mp3">`, `<audio src="https://cdn.example.com/player.mp3"></audio>`, "https://cdn.example.com/player.mp3"},
+		{"og:audio", `<meta property="og:audio" content="https://cdn.example.com/episode-42.mp3">`, "", "https://cdn.example.com/episode-42.mp3"},
+		{"normalized og:audio secure url", `<meta name="og:audio" value="http://cdn.example.com/plain.mp3"><meta name="og:audio:secure_url" value="https://cdn.example.com/secure.mp3">`, "", "https://cdn.example.com/secure.mp3"},
+		{"jsonld audio object", `<script type="application/ld+json">{"@type":"AudioObject","contentUrl":"/media/narration.mp3"}</script>`, "", "https://podcast.example.com/media/narration.mp3"},
+		{"jsonld podcast episode", `<script type="application/ld+json">{"@type":"PodcastEpisode","associatedMedia":{"@type":"MediaObject","encodingFormat":"audio/mpeg","contentUrl":"https://cdn.example.com/ep42.mp3"}}</script>`, "", "https://cdn.example.com/ep42.mp3"},
+		{"jsonld video media ignored", `<script type="application/ld+json">{"@type":"NewsArticle","associatedMedia":{"@type":"VideoObject","contentUrl":"https://cdn.example.com/clip.mp4"}}</script>`, "", ""},
+		{"blob source skipped", "", `<audio src="blob:https://podcast.example.com/1234"></audio>`, ""},
+		{"nothing", "", `<p>No audio here.</p>`, ""},
+	}
+
+	extractor := &GenericAudioExtractor{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html><head>" + tt.head + "</head><body>" + tt.body + "</body></html>"))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+			if got := extractor.Extract(doc.Selection, "https://podcast.example.com/shows/episode-42"); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
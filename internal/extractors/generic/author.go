@@ -90,6 +90,13 @@ type GenericAuthorExtractor struct{}
 // Extract extracts author information from HTML using the three-tier strategy
 // Returns *string to allow nil for no author found (matching JavaScript behavior)
 func (e *GenericAuthorExtractor) Extract(doc *goquery.Selection, metaCache []string) *string {
+	author, _ := e.ExtractWithSource(doc, metaCache)
+	return author
+}
+
+// ExtractWithSource extracts the author like Extract and also reports which
+// tier it came from (SourceMeta, SourceSelector or SourceByline)
+func (e *GenericAuthorExtractor) ExtractWithSource(doc *goquery.Selection, metaCache []string) (*string, string) {
 	var author string
 
 	// First, check to see if we have a matching meta tag that we can make use of.
@@ -118,7 +125,7 @@ func (e *GenericAuthorExtractor) Extract(doc *goquery.Selection, metaCache []str
 			author = *authorPtr
 			if len(author) < AUTHOR_MAX_LENGTH {
 				cleaned := cleanAuthor(author)
-				return &cleaned
+				return &cleaned, SourceMeta
 			}
 		}
 	}
@@ -129,7 +136,7 @@ func (e *GenericAuthorExtractor) Extract(doc *goquery.Selection, metaCache []str
 		author = *authorPtr
 		if len(author) < AUTHOR_MAX_LENGTH {
 			cleaned := cleanAuthor(author)
-			return &cleaned
+			return &cleaned, SourceSelector
 		}
 	}
 
@@ -143,12 +150,12 @@ func (e *GenericAuthorExtractor) Extract(doc *goquery.Selection, metaCache []str
 			text := strings.TrimSpace(node.Text())
 			if regex.MatchString(text) {
 				cleaned := cleanAuthor(text)
-				return &cleaned
+				return &cleaned, SourceByline
 			}
 		}
 	}
 
-	return nil
+	return nil, ""
 }
 
 // cleanAuthor cleans author strings by removing prefixes like "By", "posted by", etc.
@@ -0,0 +1,258 @@
+// ABOUTME: GenericBreadcrumbsExtractor extracts category/navigation breadcrumbs from JSON-LD, microdata and nav markup
+// ABOUTME: Returns crumb names ordered from root to leaf, with helpers to drop the self-referential final crumb
+
+package generic
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GenericBreadcrumbsExtractor extracts breadcrumb trails
+type GenericBreadcrumbsExtractor struct{}
+
+// Common breadcrumb navigation containers, ordered by priority
+var breadcrumbNavSelectors = []string{
+	`nav[aria-label="breadcrumb"]`,
+	`nav[aria-label="Breadcrumb"]`,
+	`nav[aria-label="breadcrumbs"]`,
+	`nav[aria-label="Breadcrumbs"]`,
+	`ol.breadcrumb`,
+	`ul.breadcrumb`,
+	`.breadcrumbs`,
+	`#breadcrumbs`,
+	`#breadcrumb`,
+}
+
+// Characters used as visual separators between crumbs
+const breadcrumbSeparatorChars = " \t\n\r/>»›|→·•"
+
+// Crumbs longer than this are almost certainly not navigation labels
+const maxBreadcrumbLength = 100
+
+// breadcrumbItem is a crumb with its declared position, if any
+type breadcrumbItem struct {
+	position int
+	name     string
+}
+
+// Extract extracts breadcrumbs using priority-based strategies
+func (extractor *GenericBreadcrumbsExtractor) Extract(selection *goquery.Selection, pageURL string, metaCache []string) []string {
+	// Strategy 1: JSON-LD BreadcrumbList (most reliable)
+	if crumbs := extractor.extractFromJSONLD(selection); len(crumbs) > 0 {
+		return crumbs
+	}
+
+	// Strategy 2: schema.org microdata
+	if crumbs := extractor.extractFromMicrodata(selection); len(crumbs) > 0 {
+		return crumbs
+	}
+
+	// Strategy 3: common breadcrumb navigation patterns
+	return extractor.extractFromNav(selection)
+}
+
+// extractFromJSONLD extracts breadcrumbs from a JSON-LD BreadcrumbList
+func (extractor *GenericBreadcrumbsExtractor) extractFromJSONLD(selection *goquery.Selection) []string {
+	var crumbs []string
+
+	selection.Find("script[type=\"application/ld+json\"]").EachWithBreak(func(i int, s *goquery.Selection) bool {
+		jsonText := strings.TrimSpace(s.Text())
+		if jsonText == "" {
+			return true
+		}
+
+		var data interface{}
+		if err := json.Unmarshal([]byte(jsonText), &data); err != nil {
+			return true // Skip invalid JSON
+		}
+
+		if list := findBreadcrumbList(data); list != nil {
+			crumbs = extractor.itemsFromJSONLD(list)
+		}
+		return len(crumbs) == 0
+	})
+
+	return crumbs
+}
+
+// findBreadcrumbList walks JSON-LD data (objects, arrays and @graph) looking for a BreadcrumbList
+func findBreadcrumbList(data interface{}) map[string]interface{} {
+	switch v := data.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if list := findBreadcrumbList(item); list != nil {
+				return list
+			}
+		}
+	case map[string]interface{}:
+		if hasJSONLDType(v["@type"], "BreadcrumbList") {
+			return v
+		}
+		if graph, ok := v["@graph"]; ok {
+			return findBreadcrumbList(graph)
+		}
+		if breadcrumb, ok := v["breadcrumb"]; ok {
+			return findBreadcrumbList(breadcrumb)
+		}
+	}
+	return nil
+}
+
+// hasJSONLDType checks a JSON-LD @type value, which may be a string or an array
+func hasJSONLDType(typeVal interface{}, want string) bool {
+	switch v := typeVal.(type) {
+	case string:
+		return v == want
+	case []interface{}:
+		for _, t := range v {
+			if s, ok := t.(string); ok && s == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// itemsFromJSONLD reads itemListElement entries of a BreadcrumbList in position order
+func (extractor *GenericBreadcrumbsExtractor) itemsFromJSONLD(list map[string]interface{}) []string {
+	elements, ok := list["itemListElement"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var items []breadcrumbItem
+	for i, element := range elements {
+		entry, ok := element.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := entry["name"].(string)
+		if name == "" {
+			// Some sites put the name on the nested item instead
+			if item, ok := entry["item"].(map[string]interface{}); ok {
+				name, _ = item["name"].(string)
+			}
+		}
+
+		items = append(items, breadcrumbItem{
+			position: jsonLDPosition(entry["position"], i+1),
+			name:     name,
+		})
+	}
+
+	return orderBreadcrumbs(items)
+}
+
+// jsonLDPosition reads a ListItem position, which may be a number or a string
+func jsonLDPosition(value interface{}, fallback int) int {
+	switch v := value.(type) {
+	case float64:
+		return int(v)
+	case string:
+		if n, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+			return n
+		}
+	}
+	return fallback
+}
+
+// extractFromMicrodata extracts breadcrumbs from [itemtype*=BreadcrumbList] microdata
+func (extractor *GenericBreadcrumbsExtractor) extractFromMicrodata(selection *goquery.Selection) []string {
+	list := selection.Find("[itemtype*=\"BreadcrumbList\"]").First()
+	if list.Length() == 0 {
+		return nil
+	}
+
+	var items []breadcrumbItem
+	list.Find("[itemprop=\"itemListElement\"]").Each(func(i int, el *goquery.Selection) {
+		name := ""
+		if nameEl := el.Find("[itemprop=\"name\"]").First(); nameEl.Length() > 0 {
+			// Normalized meta tags carry content in value
+			name = nameEl.AttrOr("value", nameEl.AttrOr("content", nameEl.Text()))
+		} else {
+			name = el.Text()
+		}
+
+		position := i + 1
+		if posEl := el.Find("[itemprop=\"position\"]").First(); posEl.Length() > 0 {
+			position = jsonLDPosition(posEl.AttrOr("value", posEl.AttrOr("content", posEl.Text())), position)
+		}
+
+		items = append(items, breadcrumbItem{position: position, name: name})
+	})
+
+	return orderBreadcrumbs(items)
+}
+
+// extractFromNav extracts breadcrumbs from common navigation markup
+func (extractor *GenericBreadcrumbsExtractor) extractFromNav(selection *goquery.Selection) []string {
+	for _, selector := range breadcrumbNavSelectors {
+		container := selection.Find(selector).First()
+		if container.Length() == 0 {
+			continue
+		}
+
+		// Prefer list items, falling back to links for inline trails
+		nodes := container.Find("li")
+		if nodes.Length() == 0 {
+			nodes = container.Find("a, span[aria-current]")
+		}
+
+		var items []breadcrumbItem
+		nodes.Each(func(i int, el *goquery.Selection) {
+			items = append(items, breadcrumbItem{position: i + 1, name: el.Text()})
+		})
+
+		if crumbs := orderBreadcrumbs(items); len(crumbs) > 0 {
+			return crumbs
+		}
+	}
+
+	return nil
+}
+
+// orderBreadcrumbs sorts crumbs root to leaf and cleans their names
+func orderBreadcrumbs(items []breadcrumbItem) []string {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].position < items[j].position
+	})
+
+	var crumbs []string
+	for _, item := range items {
+		name := cleanBreadcrumb(item.name)
+		if name == "" || len(name) > maxBreadcrumbLength {
+			continue
+		}
+		// Skip consecutive duplicates from nested markup
+		if len(crumbs) > 0 && crumbs[len(crumbs)-1] == name {
+			continue
+		}
+		crumbs = append(crumbs, name)
+	}
+	return crumbs
+}
+
+// cleanBreadcrumb collapses whitespace and strips visual separators
+func cleanBreadcrumb(name string) string {
+	name = strings.Join(strings.Fields(name), " ")
+	return strings.Trim(name, breadcrumbSeparatorChars)
+}
+
+// RemoveSelfBreadcrumb drops the final crumb when it duplicates the article title
+func RemoveSelfBreadcrumb(crumbs []string, title string) []string {
+	if len(crumbs) == 0 || title == "" {
+		return crumbs
+	}
+
+	last := strings.ToLower(cleanBreadcrumb(crumbs[len(crumbs)-1]))
+	if last == strings.ToLower(cleanBreadcrumb(title)) {
+		return crumbs[:len(crumbs)-1]
+	}
+	return crumbs
+}
@@ -0,0 +1,161 @@
+// ABOUTME: Test suite for breadcrumb extraction from JSON-LD, microdata and navigation markup
+// ABOUTME: Verifies root-to-leaf ordering, crumb cleanup and removal of the self-referential crumb
+
+package generic
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericBreadcrumbsExtractor_Extract(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected []string
+	}{
+		{
+			name: "extracts from JSON-LD BreadcrumbList",
+			html: `<html><head>
+				<script type="application/ld+json">
+				{
+					"@context": "https://schema.org",
+					"@type": "BreadcrumbList",
+					"itemListElement": [
+						{"@type": "ListItem", "position": 2, "name": "Technology", "item": "https://example.com/tech"},
+						{"@type": "ListItem", "position": 1, "name": "News", "item": "https://example.com/news"},
+						{"@type": "ListItem", "position": 3, "item": {"@id": "https://example.com/tech/ai", "name": "AI"}}
+					]
+				}
+				</script>
+			</head><body></body></html>`,
+			expected: []string{"News", "Technology", "AI"},
+		},
+		{
+			name: "extracts from JSON-LD @graph",
+			html: `<html><head>
+				<script type="application/ld+json">
+				{"@context": "https://schema.org", "@graph": [
+					{"@type": "NewsArticle", "headline": "Story"},
+					{"@type": "BreadcrumbList", "itemListElement": [
+						{"@type": "ListItem", "position": "1", "name": "Home"},
+						{"@type": "ListItem", "position": "2", "name": "Sports"}
+					]}
+				]}
+				</script>
+			</head><body></body></html>`,
+			expected: []string{"Home", "Sports"},
+		},
+		{
+			name: "extracts from microdata",
+			html: `<html><body>
+				<ol itemscope itemtype="https://schema.org/BreadcrumbList">
+					<li itemprop="itemListElement" itemscope itemtype="https://schema.org/ListItem">
+						<a itemprop="item" href="/books"><span itemprop="name">Books</span></a>
+						<meta itemprop="position" content="1" />
+					</li>
+					›
+					<li itemprop="itemListElement" itemscope itemtype="https://schema.org/ListItem">
+						<a itemprop="item" href="/books/sf"><span itemprop="name">Science   Fiction</span></a>
+						<meta itemprop="position" content="2" />
+					</li>
+				</ol>
+			</body></html>`,
+			expected: []string{"Books", "Science Fiction"},
+		},
+		{
+			name: "extracts from breadcrumb nav",
+			html: `<html><body>
+				<nav aria-label="breadcrumb">
+					<ol>
+						<li><a href="/">Docs</a> /</li>
+						<li><a href="/guides">Guides</a> /</li>
+						<li aria-current="page">Installation</li>
+					</ol>
+				</nav>
+			</body></html>`,
+			expected: []string{"Docs", "Guides", "Installation"},
+		},
+		{
+			name: "prefers JSON-LD over nav markup",
+			html: `<html><head>
+				<script type="application/ld+json">
+				{"@type": "BreadcrumbList", "itemListElement": [{"@type": "ListItem", "position": 1, "name": "World"}]}
+				</script>
+			</head><body>
+				<div class="breadcrumbs"><a href="/">Home</a> » <a href="/local">Local</a></div>
+			</body></html>`,
+			expected: []string{"World"},
+		},
+		{
+			name:     "returns nothing without breadcrumbs",
+			html:     `<html><body><nav><a href="/">Home</a></nav><p>Content</p></body></html>`,
+			expected: nil,
+		},
+		{
+			name: "skips invalid JSON-LD",
+			html: `<html><head>
+				<script type="application/ld+json">{ invalid json</script>
+			</head><body>
+				<ul class="breadcrumb"><li>Home</li><li>Blog</li></ul>
+			</body></html>`,
+			expected: []string{"Home", "Blog"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+
+			extractor := &GenericBreadcrumbsExtractor{}
+			result := extractor.Extract(doc.Selection, "https://example.com/article", nil)
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestRemoveSelfBreadcrumb(t *testing.T) {
+	tests := []struct {
+		name     string
+		crumbs   []string
+		title    string
+		expected []string
+	}{
+		{
+			name:     "drops final crumb matching title",
+			crumbs:   []string{"News", "Tech", "New  Chip Announced"},
+			title:    "new chip announced",
+			expected: []string{"News", "Tech"},
+		},
+		{
+			name:     "keeps final crumb that differs from title",
+			crumbs:   []string{"News", "Tech"},
+			title:    "New Chip Announced",
+			expected: []string{"News", "Tech"},
+		},
+		{
+			name:     "keeps crumbs without a title",
+			crumbs:   []string{"News"},
+			title:    "",
+			expected: []string{"News"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := RemoveSelfBreadcrumb(tt.crumbs, tt.title)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// ABOUTME: GenericCommentCountExtractor reads an article's comment count from JSON-LD, microdata and count widgets
+// ABOUTME: Returns -1 when the page does not expose a count so consumers can tell "unknown" from "no comments"
+
+package generic
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GenericCommentCountExtractor extracts the number of reader comments
+type GenericCommentCountExtractor struct{}
+
+// Comment count widgets, ordered by priority. Disqus replaces the text of its
+// count spans with e.g. "12 Comments" once its script runs.
+var commentCountSelectors = []string{
+	`[itemprop="commentCount"]`,
+	`.comment-count`,
+	`.comments-count`,
+	`.comment_count`,
+	`.comments_count`,
+	`.commentCount`,
+	`.disqus-comment-count`,
+	`span[data-disqus-identifier]`,
+	`span[data-disqus-url]`,
+	`a[href$="#disqus_thread"]`,
+	`a[href$="#comments"]`,
+}
+
+// First number in a count label, allowing thousands separators ("1,204 comments")
+// and abbreviated thousands ("1.2K comments")
+var commentCountNumberRE = regexp.MustCompile(`(\d[\d,]*(?:\.\d+)?)(?:\s*([kK])\b)?`)
+
+// Labels that mean zero comments without containing a digit
+var noCommentsRE = regexp.MustCompile(`(?i)^\s*(no|zero)\s+comments?\b`)
+
+// Extract returns the comment count, or -1 when it is unknown
+func (extractor *GenericCommentCountExtractor) Extract(selection *goquery.Selection) int {
+	if count := extractor.extractFromJSONLD(selection); count >= 0 {
+		return count
+	}
+
+	for _, selector := range commentCountSelectors {
+		count := -1
+		selection.Find(selector).EachWithBreak(func(i int, s *goquery.Selection) bool {
+			// Normalized microdata meta tags carry the count in value
+			count = parseCommentCount(s.AttrOr("value", s.AttrOr("content", s.Text())))
+			return count < 0
+		})
+		if count >= 0 {
+			return count
+		}
+	}
+
+	return -1
+}
+
+// extractFromJSONLD looks for commentCount or a CommentAction interaction statistic
+func (extractor *GenericCommentCountExtractor) extractFromJSONLD(selection *goquery.Selection) int {
+	count := -1
+	selection.Find("script[type=\"application/ld+json\"]").EachWithBreak(func(i int, s *goquery.Selection) bool {
+		jsonText := strings.TrimSpace(s.Text())
+		if jsonText == "" {
+			return true
+		}
+
+		var data interface{}
+		if err := json.Unmarshal([]byte(jsonText), &data); err != nil {
+			return true // Skip invalid JSON
+		}
+
+		count = findJSONLDCommentCount(data)
+		return count < 0
+	})
+	return count
+}
+
+// findJSONLDCommentCount walks JSON-LD data (objects, arrays and @graph) for a comment count
+func findJSONLDCommentCount(data interface{}) int {
+	switch v := data.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if count := findJSONLDCommentCount(item); count >= 0 {
+				return count
+			}
+		}
+	case map[string]interface{}:
+		if count := jsonLDCount(v["commentCount"]); count >= 0 {
+			return count
+		}
+		if isCommentAction(v["interactionType"]) {
+			if count := jsonLDCount(v["userInteractionCount"]); count >= 0 {
+				return count
+			}
+		}
+		for _, key := range []string{"@graph", "interactionStatistic", "mainEntity"} {
+			if nested, ok := v[key]; ok {
+				if count := findJSONLDCommentCount(nested); count >= 0 {
+					return count
+				}
+			}
+		}
+	}
+	return -1
+}
+
+// isCommentAction checks an InteractionCounter's interactionType, which may be a
+// type name, a schema.org URL or an object with its own @type
+func isCommentAction(interactionType interface{}) bool {
+	if typed, ok := interactionType.(map[string]interface{}); ok {
+		interactionType = typed["@type"]
+	}
+	if name, ok := interactionType.(string); ok {
+		return strings.TrimPrefix(strings.TrimPrefix(name, "https://schema.org/"), "http://schema.org/") == "CommentAction"
+	}
+	return false
+}
+
+// jsonLDCount converts a JSON-LD count, given as a number or numeric string
+func jsonLDCount(value interface{}) int {
+	switch v := value.(type) {
+	case float64:
+		if v >= 0 {
+			return int(v)
+		}
+	case string:
+		return parseCommentCount(v)
+	}
+	return -1
+}
+
+// parseCommentCount reads the count from a label like "42", "1,204 Comments" or "No comments"
+func parseCommentCount(label string) int {
+	label = strings.TrimSpace(label)
+	if noCommentsRE.MatchString(label) {
+		return 0
+	}
+
+	match := commentCountNumberRE.FindStringSubmatch(label)
+	if match == nil {
+		return -1
+	}
+	count, err := strconv.ParseFloat(strings.ReplaceAll(match[1], ",", ""), 64)
+	if err != nil || count < 0 {
+		return -1
+	}
+	if match[2] != "" {
+		count *= 1000
+	}
+	return int(count)
+}
@@ -0,0 +1,103 @@
+// ABOUTME: Test suite for comment count extraction from JSON-LD, microdata and count widgets
+// ABOUTME: Verifies label parsing and the -1 default when no count is exposed
+
+package generic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericCommentCountExtractor_Extract(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected int
+	}{
+		{
+			name:     "JSON-LD commentCount",
+			html:     `<script type="application/ld+json">{"@type":"NewsArticle","headline":"Story","commentCount":37}</script>`,
+			expected: 37,
+		},
+		{
+			name: "JSON-LD graph with interaction statistic",
+			html: `<script type="application/ld+json">{"@context":"https://schema.org","@graph":[
+				{"@type":"WebPage"},
+				{"@type":"Article","interactionStatistic":[
+					{"@type":"InteractionCounter","interactionType":"https://schema.org/LikeAction","userInteractionCount":900},
+					{"@type":"InteractionCounter","interactionType":{"@type":"CommentAction"},"userInteractionCount":"58"}
+				]}
+			]}</script>`,
+			expected: 58,
+		},
+		{
+			name:     "microdata commentCount",
+			html:     `<article><meta itemprop="commentCount" content="4"><h1>Story</h1></article>`,
+			expected: 4,
+		},
+		{
+			name:     "comment count label with thousands separator",
+			html:     `<div class="byline"><span class="comment-count">1,204 Comments</span></div>`,
+			expected: 1204,
+		},
+		{
+			name:     "abbreviated count",
+			html:     `<a class="comments-count" href="#comments">1.2K</a>`,
+			expected: 1200,
+		},
+		{
+			name:     "Disqus count span",
+			html:     `<span class="disqus-comment-count" data-disqus-identifier="post-42">12 Comments</span>`,
+			expected: 12,
+		},
+		{
+			name:     "no comments label",
+			html:     `<span class="comment-count">No comments yet</span>`,
+			expected: 0,
+		},
+		{
+			name:     "skips labels without a number",
+			html:     `<a href="/story#comments">Jump to comments</a><a href="/story#comments">Comments (3)</a>`,
+			expected: 3,
+		},
+		{
+			name:     "unknown",
+			html:     `<article><p>An article without any comment widgets.</p></article>`,
+			expected: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+
+			extractor := &GenericCommentCountExtractor{}
+			if got := extractor.Extract(doc.Selection); got != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseCommentCount(t *testing.T) {
+	tests := map[string]int{
+		"42":            42,
+		"  7 comments ": 7,
+		"Zero comments": 0,
+		"2.5k":          2500,
+		"12 Kommentare": 12,
+		"Comments":      -1,
+		"":              -1,
+	}
+
+	for label, expected := range tests {
+		if got := parseCommentCount(label); got != expected {
+			t.Errorf("parseCommentCount(%q): expected %d, got %d", label, expected, got)
+		}
+	}
+}
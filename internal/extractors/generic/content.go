@@ -16,6 +16,13 @@ type ExtractorOptions struct {
 	StripUnlikelyCandidates bool
 	WeightNodes             bool
 	CleanConditionally      bool
+	KeepSafeStyles          bool    // Keep allowlisted inline styles (text-align, font-style, font-weight)
+	KeepLineBreaks          bool    // Keep single <br> inside paragraphs as soft line breaks (markdown output)
+	LinkDensityThreshold    float64 // Link density above which well-scored elements are still cleaned, 0 uses dom.DefaultLinkDensityThreshold
+	ContentHint             string  // Selector to favor when ranking content candidates, empty for none
+	MinParagraphWords       int     // Paragraphs with fewer words are removed unless they hold media or links, 0 keeps all
+	DebugScores             bool    // Record the top scored candidates of each pass in GenericContentExtractor.Scores
+	KeepEmbedHosts          []string // Iframe hosts kept in addition to security.DefaultEmbedHosts and video players
 }
 
 // ExtractorParams contains all the parameters needed for extraction
@@ -29,6 +36,10 @@ type ExtractorParams struct {
 // GenericContentExtractor implements the main content extraction logic
 type GenericContentExtractor struct {
 	DefaultOpts ExtractorOptions
+
+	// Scores holds the top scored candidates of the last extraction pass,
+	// the one that produced the returned content, when DebugScores is set
+	Scores []dom.CandidateScore
 }
 
 // NewGenericContentExtractor creates a new extractor with default options
@@ -115,17 +126,28 @@ func (e *GenericContentExtractor) Extract(params ExtractorParams, opts Extractor
 // This orchestrates the extraction pipeline: extract best node -> clean content
 func (e *GenericContentExtractor) GetContentNode(doc *goquery.Document, title, url string, opts ExtractorOptions) *goquery.Selection {
 	// Extract the best node using the scoring system
+	var ranking *[]dom.CandidateScore
+	if opts.DebugScores {
+		ranking = &e.Scores
+	}
 	bestNode := ExtractBestNode(doc, ExtractBestNodeOptions{
 		StripUnlikelyCandidates: opts.StripUnlikelyCandidates,
 		WeightNodes:             opts.WeightNodes,
+		ContentHint:             opts.ContentHint,
+		Ranking:                 ranking,
 	})
 
 	// Clean the content
 	return CleanContent(bestNode, CleanContentOptions{
-		Doc:                doc,
-		CleanConditionally: opts.CleanConditionally,
-		Title:              title,
-		URL:                url,
+		Doc:                  doc,
+		CleanConditionally:   opts.CleanConditionally,
+		Title:                title,
+		URL:                  url,
+		KeepSafeStyles:       opts.KeepSafeStyles,
+		KeepLineBreaks:       opts.KeepLineBreaks,
+		LinkDensityThreshold: opts.LinkDensityThreshold,
+		MinParagraphWords:    opts.MinParagraphWords,
+		KeepEmbedHosts:       opts.KeepEmbedHosts,
 	})
 }
 
@@ -156,6 +178,13 @@ func (e *GenericContentExtractor) mergeOptions(opts ExtractorOptions) ExtractorO
 	merged.StripUnlikelyCandidates = opts.StripUnlikelyCandidates
 	merged.WeightNodes = opts.WeightNodes
 	merged.CleanConditionally = opts.CleanConditionally
+	merged.KeepSafeStyles = opts.KeepSafeStyles
+	merged.KeepLineBreaks = opts.KeepLineBreaks
+	merged.LinkDensityThreshold = opts.LinkDensityThreshold
+	merged.ContentHint = opts.ContentHint
+	merged.MinParagraphWords = opts.MinParagraphWords
+	merged.DebugScores = opts.DebugScores
+	merged.KeepEmbedHosts = opts.KeepEmbedHosts
 
 	return merged
 }
@@ -175,11 +204,16 @@ func NodeIsSufficient(node *goquery.Selection) bool {
 
 // CleanContentOptions represents options for content cleaning
 type CleanContentOptions struct {
-	Doc                *goquery.Document
-	CleanConditionally bool
-	Title              string
-	URL                string
-	DefaultCleaner     bool
+	Doc                  *goquery.Document
+	CleanConditionally   bool
+	Title                string
+	URL                  string
+	DefaultCleaner       bool
+	KeepSafeStyles       bool
+	KeepLineBreaks       bool
+	LinkDensityThreshold float64
+	MinParagraphWords    int
+	KeepEmbedHosts       []string
 }
 
 // CleanContent cleans article content, returning a new, cleaned node
@@ -199,6 +233,18 @@ func CleanContent(article *goquery.Selection, opts CleanContentOptions) *goquery
 	// NOTE: Go DOM functions operate on entire document, not individual selections
 	doc := opts.Doc
 
+	// Single <br> inside a paragraph is a soft wrap, keep it only when the output can represent it.
+	// This runs before RewriteTopLevel re-parses the document so it reaches the returned article node.
+	if !opts.KeepLineBreaks {
+		doc = dom.CollapseBrs(doc)
+	}
+
+	// Short orphaned paragraphs are captions and UI labels; drop them before the re-parse for the same reason
+	doc = dom.RemoveShortParagraphs(doc, opts.MinParagraphWords)
+
+	// "Related posts" and "You may also like" teaser blocks that scored into the content
+	doc = dom.RemoveRelatedWidgets(doc)
+
 	// Rewrite the tag name to div if it's a top level node like body or html
 	// to avoid later complications with multiple body tags.
 	doc = dom.RewriteTopLevel(doc)
@@ -215,8 +261,8 @@ func CleanContent(article *goquery.Selection, opts CleanContentOptions) *goquery
 
 	// Mark elements to keep that would normally be removed.
 	// E.g., stripJunkTags will remove iframes, so we're going to mark
-	// YouTube/Vimeo videos as elements we want to keep.
-	doc = dom.MarkToKeep(doc)
+	// YouTube/Vimeo videos and other known embeds as elements we want to keep.
+	doc = dom.MarkToKeepEmbeds(doc, opts.KeepEmbedHosts)
 
 	// Drop certain tags like <title>, etc
 	// This is -mostly- for cleanliness, not security.
@@ -235,14 +281,18 @@ func CleanContent(article *goquery.Selection, opts CleanContentOptions) *goquery
 	// way to detect menus particularly and remove them.
 	// Also optionally running, since it can be overly aggressive.
 	if defaultCleaner {
-		doc = dom.CleanTags(doc)
+		doc = dom.CleanTagsWithLinkDensity(doc, opts.LinkDensityThreshold)
 	}
 
 	// Remove empty paragraph nodes
 	doc = dom.RemoveEmpty(doc)
 
-	// Remove unnecessary attributes
-	doc = dom.CleanAttributes(doc)
+	// Remove unnecessary attributes, optionally keeping safe inline styles as readability hints
+	if opts.KeepSafeStyles {
+		doc = dom.CleanAttributesWithSafeStyles(doc)
+	} else {
+		doc = dom.CleanAttributes(doc)
+	}
 
 	// After cleaning the document, we need to find the corresponding element
 	// This is a limitation of the Go approach - we clean the entire document
@@ -0,0 +1,128 @@
+// ABOUTME: Continue-reading link detector for truncated teaser pages that link to the full article body
+// ABOUTME: Distinguishes "continue reading" links to the same article from genuine related-article links
+
+package generic
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/BumpyClock/hermes/internal/utils/text"
+	"github.com/PuerkitoBio/goquery"
+)
+
+var (
+	// CONTINUE_READING_TEXT_RE matches link text used by sites that serve a truncated teaser
+	CONTINUE_READING_TEXT_RE = regexp.MustCompile(`(?i)^(continue reading|keep reading|read more|read the rest|read (the )?full (story|article|post)|view (the )?full (story|article))\b`)
+
+	// CONTINUE_READING_TRIM_CHARS are decorations commonly wrapped around continue-reading text
+	CONTINUE_READING_TRIM_CHARS = " \t\n\r.…→»›>"
+
+	// CONTINUE_READING_CONTROLS are the links and buttons sites use as continue-reading markers
+	CONTINUE_READING_CONTROLS = "a[href], button, [role=button]"
+
+	// CONTINUE_READING_URL_ATTRS hold the target of button-style controls, in order of preference
+	CONTINUE_READING_URL_ATTRS = []string{"href", "data-href", "data-url", "data-link", "formaction"}
+
+	// CONTINUE_READING_ONCLICK_RE extracts the URL a button navigates to from its onclick handler
+	CONTINUE_READING_ONCLICK_RE = regexp.MustCompile(`(?i)(?:location(?:\.href)?\s*=|location\.(?:assign|replace)\s*\(|window\.open\s*\()\s*['"]([^'"]+)['"]`)
+)
+
+// GenericContinueReadingExtractor finds the link to the full version of a truncated article
+type GenericContinueReadingExtractor struct{}
+
+// NewGenericContinueReadingExtractor creates a new instance
+func NewGenericContinueReadingExtractor() *GenericContinueReadingExtractor {
+	return &GenericContinueReadingExtractor{}
+}
+
+// Extract returns the absolute URL of a "continue reading" link or button that points
+// back to the same article (or a ?page= variant of it), or an empty string if none is
+// found. Controls whose text matches but which lead to a different article are ignored.
+func (e *GenericContinueReadingExtractor) Extract(doc *goquery.Document, articleURL string, parsedURL *url.URL) string {
+	if doc == nil {
+		return ""
+	}
+
+	if parsedURL == nil {
+		var err error
+		parsedURL, err = url.Parse(articleURL)
+		if err != nil {
+			return ""
+		}
+	}
+
+	var found string
+	doc.Find(CONTINUE_READING_CONTROLS).EachWithBreak(func(i int, control *goquery.Selection) bool {
+		if !IsContinueReadingText(control.Text()) {
+			return true
+		}
+
+		target := continueReadingTarget(control)
+		if target == "" {
+			return true
+		}
+		resolved, err := parsedURL.Parse(target)
+		if err != nil {
+			return true
+		}
+
+		if isSameArticleVariant(resolved, parsedURL) {
+			found = resolved.String()
+			return false
+		}
+		return true
+	})
+
+	return found
+}
+
+// continueReadingTarget returns the URL a continue-reading control leads to: its own
+// href or data attribute, an onclick navigation, the link wrapped around it, or for
+// a button the action of its form. It returns an empty string for controls without one.
+func continueReadingTarget(control *goquery.Selection) string {
+	for _, attr := range CONTINUE_READING_URL_ATTRS {
+		if target := strings.TrimSpace(control.AttrOr(attr, "")); target != "" {
+			return target
+		}
+	}
+	if match := CONTINUE_READING_ONCLICK_RE.FindStringSubmatch(control.AttrOr("onclick", "")); match != nil {
+		return strings.TrimSpace(match[1])
+	}
+	if link := control.Closest("a[href]"); link.Length() > 0 {
+		return strings.TrimSpace(link.AttrOr("href", ""))
+	}
+	if control.Is("button") {
+		return strings.TrimSpace(control.Closest("form").AttrOr("action", ""))
+	}
+	return ""
+}
+
+// IsContinueReadingText reports whether link text looks like a truncation marker
+func IsContinueReadingText(linkText string) bool {
+	linkText = strings.Trim(text.NormalizeSpaces(linkText), CONTINUE_READING_TRIM_CHARS)
+	if linkText == "" || len(linkText) > 40 {
+		return false
+	}
+	return CONTINUE_READING_TEXT_RE.MatchString(linkText)
+}
+
+// isSameArticleVariant reports whether candidate refers to the same article as articleURL
+// but is not identical to it, e.g. the same path with ?page=2 or ?full=1 appended
+func isSameArticleVariant(candidate, articleURL *url.URL) bool {
+	if candidate.Scheme != "http" && candidate.Scheme != "https" {
+		return false
+	}
+
+	if !strings.EqualFold(candidate.Hostname(), articleURL.Hostname()) {
+		return false
+	}
+
+	if strings.TrimSuffix(candidate.Path, "/") != strings.TrimSuffix(articleURL.Path, "/") {
+		return false
+	}
+
+	// Anchors like #more point to the page we already have
+	return candidate.RawQuery != articleURL.RawQuery
+}
@@ -0,0 +1,136 @@
+// ABOUTME: Tests for continue-reading link detection on truncated teaser pages
+// ABOUTME: Verifies same-article variants are followed while related-article links are ignored
+
+package generic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericContinueReadingExtractor_Extract(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		url      string
+		expected string
+	}{
+		{
+			name:     "continue reading link to page variant",
+			html:     `<div><p>Teaser text</p><a href="/news/story?page=2">Continue reading →</a></div>`,
+			url:      "https://example.com/news/story",
+			expected: "https://example.com/news/story?page=2",
+		},
+		{
+			name:     "read full story link with absolute URL",
+			html:     `<div><a class="btn" href="https://example.com/news/story/?full=1">Read full story</a></div>`,
+			url:      "https://example.com/news/story",
+			expected: "https://example.com/news/story/?full=1",
+		},
+		{
+			name:     "read more link to a related article is ignored",
+			html:     `<div><a href="/news/other-story">Read more</a></div>`,
+			url:      "https://example.com/news/story",
+			expected: "",
+		},
+		{
+			name:     "same article anchor is ignored",
+			html:     `<div><a href="/news/story#more">Continue reading</a></div>`,
+			url:      "https://example.com/news/story",
+			expected: "",
+		},
+		{
+			name:     "other domain is ignored",
+			html:     `<div><a href="https://partner.com/news/story?page=2">Continue reading</a></div>`,
+			url:      "https://example.com/news/story",
+			expected: "",
+		},
+		{
+			name:     "long headline starting with read more is ignored",
+			html:     `<div><a href="/news/story?page=2">Read more about the election results in your state tonight</a></div>`,
+			url:      "https://example.com/news/story",
+			expected: "",
+		},
+		{
+			name: "related link before continue link",
+			html: `<div>
+				<a href="/news/related">Read more</a>
+				<a href="/news/story?page=2">Keep reading...</a>
+			</div>`,
+			url:      "https://example.com/news/story",
+			expected: "https://example.com/news/story?page=2",
+		},
+		{
+			name:     "continue reading button with data-href",
+			html:     `<div><p>Teaser text</p><button class="more" data-href="/news/story?page=full">Continue reading</button></div>`,
+			url:      "https://example.com/news/story",
+			expected: "https://example.com/news/story?page=full",
+		},
+		{
+			name:     "role button with onclick navigation",
+			html:     `<div><span role="button" onclick="window.location.href='/news/story?full=1'">Read the full story</span></div>`,
+			url:      "https://example.com/news/story",
+			expected: "https://example.com/news/story?full=1",
+		},
+		{
+			name:     "button wrapped in a link",
+			html:     `<div><a href="/news/story?page=2"><button type="button">Keep reading</button></a></div>`,
+			url:      "https://example.com/news/story",
+			expected: "https://example.com/news/story?page=2",
+		},
+		{
+			name:     "button submitting a form",
+			html:     `<form action="/news/story?page=2" method="get"><button type="submit">Continue reading</button></form>`,
+			url:      "https://example.com/news/story",
+			expected: "https://example.com/news/story?page=2",
+		},
+		{
+			name:     "button without a target is ignored",
+			html:     `<div><button class="expand">Read more</button></div>`,
+			url:      "https://example.com/news/story",
+			expected: "",
+		},
+		{
+			name:     "read more button to a related article is ignored",
+			html:     `<div><button data-href="/news/other-story">Read more</button></div>`,
+			url:      "https://example.com/news/story",
+			expected: "",
+		},
+	}
+
+	extractor := NewGenericContinueReadingExtractor()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+
+			result := extractor.Extract(doc, tt.url, nil)
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestIsContinueReadingText(t *testing.T) {
+	tests := map[string]bool{
+		"Continue reading":        true,
+		"Continue Reading →":      true,
+		"  read the full article": true,
+		"» Read more":             true,
+		"View full story":         true,
+		"Next page":               false,
+		"Readmore magazine":       false,
+		"":                        false,
+	}
+
+	for input, expected := range tests {
+		if result := IsContinueReadingText(input); result != expected {
+			t.Errorf("IsContinueReadingText(%q) = %v, expected %v", input, result, expected)
+		}
+	}
+}
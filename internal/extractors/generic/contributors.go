@@ -0,0 +1,196 @@
+// ABOUTME: GenericContributorsExtractor separates article authors from editors and other contributors
+// ABOUTME: Reads JSON-LD author/contributor/editor and role-qualified bylines like "Reported by X, edited by Y"
+
+package generic
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// AuthorInfo is a person credited on the article and the role they played
+type AuthorInfo struct {
+	Name string
+	Role string // "author", "editor", "contributor", "photographer", ...
+}
+
+// RoleAuthor is the role of people credited with writing the article
+const RoleAuthor = "author"
+
+// Role phrases in bylines, e.g. "Reported by", "edited by", "Contributing:"
+var bylineRoleRE = regexp.MustCompile(`(?i)\b(?:(additional reporting|reporting|reported|written|words|text|story|edited|editing|fact-checked|contributions?|photos?|photography|photographs|illustrations?|illustrated|graphics|video|produced|research)\s+by|(contributing(?: reporting)?|additional reporting)\s*:|(by))\b\s*:?`)
+
+// Role names for the phrases bylineRoleRE captures
+var bylineRoles = map[string]string{
+	"additional reporting":   "contributor",
+	"reporting":              RoleAuthor,
+	"reported":               RoleAuthor,
+	"written":                RoleAuthor,
+	"words":                  RoleAuthor,
+	"text":                   RoleAuthor,
+	"story":                  RoleAuthor,
+	"by":                     RoleAuthor,
+	"edited":                 "editor",
+	"editing":                "editor",
+	"fact-checked":           "fact-checker",
+	"contribution":           "contributor",
+	"contributions":          "contributor",
+	"contributing":           "contributor",
+	"contributing reporting": "contributor",
+	"photo":                  "photographer",
+	"photos":                 "photographer",
+	"photography":            "photographer",
+	"photographs":            "photographer",
+	"illustration":           "illustrator",
+	"illustrations":          "illustrator",
+	"illustrated":            "illustrator",
+	"graphics":               "graphics",
+	"video":                  "video",
+	"produced":               "producer",
+	"research":               "researcher",
+}
+
+// Separators between names in one byline segment
+var bylineNameSplitRE = regexp.MustCompile(`(?i)\s*(?:,|;|&|\band\b)\s*`)
+
+// Bylines holding role-qualified credits
+const contributorBylineSelectors = "#byline, .byline, .credits, .article-credits, .contributors"
+
+// Longest plausible credited name
+const maxCreditNameLength = 80
+
+// GenericContributorsExtractor extracts authors and contributors with their roles
+type GenericContributorsExtractor struct{}
+
+// Extract returns the people credited as authors and, separately, everyone
+// credited in another role. JSON-LD author lists win over bylines for
+// authors; contributors from both sources are merged. A name credited as an
+// author is never repeated among the contributors.
+func (extractor *GenericContributorsExtractor) Extract(selection *goquery.Selection) (authors, contributors []AuthorInfo) {
+	jsonLDCredits := creditsFromJSONLD(selection)
+	bylineCredits := creditsFromByline(selection)
+
+	authorCredits := bylineCredits
+	for _, credit := range jsonLDCredits {
+		if credit.Role == RoleAuthor {
+			authorCredits = jsonLDCredits
+			break
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, credit := range authorCredits {
+		if credit.Role == RoleAuthor && !seen[strings.ToLower(credit.Name)] {
+			seen[strings.ToLower(credit.Name)] = true
+			authors = append(authors, credit)
+		}
+	}
+	for _, credit := range append(jsonLDCredits, bylineCredits...) {
+		if credit.Role != RoleAuthor && !seen[strings.ToLower(credit.Name)] {
+			seen[strings.ToLower(credit.Name)] = true
+			contributors = append(contributors, credit)
+		}
+	}
+
+	return authors, contributors
+}
+
+// creditsFromJSONLD reads author, editor and contributor from the first
+// JSON-LD object that credits anyone
+func creditsFromJSONLD(selection *goquery.Selection) []AuthorInfo {
+	var credits []AuthorInfo
+	selection.Find(`script[type="application/ld+json"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+		var data interface{}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(s.Text())), &data); err != nil {
+			return true // Skip invalid JSON
+		}
+		walkJSONLDObjects(data, func(obj map[string]interface{}) {
+			if len(credits) > 0 {
+				return
+			}
+			for _, field := range [...]struct{ key, role string }{
+				{"author", RoleAuthor},
+				{"creator", RoleAuthor},
+				{"editor", "editor"},
+				{"contributor", "contributor"},
+			} {
+				for _, name := range jsonLDPersonNames(obj[field.key]) {
+					credits = append(credits, AuthorInfo{Name: name, Role: field.role})
+				}
+			}
+		})
+		return len(credits) == 0
+	})
+	return credits
+}
+
+// jsonLDPersonNames reads names from a string, a Person object or a list of either
+func jsonLDPersonNames(value interface{}) []string {
+	var names []string
+	switch v := value.(type) {
+	case string:
+		if name := cleanCreditName(v); name != "" {
+			names = append(names, name)
+		}
+	case map[string]interface{}:
+		if name := cleanCreditName(jsonLDString(v["name"])); name != "" {
+			names = append(names, name)
+		}
+	case []interface{}:
+		for _, item := range v {
+			names = append(names, jsonLDPersonNames(item)...)
+		}
+	}
+	return names
+}
+
+// creditsFromByline splits the first byline at role phrases such as
+// "Reported by" and "edited by" and credits each segment's names with that role
+func creditsFromByline(selection *goquery.Selection) []AuthorInfo {
+	byline := strings.Join(strings.Fields(selection.Find(contributorBylineSelectors).First().Text()), " ")
+	if byline == "" {
+		return nil
+	}
+
+	matches := bylineRoleRE.FindAllStringSubmatchIndex(byline, -1)
+	var credits []AuthorInfo
+	for i, match := range matches {
+		phrase := ""
+		for group := 1; group <= 3; group++ {
+			if match[2*group] >= 0 {
+				phrase = strings.ToLower(byline[match[2*group]:match[2*group+1]])
+				break
+			}
+		}
+		role, ok := bylineRoles[phrase]
+		if !ok {
+			continue
+		}
+
+		end := len(byline)
+		if i+1 < len(matches) {
+			end = matches[i+1][0]
+		}
+		// Pipes and middots separate the credits from dates and other byline furniture
+		segment, _, _ := strings.Cut(byline[match[1]:end], "|")
+		segment, _, _ = strings.Cut(segment, "·")
+		for _, name := range bylineNameSplitRE.Split(segment, -1) {
+			if name = cleanCreditName(name); name != "" {
+				credits = append(credits, AuthorInfo{Name: name, Role: role})
+			}
+		}
+	}
+	return credits
+}
+
+// cleanCreditName trims punctuation around a name and rejects implausible ones
+func cleanCreditName(name string) string {
+	name = strings.Trim(strings.Join(strings.Fields(name), " "), " .,;:|-–—")
+	if name == "" || len(name) > maxCreditNameLength || strings.ContainsAny(name, "<>{}") {
+		return ""
+	}
+	return name
+}
@@ -0,0 +1,61 @@
+// ABOUTME: Tests for GenericContributorsExtractor
+// ABOUTME: Covers role-qualified bylines, JSON-LD author/editor/contributor and author-over-contributor precedence
+
+package generic
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericContributorsExtractor(t *testing.T) {
+	tests := []struct {
+		name         string
+		html         string
+		authors      []AuthorInfo
+		contributors []AuthorInfo
+	}{
+		{
+			name:         "role-qualified byline",
+			html:         `<p class="byline">Reported by Ana Ruiz and Tom Hale, edited by Priya Shah. Photos by Lee Park</p>`,
+			authors:      []AuthorInfo{{"Ana Ruiz", "author"}, {"Tom Hale", "author"}},
+			contributors: []AuthorInfo{{"Priya Shah", "editor"}, {"Lee Park", "photographer"}},
+		},
+		{
+			name:    "plain byline",
+			html:    `<div class="byline">By Maria Lopez | Updated 4 March 2024</div>`,
+			authors: []AuthorInfo{{"Maria Lopez", "author"}},
+		},
+		{
+			name: "jsonld authors win and contributors merge",
+			html: `<script type="application/ld+json">{"@type":"NewsArticle","headline":"Dam Report","author":[{"@type":"Person","name":"Ana Ruiz"}],"editor":"Priya Shah","contributor":[{"name":"Sam Cole"}]}</script>
+<p class="byline">By Ana Ruiz. Additional reporting by Jo Kim</p>`,
+			authors:      []AuthorInfo{{"Ana Ruiz", "author"}},
+			contributors: []AuthorInfo{{"Priya Shah", "editor"}, {"Sam Cole", "contributor"}, {"Jo Kim", "contributor"}},
+		},
+		{
+			name: "no credits",
+			html: `<p>Nothing credited here.</p>`,
+		},
+	}
+
+	extractor := &GenericContributorsExtractor{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html><body>" + tt.html + "</body></html>"))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+			authors, contributors := extractor.Extract(doc.Selection)
+			if !reflect.DeepEqual(authors, tt.authors) {
+				t.Errorf("Expected authors %v, got %v", tt.authors, authors)
+			}
+			if !reflect.DeepEqual(contributors, tt.contributors) {
+				t.Errorf("Expected contributors %v, got %v", tt.contributors, contributors)
+			}
+		})
+	}
+}
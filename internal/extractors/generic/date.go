@@ -58,15 +58,6 @@ var DATE_PUBLISHED_SELECTORS = []string{
 	".pubdate",
 }
 
-// DATE_PUBLISHED_URL_RES - Ordered list of compiled regular expressions to find likely date
-// published dates from the URL. These should always have the first reference be a date string
-// that is parseable. Matches JavaScript exactly.
-var DATE_PUBLISHED_URL_RES = []*regexp.Regexp{
-	regexp.MustCompile(`/(20\d{2}/\d{2}/\d{2})/`),                                                // 2023/12/01
-	regexp.MustCompile(`(20\d{2}-[01]\d-[0-3]\d)`),                                             // 2023-12-01
-	regexp.MustCompile(`/(20\d{2}/(jan|feb|mar|apr|may|jun|jul|aug|sep|oct|nov|dec)/[0-3]\d)/`), // 2023/dec/01
-}
-
 // JavaScript date cleaner constants (ported from cleaners/constants.js)
 var (
 	MS_DATE_STRING        = regexp.MustCompile(`^\d{13}$`)
@@ -91,8 +82,33 @@ var GenericDateExtractor = GenericDateExtractorType{}
 
 // Extract publication date from document using meta tags, selectors, and URL patterns
 func (e GenericDateExtractorType) Extract(doc *goquery.Selection, url string, metaCache []string) *string {
+	return e.ExtractWithLocale(doc, url, metaCache, "")
+}
+
+// ExtractWithLocale extracts the publication date, reading ambiguous numeric dates and
+// localized month names according to locale (e.g. "en-GB", "fr"). An empty locale
+// behaves exactly like Extract.
+func (e GenericDateExtractorType) ExtractWithLocale(doc *goquery.Selection, url string, metaCache []string, locale string) *string {
+	date, _ := e.ExtractWithSource(doc, url, metaCache, locale)
+	return date
+}
+
+// ExtractWithSource extracts the publication date like ExtractWithLocale and also
+// reports where it was found (SourceMeta, SourceSelector or SourceURL)
+func (e GenericDateExtractorType) ExtractWithSource(doc *goquery.Selection, url string, metaCache []string, locale string) (*string, string) {
+	return e.ExtractWithSourceAt(doc, url, metaCache, locale, time.Now())
+}
+
+// ExtractWithSourceAt is ExtractWithSource with relative dates ("5 minutes
+// ago", "yesterday") resolved against now instead of the system clock
+func (e GenericDateExtractorType) ExtractWithSourceAt(doc *goquery.Selection, url string, metaCache []string, locale string, now time.Time) (*string, string) {
 	var datePublished string
 	
+	options := map[string]interface{}{"now": now}
+	if locale != "" {
+		options["locale"] = locale
+	}
+	
 	// Convert Selection to Document for meta tag extraction
 	var document *goquery.Document
 	if html, err := doc.Html(); err == nil {
@@ -109,8 +125,8 @@ func (e GenericDateExtractorType) Extract(doc *goquery.Selection, url string, me
 	if document != nil {
 		if meta := dom.ExtractFromMeta(document, DATE_PUBLISHED_META_TAGS, metaCache, false); meta != nil {
 			datePublished = *meta
-			if cleaned := cleanDatePublished(datePublished, nil); cleaned != nil {
-				return cleaned
+			if cleaned := cleanDatePublished(datePublished, options); cleaned != nil {
+				return cleaned, SourceMeta
 			}
 		}
 	}
@@ -118,20 +134,20 @@ func (e GenericDateExtractorType) Extract(doc *goquery.Selection, url string, me
 	// Second, look through our selectors looking for potential date_published's
 	if selector := dom.ExtractFromSelectors(doc, DATE_PUBLISHED_SELECTORS, 5, false); selector != nil {
 		datePublished = *selector
-		if cleaned := cleanDatePublished(datePublished, nil); cleaned != nil {
-			return cleaned
+		if cleaned := cleanDatePublished(datePublished, options); cleaned != nil {
+			return cleaned, SourceSelector
 		}
 	}
 	
-	// Lastly, look to see if a date string exists in the URL
-	if urlDate, found := text.ExtractFromURL(url, DATE_PUBLISHED_URL_RES); found {
+	// Lastly, look to see if a date string exists in the URL path
+	if urlDate, found := dom.ExtractDateFromURL(url); found {
 		datePublished = urlDate
-		if cleaned := cleanDatePublished(datePublished, nil); cleaned != nil {
-			return cleaned
+		if cleaned := cleanDatePublished(datePublished, options); cleaned != nil {
+			return cleaned, SourceURL
 		}
 	}
 	
-	return nil
+	return nil, ""
 }
 
 // cleanDatePublished takes a date published string and returns a clean ISO date string
@@ -141,10 +157,18 @@ func cleanDatePublished(dateString string, options map[string]interface{}) *stri
 		return nil
 	}
 	
-	// Handle timezone and format options (for future compatibility)
+	// Handle timezone and format options (for future compatibility) and the parsing locale
 	var timezone string
 	var format string
+	var locale string
+	now := time.Now()
 	if options != nil {
+		if loc, ok := options["locale"].(string); ok {
+			locale = loc
+		}
+		if t, ok := options["now"].(time.Time); ok {
+			now = t
+		}
 		if tz, ok := options["timezone"].(string); ok {
 			timezone = tz
 		}
@@ -172,14 +196,14 @@ func cleanDatePublished(dateString string, options map[string]interface{}) *stri
 	}
 	
 	// Try to create date using various parsing strategies
-	if date := createDate(dateString, timezone, format); date != nil {
+	if date := createDate(dateString, timezone, format, locale, now); date != nil {
 		iso := date.UTC().Format("2006-01-02T15:04:05.000Z")
 		return &iso
 	}
 	
 	// If that failed, clean the date string and try again
 	cleanedDateString := cleanDateString(dateString)
-	if date := createDate(cleanedDateString, timezone, format); date != nil {
+	if date := createDate(cleanedDateString, timezone, format, locale, now); date != nil {
 		iso := date.UTC().Format("2006-01-02T15:04:05.000Z")
 		return &iso
 	}
@@ -210,8 +234,9 @@ func cleanDateString(dateString string) string {
 }
 
 // createDate creates a time.Time from various date string formats
-// Implements JavaScript moment.js-like behavior
-func createDate(dateString, timezone, format string) *time.Time {
+// Implements JavaScript moment.js-like behavior, locale picks day/month order and month names
+// Relative dates are measured back from now
+func createDate(dateString, timezone, format, locale string, now time.Time) *time.Time {
 	if dateString == "" {
 		return nil
 	}
@@ -234,7 +259,6 @@ func createDate(dateString, timezone, format string) *time.Time {
 			amount, err := strconv.Atoi(matches[1])
 			if err == nil {
 				unit := matches[2]
-				now := time.Now()
 				
 				// Convert to singular for switch statement
 				unit = strings.TrimSuffix(unit, "s")
@@ -267,7 +291,6 @@ func createDate(dateString, timezone, format string) *time.Time {
 	
 	// Check for "now" strings
 	if TIME_NOW_STRING.MatchString(dateString) {
-		now := time.Now()
 		return &now
 	}
 	
@@ -276,7 +299,7 @@ func createDate(dateString, timezone, format string) *time.Time {
 	_ = format   // Custom format support not implemented - uses standard Go layouts
 	
 	// Try general-purpose date parsing (using existing text utils)
-	if parsed, err := text.ParseDate(dateString); err == nil {
+	if parsed, err := text.ParseDateAt(dateString, locale, now); err == nil {
 		// Convert to UTC to match JavaScript behavior
 		utc := parsed.UTC()
 		return &utc
@@ -0,0 +1,66 @@
+// ABOUTME: GenericDateModifiedExtractor reads when an article was last updated
+// ABOUTME: Uses modified-time meta tags, then JSON-LD dateModified, cleaned like the publish date
+
+package generic
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Meta tag names declaring the last update, most specific first
+var DATE_MODIFIED_META_TAGS = []string{
+	"article:modified_time",
+	"og:updated_time",
+	"dcterms.modified",
+	"dc.date.modified",
+	"date_modified",
+}
+
+// GenericDateModifiedExtractor extracts the article's last update time
+type GenericDateModifiedExtractor struct {
+	Now time.Time // Resolves relative dates like "2 hours ago", zero uses the system clock
+}
+
+// Extract returns the update time as an ISO 8601 string, or nil when the page
+// declares none. locale is used for ambiguous dates as in GenericDateExtractor.
+func (extractor *GenericDateModifiedExtractor) Extract(selection *goquery.Selection, locale string) *string {
+	options := map[string]interface{}{}
+	if locale != "" {
+		options["locale"] = locale
+	}
+	if !extractor.Now.IsZero() {
+		options["now"] = extractor.Now
+	}
+
+	for _, name := range DATE_MODIFIED_META_TAGS {
+		var cleaned *string
+		selection.Find(`meta[name="` + name + `"], meta[property="` + name + `"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+			cleaned = cleanDatePublished(strings.TrimSpace(s.AttrOr("value", s.AttrOr("content", ""))), options)
+			return cleaned == nil
+		})
+		if cleaned != nil {
+			return cleaned
+		}
+	}
+
+	var cleaned *string
+	selection.Find(`script[type="application/ld+json"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+		var data interface{}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(s.Text())), &data); err != nil {
+			return true // Skip invalid JSON
+		}
+		walkJSONLDObjects(data, func(obj map[string]interface{}) {
+			if cleaned == nil {
+				if modified := jsonLDString(obj["dateModified"]); modified != "" {
+					cleaned = cleanDatePublished(modified, options)
+				}
+			}
+		})
+		return cleaned == nil
+	})
+	return cleaned
+}
@@ -0,0 +1,45 @@
+// ABOUTME: Tests for GenericDateModifiedExtractor
+// ABOUTME: Covers modified-time meta tags, JSON-LD dateModified and offset normalization
+
+package generic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericDateModifiedExtractor(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected string
+	}{
+		{"normalized meta", `<meta name="article:modified_time" value="2024-03-05T10:30:00Z">`, "2024-03-05T10:30:00.000Z"},
+		{"property meta with offset", `<meta property="og:updated_time" content="2024-03-05T12:30:00+02:00">`, "2024-03-05T10:30:00.000Z"},
+		{"jsonld", `<script type="application/ld+json">{"@graph":[{"@type":"NewsArticle","datePublished":"2024-03-01T08:00:00Z","dateModified":"2024-03-05T10:30:00Z"}]}</script>`, "2024-03-05T10:30:00.000Z"},
+		{"meta wins over jsonld", `<meta name="article:modified_time" value="2024-03-05T10:30:00Z"><script type="application/ld+json">{"dateModified":"2024-01-01T00:00:00Z"}</script>`, "2024-03-05T10:30:00.000Z"},
+		{"none", `<meta name="article:published_time" value="2024-03-01T08:00:00Z">`, ""},
+	}
+
+	extractor := &GenericDateModifiedExtractor{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html><head>" + tt.html + "</head><body></body></html>"))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+			got := extractor.Extract(doc.Selection, "")
+			if tt.expected == "" {
+				if got != nil {
+					t.Errorf("Expected no date, got %q", *got)
+				}
+				return
+			}
+			if got == nil || *got != tt.expected {
+				t.Errorf("Expected %q, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -39,7 +39,10 @@ var (
 )
 
 // GenericDekExtractor extracts article subtitles/descriptions (deks)
-type GenericDekExtractor struct{}
+type GenericDekExtractor struct {
+	// AllowURLs keeps deks that mention a plain-text URL instead of rejecting them
+	AllowURLs bool
+}
 
 // Extract extracts dek from meta tags and selectors with validation and cleaning
 func (e *GenericDekExtractor) Extract(doc *goquery.Document, opts map[string]interface{}) string {
@@ -147,7 +150,7 @@ func (e *GenericDekExtractor) cleanDek(dek, excerpt string) string {
 	}
 	
 	// Plain text links shouldn't exist in the dek
-	if textLinkRE.MatchString(dekText) {
+	if !e.AllowURLs && textLinkRE.MatchString(dekText) {
 		return ""
 	}
 	
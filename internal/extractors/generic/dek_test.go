@@ -228,6 +228,33 @@ func TestGenericDekExtractor_Extract_Validation(t *testing.T) {
 	}
 }
 
+func TestGenericDekExtractor_Extract_AllowURLs(t *testing.T) {
+	html := `<html>
+		<head><meta name="description" content="Sign up for the newsletter at https://example.com/letters every Friday" /></head>
+		<body><div>Content</div></body>
+	</html>`
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+	opts := map[string]interface{}{"$": doc.Selection}
+
+	if result := (&GenericDekExtractor{}).Extract(doc, opts); result != "" {
+		t.Errorf("Expected dek with a URL to be rejected by default, got %q", result)
+	}
+
+	expected := "Sign up for the newsletter at https://example.com/letters every Friday"
+	if result := (&GenericDekExtractor{AllowURLs: true}).Extract(doc, opts); result != expected {
+		t.Errorf("Expected %q with AllowURLs, got %q", expected, result)
+	}
+
+	// Length checks still apply
+	short, _ := goquery.NewDocumentFromReader(strings.NewReader(`<html><head><meta name="description" content="Hi" /></head></html>`))
+	if result := (&GenericDekExtractor{AllowURLs: true}).Extract(short, map[string]interface{}{"$": short.Selection}); result != "" {
+		t.Errorf("Expected short dek to be rejected with AllowURLs, got %q", result)
+	}
+}
+
 func TestGenericDekExtractor_Extract_ExcerptComparison(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -285,9 +285,13 @@ func TestExcerptExtractor_JavaScriptCompatibility(t *testing.T) {
 // Helper function to build meta cache (simulating what would be done in the actual parser)
 func buildMetaCache(doc *goquery.Document) []string {
 	var metaNames []string
-	doc.Find("meta[name]").Each(func(i int, s *goquery.Selection) {
-		if name, exists := s.Attr("name"); exists {
-			metaNames = append(metaNames, name)
+	seen := make(map[string]bool)
+	doc.Find("meta").Each(func(i int, s *goquery.Selection) {
+		for _, attr := range []string{"name", "property"} {
+			if name, exists := s.Attr(attr); exists && name != "" && !seen[name] {
+				metaNames = append(metaNames, name)
+				seen[name] = true
+			}
 		}
 	})
 	return metaNames
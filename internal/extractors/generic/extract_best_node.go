@@ -12,13 +12,18 @@ import (
 type ExtractBestNodeOptions struct {
 	StripUnlikelyCandidates bool
 	WeightNodes             bool
+	ContentHint             string                // Selector whose matches get a score boost, empty for none
+	Ranking                 *[]dom.CandidateScore // Receives the top scored candidates before the winner is chosen, nil skips ranking
 }
 
+// Number of candidates recorded when a ranking is requested
+const rankedCandidateLimit = 10
+
 // ExtractBestNode extracts the content most likely to be article text using a variety of scoring techniques.
 //
 // The function orchestrates the complete extraction pipeline:
 // 1. Optionally strips unlikely candidates (comments, ads, etc.)
-// 2. Converts elements to paragraphs for better scoring
+// 2. Splits <br><br>-separated paragraphs and converts elements to paragraphs for better scoring
 // 3. Scores all content based on various signals
 // 4. Finds and returns the top candidate element
 //
@@ -29,6 +34,8 @@ type ExtractBestNodeOptions struct {
 //   - opts: ExtractBestNodeOptions with configuration flags
 //     - StripUnlikelyCandidates: If true, remove elements that match exclusion criteria
 //     - WeightNodes: If true, use classNames and IDs to determine node worthiness
+//     - ContentHint: Selector for where the caller expects content, boosted but not forced
+//     - Ranking: If set, receives the top scored candidates for diagnostics
 //
 // Returns:
 //   - *goquery.Selection: The top candidate element, or nil if no suitable content found
@@ -36,13 +43,22 @@ func ExtractBestNode(doc *goquery.Document, opts ExtractBestNodeOptions) *goquer
 	// Step 1: Conditionally strip unlikely candidates
 	if opts.StripUnlikelyCandidates {
 		doc = dom.StripUnlikelyCandidates(doc)
+	} else {
+		// Lax passes keep unlikely candidates, but a long comment thread must never win scoring
+		doc = dom.StripCommentSections(doc)
 	}
 
-	// Step 2: Convert elements to paragraphs for better scoring
+	// Step 2: Split <br><br>-separated paragraphs and convert elements to paragraphs for better scoring
+	doc = dom.SplitBrParagraphs(doc)
 	doc = dom.ConvertToParagraphs(doc)
 
 	// Step 3: Score all content using the scoring system
 	dom.ScoreContent(doc, opts.WeightNodes)
+	dom.BoostContentHint(doc, opts.ContentHint)
+
+	if opts.Ranking != nil {
+		*opts.Ranking = dom.RankCandidates(doc, rankedCandidateLimit)
+	}
 
 	// Step 4: Find and return the top candidate
 	topCandidate := dom.FindTopCandidate(doc)
@@ -1,6 +1,9 @@
 package generic
 
 import (
+	"net/url"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/PuerkitoBio/goquery"
@@ -9,8 +12,18 @@ import (
 // GenericFaviconExtractor extracts the favicon URL
 type GenericFaviconExtractor struct{}
 
-// Extract extracts the favicon URL from the page
+// Extract extracts the favicon URL from the page, falling back to
+// /favicon.ico on the page's host when no icon link is declared
 func (extractor *GenericFaviconExtractor) Extract(selection *goquery.Selection, pageURL string, metaCache []string) string {
+	if declared := extractor.Declared(selection, pageURL); declared != "" {
+		return declared
+	}
+	return FallbackFaviconURL(pageURL)
+}
+
+// Declared returns the preferred icon declared by a link tag, or empty when
+// the page declares none
+func (extractor *GenericFaviconExtractor) Declared(selection *goquery.Selection, pageURL string) string {
 	// Priority order for favicon extraction
 	linkRels := []string{
 		"apple-touch-icon",
@@ -26,9 +39,121 @@ func (extractor *GenericFaviconExtractor) Extract(selection *goquery.Selection,
 			return extractor.normalizeURL(href, pageURL)
 		}
 	}
+	return ""
+}
+
+// FallbackFaviconURL returns scheme://host/favicon.ico for an HTTP(S) page
+// URL, where browsers look for an icon when none is declared, or empty for
+// URLs without a host
+func FallbackFaviconURL(pageURL string) string {
+	parsed, err := url.Parse(strings.TrimSpace(pageURL))
+	if err != nil || parsed.Host == "" {
+		return ""
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return ""
+	}
+	return (&url.URL{Scheme: parsed.Scheme, Host: parsed.Host, Path: "/favicon.ico"}).String()
+}
+
+// IconInfo describes one icon declared by the page
+type IconInfo struct {
+	URL   string
+	Sizes string // Declared sizes, e.g. "180x180" or "any"
+	Type  string // MIME type, e.g. "image/png"
+}
+
+// Link rel tokens that declare icons
+var iconRels = map[string]bool{
+	"icon":                         true,
+	"apple-touch-icon":             true,
+	"apple-touch-icon-precomposed": true,
+	"mask-icon":                    true,
+}
+
+// ExtractIcons returns every declared icon, resolved to absolute URLs and
+// sorted largest-first by declared size. Scalable icons (sizes="any") sort
+// first and icons without a declared size sort last.
+func (extractor *GenericFaviconExtractor) ExtractIcons(selection *goquery.Selection, pageURL string) []IconInfo {
+	base, _ := url.Parse(pageURL)
+
+	var icons []IconInfo
+	seen := make(map[string]bool)
+	selection.Find("link[rel][href]").Each(func(i int, s *goquery.Selection) {
+		if !isIconRel(s.AttrOr("rel", "")) {
+			return
+		}
+
+		iconURL := resolveIconURL(s.AttrOr("href", ""), base)
+		if iconURL == "" || seen[iconURL] {
+			return
+		}
+		seen[iconURL] = true
+
+		icons = append(icons, IconInfo{
+			URL:   iconURL,
+			Sizes: strings.TrimSpace(s.AttrOr("sizes", "")),
+			Type:  strings.TrimSpace(s.AttrOr("type", "")),
+		})
+	})
+
+	sort.SliceStable(icons, func(i, j int) bool {
+		return iconSize(icons[i].Sizes) > iconSize(icons[j].Sizes)
+	})
+
+	return icons
+}
+
+// isIconRel reports whether a link rel attribute contains an icon token
+func isIconRel(rel string) bool {
+	for _, token := range strings.Fields(strings.ToLower(rel)) {
+		if iconRels[token] {
+			return true
+		}
+	}
+	return false
+}
 
-	// Default favicon.ico
-	return "/favicon.ico"
+// resolveIconURL resolves an icon href against the page URL, dropping non-HTTP(S) URLs
+func resolveIconURL(href string, base *url.URL) string {
+	href = strings.TrimSpace(href)
+	if href == "" {
+		return ""
+	}
+
+	parsed, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+	if base != nil {
+		parsed = base.ResolveReference(parsed)
+	}
+	if parsed.Scheme == "" && strings.HasPrefix(href, "//") {
+		parsed.Scheme = "https"
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return ""
+	}
+	return parsed.String()
+}
+
+// iconSize returns the largest width declared in a sizes attribute,
+// treating "any" as larger than any fixed size and missing sizes as 0
+func iconSize(sizes string) int {
+	largest := 0
+	for _, size := range strings.Fields(strings.ToLower(sizes)) {
+		if size == "any" {
+			return int(^uint(0) >> 1)
+		}
+		width, _, found := strings.Cut(size, "x")
+		if !found {
+			continue
+		}
+		if w, err := strconv.Atoi(width); err == nil && w > largest {
+			largest = w
+		}
+	}
+	return largest
 }
 
 // normalizeURL ensures the favicon URL is absolute
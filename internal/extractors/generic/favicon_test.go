@@ -0,0 +1,112 @@
+// ABOUTME: Test suite for site icon extraction from link tags
+// ABOUTME: Verifies URL resolution, rel matching and largest-first ordering by declared size
+
+package generic
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericFaviconExtractor_ExtractIcons(t *testing.T) {
+	html := `<html><head>
+		<link rel="icon" href="/favicon-16.png" sizes="16x16" type="image/png">
+		<link rel="shortcut icon" href="/favicon.ico">
+		<link rel="apple-touch-icon" href="/apple-touch-icon.png" sizes="180x180">
+		<link rel="icon" href="/favicon-32.png" sizes="32x32 16x16" type="image/png">
+		<link rel="mask-icon" href="//cdn.example.com/safari-pinned-tab.svg" color="#5bbad5">
+		<link rel="icon" href="/icon.svg" sizes="any" type="image/svg+xml">
+		<link rel="icon" href="/favicon-16.png" sizes="16x16">
+		<link rel="stylesheet" href="/site.css">
+		<link rel="icon" href="data:image/png;base64,AAAA">
+	</head></html>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+
+	extractor := &GenericFaviconExtractor{}
+	got := extractor.ExtractIcons(doc.Selection, "https://example.com/news/story")
+
+	expected := []IconInfo{
+		{URL: "https://example.com/icon.svg", Sizes: "any", Type: "image/svg+xml"},
+		{URL: "https://example.com/apple-touch-icon.png", Sizes: "180x180"},
+		{URL: "https://example.com/favicon-32.png", Sizes: "32x32 16x16", Type: "image/png"},
+		{URL: "https://example.com/favicon-16.png", Sizes: "16x16", Type: "image/png"},
+		{URL: "https://example.com/favicon.ico"},
+		{URL: "https://cdn.example.com/safari-pinned-tab.svg"},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestGenericFaviconExtractor_ExtractIconsNone(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<html><head><title>No icons</title></head></html>`))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+
+	extractor := &GenericFaviconExtractor{}
+	if got := extractor.ExtractIcons(doc.Selection, "https://example.com/"); got != nil {
+		t.Errorf("Expected no icons, got %+v", got)
+	}
+}
+
+func TestGenericFaviconExtractor_Extract(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		pageURL  string
+		expected string
+	}{
+		{
+			name:     "declared icon is unchanged",
+			html:     `<html><head><link rel="icon" href="https://cdn.example.com/icon.png"><link rel="shortcut icon" href="/favicon.ico"></head></html>`,
+			pageURL:  "https://example.com/news/story",
+			expected: "https://cdn.example.com/icon.png",
+		},
+		{
+			name:     "apple touch icon is preferred",
+			html:     `<html><head><link rel="icon" href="https://example.com/icon.png"><link rel="apple-touch-icon" href="//example.com/touch.png"></head></html>`,
+			pageURL:  "https://example.com/news/story",
+			expected: "https://example.com/touch.png",
+		},
+		{
+			name:     "no icon falls back to favicon.ico on the host",
+			html:     `<html><head><title>No icons</title></head></html>`,
+			pageURL:  "https://blog.example.com:8443/2024/01/post?id=3#top",
+			expected: "https://blog.example.com:8443/favicon.ico",
+		},
+		{
+			name:     "no icon keeps the page scheme",
+			html:     `<html><head></head></html>`,
+			pageURL:  "http://example.com/post",
+			expected: "http://example.com/favicon.ico",
+		},
+		{
+			name:     "no icon and no host",
+			html:     `<html><head></head></html>`,
+			pageURL:  "/relative/post",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+
+			extractor := &GenericFaviconExtractor{}
+			if got := extractor.Extract(doc.Selection, tt.pageURL, nil); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+// ABOUTME: Names for where an extracted field value came from, reported in Result.FieldSources
+// ABOUTME: Lets consumers tell strong structured-data signals from weak heuristics
+
+package generic
+
+// Field sources, from strongest to weakest signal
+const (
+	SourceCustom    = "custom"            // Site-specific custom extractor selectors
+	SourceJSONLD    = "jsonld"            // JSON-LD structured data
+	SourceMeta      = "meta"              // Meta tags such as og:title or author
+	SourceSelector  = "selector"          // Well-known CSS selectors
+	SourceByline    = "byline"            // Loose byline text patterns
+	SourceURL       = "url"               // Date encoded in the URL path
+	SourceHeuristic = "generic-heuristic" // Content scoring heuristics
+	SourceFallback  = "fallback"          // Last-resort fallbacks such as the <title> tag or body text
+)
@@ -21,6 +21,12 @@ var LEAD_IMAGE_URL_META_TAGS = []string{
 	"image_src",
 }
 
+// Meta tags declaring the size of the og:image or twitter:image
+var (
+	LEAD_IMAGE_WIDTH_META_TAGS  = []string{"og:image:width", "twitter:image:width"}
+	LEAD_IMAGE_HEIGHT_META_TAGS = []string{"og:image:height", "twitter:image:height"}
+)
+
 // Fallback selectors for lead image extraction
 var LEAD_IMAGE_URL_SELECTORS = []string{
 	"link[rel=image_src]",
@@ -58,6 +64,36 @@ type ExtractorImageParams struct {
 	Content   string
 	MetaCache map[string]string
 	HTML      string
+	Options   ImageOptions
+}
+
+// ImageOptions sets hard minimums for images picked from the page content.
+// Candidates below any minimum, or without width and height attributes, are
+// rejected outright instead of merely scored down. Meta tag and image_src
+// link images are exempt since their dimensions are rarely declared.
+type ImageOptions struct {
+	MinWidth  int // Minimum width in pixels, 0 disables
+	MinHeight int // Minimum height in pixels, 0 disables
+	MinArea   int // Minimum width x height in pixels, 0 disables
+}
+
+// enabled reports whether any minimum is set
+func (o ImageOptions) enabled() bool {
+	return o.MinWidth > 0 || o.MinHeight > 0 || o.MinArea > 0
+}
+
+// allows reports whether img meets every configured minimum
+func (o ImageOptions) allows(img *goquery.Selection) bool {
+	if !o.enabled() {
+		return true
+	}
+	width, height, ok := imageDimensions(img)
+	if !ok {
+		return false
+	}
+	return width >= float64(o.MinWidth) &&
+		height >= float64(o.MinHeight) &&
+		width*height >= float64(o.MinArea)
 }
 
 // GenericLeadImageExtractor implements lead image extraction logic
@@ -88,7 +124,7 @@ func (e *GenericLeadImageExtractor) Extract(params ExtractorImageParams) *string
 
 	// Try to find the "best" image via content scoring
 	if params.Content != "" {
-		if imageUrl := e.extractFromContent(doc, params.Content); imageUrl != nil {
+		if imageUrl := e.extractFromContent(doc, params.Content, params.Options); imageUrl != nil {
 			if cleanUrl := cleanImage(*imageUrl); cleanUrl != nil {
 				return cleanUrl
 			}
@@ -105,6 +141,75 @@ func (e *GenericLeadImageExtractor) Extract(params ExtractorImageParams) *string
 	return nil
 }
 
+// Dimensions returns the declared width and height in pixels of imageURL,
+// the lead image chosen for the page at pageURL. og:image:width and
+// og:image:height apply when imageURL is the page's meta image; otherwise the
+// width and height attributes of the matching <img> in content, then in the
+// document, are used. Dimensions that are not declared are 0.
+func (e *GenericLeadImageExtractor) Dimensions(doc *goquery.Document, content, imageURL, pageURL string) (int, int) {
+	if imageURL == "" {
+		return 0, 0
+	}
+	base, _ := url.Parse(pageURL)
+	target := imageURLKey(imageURL, base)
+	if target == "" {
+		return 0, 0
+	}
+
+	if metaImage := e.extractFromMetaTags(doc, nil); metaImage != nil && imageURLKey(*metaImage, base) == target {
+		width := parsePixels(firstMetaValue(doc.Selection, LEAD_IMAGE_WIDTH_META_TAGS))
+		height := parsePixels(firstMetaValue(doc.Selection, LEAD_IMAGE_HEIGHT_META_TAGS))
+		if width > 0 || height > 0 {
+			return width, height
+		}
+	}
+
+	sources := []*goquery.Selection{}
+	if content != "" {
+		if contentDoc, err := goquery.NewDocumentFromReader(strings.NewReader(content)); err == nil {
+			sources = append(sources, contentDoc.Selection)
+		}
+	}
+	sources = append(sources, doc.Selection)
+	for _, source := range sources {
+		var width, height int
+		source.Find("img").EachWithBreak(func(i int, img *goquery.Selection) bool {
+			for _, attr := range []string{"src", "data-src"} {
+				if imageURLKey(img.AttrOr(attr, ""), base) == target {
+					width = parsePixels(img.AttrOr("width", ""))
+					height = parsePixels(img.AttrOr("height", ""))
+					return false
+				}
+			}
+			return true
+		})
+		if width > 0 || height > 0 {
+			return width, height
+		}
+	}
+	return 0, 0
+}
+
+// imageURLKey resolves raw against base for comparison, ignoring whether
+// it is served over http or https
+func imageURLKey(raw string, base *url.URL) string {
+	resolved := resolveMediaURL(strings.TrimSpace(raw), base)
+	if i := strings.Index(resolved, "://"); i >= 0 {
+		return resolved[i+3:]
+	}
+	return resolved
+}
+
+// parsePixels reads a dimension such as "1200" or "1200px", returning 0 when
+// it is not a positive whole number
+func parsePixels(value string) int {
+	pixels, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(value), "px"))
+	if err != nil || pixels < 0 {
+		return 0
+	}
+	return pixels
+}
+
 // extractFromMetaTags extracts image URL from meta tags using priority order
 // Handles both standard meta[name] and OpenGraph meta[property] tags
 func (e *GenericLeadImageExtractor) extractFromMetaTags(doc *goquery.Document, metaCache map[string]string) *string {
@@ -150,8 +255,9 @@ func (e *GenericLeadImageExtractor) extractFromMetaTags(doc *goquery.Document, m
 	return nil
 }
 
-// extractFromContent scores images in content and returns the highest scoring one
-func (e *GenericLeadImageExtractor) extractFromContent(doc *goquery.Document, content string) *string {
+// extractFromContent scores images in content and returns the highest scoring one,
+// skipping images that fall below the configured minimum size
+func (e *GenericLeadImageExtractor) extractFromContent(doc *goquery.Document, content string, opts ImageOptions) *string {
 	contentSelection := doc.Find(content)
 	if contentSelection.Length() == 0 {
 		// If content selector doesn't match, use the whole document
@@ -171,6 +277,9 @@ func (e *GenericLeadImageExtractor) extractFromContent(doc *goquery.Document, co
 		if !exists || src == "" {
 			return
 		}
+		if !opts.allows(img) {
+			return
+		}
 
 		score := 0
 		score += scoreImageUrl(src)
@@ -322,17 +431,8 @@ func scoreByDimensions(img *goquery.Selection) int {
 	score := 0
 	src, _ := img.Attr("src")
 
-	widthStr, widthExists := img.Attr("width")
-	heightStr, heightExists := img.Attr("height")
-
-	if !widthExists || !heightExists {
-		return 0
-	}
-
-	width, err1 := strconv.ParseFloat(widthStr, 64)
-	height, err2 := strconv.ParseFloat(heightStr, 64)
-
-	if err1 != nil || err2 != nil {
+	width, height, ok := imageDimensions(img)
+	if !ok {
 		return 0
 	}
 
@@ -360,6 +460,22 @@ func scoreByDimensions(img *goquery.Selection) int {
 	return score
 }
 
+// imageDimensions reads the numeric width and height attributes of an image
+func imageDimensions(img *goquery.Selection) (float64, float64, bool) {
+	widthStr, widthExists := img.Attr("width")
+	heightStr, heightExists := img.Attr("height")
+	if !widthExists || !heightExists {
+		return 0, 0, false
+	}
+
+	width, err1 := strconv.ParseFloat(widthStr, 64)
+	height, err2 := strconv.ParseFloat(heightStr, 64)
+	if err1 != nil || err2 != nil {
+		return 0, 0, false
+	}
+	return width, height, true
+}
+
 // scoreByPosition gives bonus to images earlier in the content
 func scoreByPosition(imgs []interface{}, index int) float64 {
 	return float64(len(imgs))/2.0 - float64(index)
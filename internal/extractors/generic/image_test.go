@@ -157,6 +157,74 @@ func TestGenericLeadImageExtractor_Extract_ContentImages(t *testing.T) {
 	}
 }
 
+func TestGenericLeadImageExtractor_Extract_MinimumSize(t *testing.T) {
+	extractor := NewGenericLeadImageExtractor()
+	minSize := ImageOptions{MinWidth: 400, MinHeight: 200}
+
+	tests := []struct {
+		name     string
+		html     string
+		expected string
+	}{
+		{
+			name: "Only small images",
+			html: `<html><body>
+				<div class="content">
+					<figure><img src="https://example.com/uploads/thumb-photo.jpg" width="150" height="100" alt="Thumbnail"></figure>
+					<img src="https://example.com/uploads/avatar.jpg" width="300" height="300">
+					<img src="https://example.com/uploads/unsized.jpg">
+				</div>
+			</body></html>`,
+		},
+		{
+			name: "Qualifying hero image",
+			html: `<html><body>
+				<div class="content">
+					<figure><img src="https://example.com/uploads/thumb-photo.jpg" width="150" height="100" alt="Thumbnail"></figure>
+					<img src="https://example.com/uploads/hero.jpg" width="1200" height="600">
+				</div>
+			</body></html>`,
+			expected: "https://example.com/uploads/hero.jpg",
+		},
+		{
+			name: "Meta tag image bypasses the minimum",
+			html: `<html><head><meta property="og:image" content="https://example.com/share.jpg"></head><body>
+				<div class="content">
+					<img src="https://example.com/uploads/thumb-photo.jpg" width="150" height="100">
+				</div>
+			</body></html>`,
+			expected: "https://example.com/share.jpg",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			require.NoError(t, err)
+
+			result := extractor.Extract(ExtractorImageParams{
+				Doc:       doc,
+				Content:   ".content",
+				MetaCache: map[string]string{},
+				HTML:      tt.html,
+				Options:   minSize,
+			})
+			if tt.expected == "" {
+				assert.Nil(t, result)
+				return
+			}
+			require.NotNil(t, result, "Expected to find an image")
+			assert.Equal(t, tt.expected, *result)
+		})
+	}
+
+	// Without a minimum the thumbnail is still chosen
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(tests[0].html))
+	require.NoError(t, err)
+	result := extractor.Extract(ExtractorImageParams{Doc: doc, Content: ".content", MetaCache: map[string]string{}})
+	require.NotNil(t, result)
+}
+
 func TestGenericLeadImageExtractor_Extract_FallbackSelectors(t *testing.T) {
 	extractor := NewGenericLeadImageExtractor()
 
@@ -565,3 +633,68 @@ func TestGenericLeadImageExtractor_EdgeCases(t *testing.T) {
 	}
 }
 
+
+func TestGenericLeadImageExtractor_Dimensions(t *testing.T) {
+	extractor := NewGenericLeadImageExtractor()
+
+	tests := []struct {
+		name           string
+		html           string
+		content        string
+		imageURL       string
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{
+			name: "og:image dimension meta tags",
+			html: `<html><head>
+				<meta property="og:image" content="https://example.com/cover.jpg">
+				<meta property="og:image:width" content="1200">
+				<meta property="og:image:height" content="630">
+			</head><body></body></html>`,
+			imageURL:       "https://example.com/cover.jpg",
+			expectedWidth:  1200,
+			expectedHeight: 630,
+		},
+		{
+			name: "og:image dimensions ignored for a different lead image",
+			html: `<html><head>
+				<meta property="og:image" content="https://example.com/cover.jpg">
+				<meta property="og:image:width" content="1200">
+				<meta property="og:image:height" content="630">
+			</head><body><img src="/photos/other.jpg"></body></html>`,
+			imageURL: "https://example.com/photos/other.jpg",
+		},
+		{
+			name:           "content image attributes",
+			html:           `<html><head></head><body><article><p>Text</p></article></body></html>`,
+			content:        `<div><p>Text</p><img src="https://example.com/photos/harbor.jpg" width="800" height="533px"></div>`,
+			imageURL:       "https://example.com/photos/harbor.jpg",
+			expectedWidth:  800,
+			expectedHeight: 533,
+		},
+		{
+			name:           "relative document image over https",
+			html:           `<html><head></head><body><img src="/photos/harbor.jpg" width="640" height="480"></body></html>`,
+			imageURL:       "https://example.com/photos/harbor.jpg",
+			expectedWidth:  640,
+			expectedHeight: 480,
+		},
+		{
+			name:     "no declared dimensions",
+			html:     `<html><head></head><body><img src="/photos/harbor.jpg" width="auto"></body></html>`,
+			imageURL: "https://example.com/photos/harbor.jpg",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			require.NoError(t, err)
+
+			width, height := extractor.Dimensions(doc, tt.content, tt.imageURL, "http://example.com/news/story")
+			assert.Equal(t, tt.expectedWidth, width, "width")
+			assert.Equal(t, tt.expectedHeight, height, "height")
+		})
+	}
+}
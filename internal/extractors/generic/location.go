@@ -0,0 +1,178 @@
+// ABOUTME: GenericLocationExtractor finds where a story takes place and its coordinates
+// ABOUTME: Reads JSON-LD contentLocation and spatialCoverage, then geo.placename and article:location meta
+
+package generic
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GeoPoint is a latitude and longitude in decimal degrees
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// JSON-LD properties naming the place an article is about, most specific first
+var LOCATION_JSONLD_PROPERTIES = []string{
+	"contentLocation",
+	"spatialCoverage",
+	"locationCreated",
+}
+
+// Meta tags naming the place an article is about
+var LOCATION_META_TAGS = []string{
+	"geo.placename",
+	"article:location",
+}
+
+// Meta tags holding coordinates, as "lat;long" or "lat, long"
+var GEO_POSITION_META_TAGS = []string{
+	"geo.position",
+	"ICBM",
+}
+
+// GenericLocationExtractor extracts the story's location
+type GenericLocationExtractor struct{}
+
+// Extract returns the place the article is about and its coordinates when
+// declared. The name is empty and the point nil when the page says nothing.
+func (extractor *GenericLocationExtractor) Extract(selection *goquery.Selection) (string, *GeoPoint) {
+	var name string
+	var point *GeoPoint
+	selection.Find(`script[type="application/ld+json"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+		var data interface{}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(s.Text())), &data); err != nil {
+			return true // Skip invalid JSON
+		}
+		walkJSONLDObjects(data, func(obj map[string]interface{}) {
+			for _, property := range LOCATION_JSONLD_PROPERTIES {
+				if name != "" {
+					return
+				}
+				name, point = placeFromJSONLD(obj[property])
+			}
+		})
+		return name == ""
+	})
+
+	if name == "" {
+		name = firstMetaValue(selection, LOCATION_META_TAGS)
+	}
+	if point == nil {
+		point = parseGeoPosition(firstMetaValue(selection, GEO_POSITION_META_TAGS))
+	}
+	return name, point
+}
+
+// placeFromJSONLD reads a place given as text or as a Place object, taking
+// the first of a list. Its name is the place name followed by whichever
+// address parts it does not already mention, e.g. "City Hall, Springfield, IL".
+func placeFromJSONLD(value interface{}) (string, *GeoPoint) {
+	if list, ok := value.([]interface{}); ok {
+		for _, item := range list {
+			if name, point := placeFromJSONLD(item); name != "" {
+				return name, point
+			}
+		}
+		return "", nil
+	}
+	if text := jsonLDString(value); text != "" {
+		return text, nil
+	}
+	place, ok := value.(map[string]interface{})
+	if !ok {
+		return "", nil
+	}
+
+	var parts []string
+	add := func(part string) {
+		if part == "" {
+			return
+		}
+		// Skip parts the name already lists, as in "Springfield, IL"
+		for _, existing := range parts {
+			for _, segment := range strings.Split(existing, ",") {
+				if strings.EqualFold(strings.TrimSpace(segment), part) {
+					return
+				}
+			}
+		}
+		parts = append(parts, part)
+	}
+	add(jsonLDString(place["name"]))
+	switch address := place["address"].(type) {
+	case string:
+		add(strings.TrimSpace(address))
+	case map[string]interface{}:
+		add(jsonLDString(address["addressLocality"]))
+		add(jsonLDString(address["addressRegion"]))
+		if country, ok := address["addressCountry"].(map[string]interface{}); ok {
+			add(jsonLDString(country["name"]))
+		} else {
+			add(jsonLDString(address["addressCountry"]))
+		}
+	}
+	if len(parts) == 0 {
+		return "", nil
+	}
+
+	var point *GeoPoint
+	if geo, ok := place["geo"].(map[string]interface{}); ok {
+		point = newGeoPoint(jsonLDNumber(geo["latitude"]), jsonLDNumber(geo["longitude"]))
+	}
+	return strings.Join(parts, ", "), point
+}
+
+// firstMetaValue returns the first non-empty content of the named meta tags, in order
+func firstMetaValue(selection *goquery.Selection, names []string) string {
+	for _, name := range names {
+		var value string
+		selection.Find(`meta[name="` + name + `"], meta[property="` + name + `"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+			value = strings.TrimSpace(s.AttrOr("value", s.AttrOr("content", "")))
+			return value == ""
+		})
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// parseGeoPosition reads "lat;long" as in geo.position or "lat, long" as in ICBM
+func parseGeoPosition(position string) *GeoPoint {
+	lat, long, ok := strings.Cut(position, ";")
+	if !ok {
+		lat, long, ok = strings.Cut(position, ",")
+	}
+	if !ok {
+		return nil
+	}
+	return newGeoPoint(strings.TrimSpace(lat), strings.TrimSpace(long))
+}
+
+// jsonLDNumber returns a number given as a JSON number or string as text
+func jsonLDNumber(value interface{}) string {
+	if number, ok := value.(float64); ok {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+	return jsonLDString(value)
+}
+
+// newGeoPoint parses decimal coordinates, returning nil when either is
+// missing or out of range
+func newGeoPoint(lat, long string) *GeoPoint {
+	latitude, err := strconv.ParseFloat(lat, 64)
+	if err != nil || latitude < -90 || latitude > 90 {
+		return nil
+	}
+	longitude, err := strconv.ParseFloat(long, 64)
+	if err != nil || longitude < -180 || longitude > 180 {
+		return nil
+	}
+	return &GeoPoint{Latitude: latitude, Longitude: longitude}
+}
@@ -0,0 +1,89 @@
+// ABOUTME: Tests for GenericLocationExtractor
+// ABOUTME: Covers JSON-LD places with addresses and coordinates, geo.placename and geo.position meta
+
+package generic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericLocationExtractor(t *testing.T) {
+	tests := []struct {
+		name      string
+		head      string
+		location  string
+		latitude  float64
+		longitude float64
+		hasPoint  bool
+	}{
+		{
+			name:     "jsonld contentLocation with address",
+			head:     `<script type="application/ld+json">{"@type":"NewsArticle","contentLocation":{"@type":"Place","name":"City Hall","address":{"@type":"PostalAddress","addressLocality":"Springfield","addressRegion":"IL","addressCountry":"US"}}}</script>`,
+			location: "City Hall, Springfield, IL, US",
+		},
+		{
+			name:      "jsonld place with coordinates",
+			head:      `<script type="application/ld+json">{"@graph":[{"@type":"NewsArticle","spatialCoverage":[{"@type":"Place","name":"Houston, TX","address":{"addressLocality":"Houston","addressCountry":{"@type":"Country","name":"United States"}},"geo":{"@type":"GeoCoordinates","latitude":29.76,"longitude":"-95.37"}}]}]}</script>`,
+			location:  "Houston, TX, United States",
+			latitude:  29.76,
+			longitude: -95.37,
+			hasPoint:  true,
+		},
+		{
+			name:     "jsonld text",
+			head:     `<script type="application/ld+json">{"@type":"Article","contentLocation":"Leeds"}</script>`,
+			location: "Leeds",
+		},
+		{
+			name:      "geo.placename with position",
+			head:      `<meta name="geo.placename" content="Galway, Ireland"><meta name="geo.position" content="53.27;-9.05">`,
+			location:  "Galway, Ireland",
+			latitude:  53.27,
+			longitude: -9.05,
+			hasPoint:  true,
+		},
+		{
+			name:     "normalized article:location",
+			head:     `<meta name="article:location" value="Nairobi">`,
+			location: "Nairobi",
+		},
+		{
+			name:      "ICBM only",
+			head:      `<meta name="ICBM" content="51.5, -0.12">`,
+			latitude:  51.5,
+			longitude: -0.12,
+			hasPoint:  true,
+		},
+		{
+			name: "out of range position",
+			head: `<meta name="geo.position" content="123;456">`,
+		},
+		{
+			name: "nothing",
+			head: `<meta name="description" content="A story">`,
+		},
+	}
+
+	extractor := &GenericLocationExtractor{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html><head>" + tt.head + "</head><body><p>Story</p></body></html>"))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+			location, point := extractor.Extract(doc.Selection)
+			if location != tt.location {
+				t.Errorf("Expected location %q, got %q", tt.location, location)
+			}
+			if (point != nil) != tt.hasPoint {
+				t.Fatalf("Expected point %v, got %+v", tt.hasPoint, point)
+			}
+			if point != nil && (point.Latitude != tt.latitude || point.Longitude != tt.longitude) {
+				t.Errorf("Expected %v,%v, got %+v", tt.latitude, tt.longitude, point)
+			}
+		})
+	}
+}
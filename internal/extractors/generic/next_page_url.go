@@ -37,8 +37,20 @@ var (
 	
 	// PAGE_RE matches pagination-related text
 	PAGE_RE = regexp.MustCompile(`(?i)pag(e|ing|inat)`)
+	
+	// COMMENT_PAGER_TEXT_RE matches pager labels and aria-labels for comment
+	// pagination, e.g. "Older comments" or "Comment navigation"
+	COMMENT_PAGER_TEXT_RE = regexp.MustCompile(`(?i)\b(comments?|replies)\b`)
+	
+	// COMMENT_PAGE_URL_RE matches comment page URLs such as WordPress's
+	// /comment-page-2/ and ?cpage=2
+	COMMENT_PAGE_URL_RE = regexp.MustCompile(`(?i)(comment-page-\d|[?&]cpage=\d)`)
 )
 
+// A pager's surrounding text is read from ancestors shorter than this; longer
+// ones are article body, which may well mention comments
+const commentPagerMaxTextLength = 200
+
 // GenericNextPageUrlExtractor extracts next page URLs for multi-page articles
 type GenericNextPageUrlExtractor struct{}
 
@@ -123,7 +135,7 @@ func scoreLinks(links []*goquery.Selection, articleURL, baseURL string, parsedUR
 		href = text.RemoveAnchor(href)
 		linkText := strings.TrimSpace(link.Text())
 
-		if !shouldScore(href, articleURL, baseURL, parsedURL, linkText, previousUrls) {
+		if !shouldScore(href, articleURL, baseURL, parsedURL, linkText, previousUrls) || isCommentPagination(link, href) {
 			continue
 		}
 
@@ -217,6 +229,29 @@ func shouldScore(href, articleURL, baseURL string, parsedURL *url.URL, linkText
 	return true
 }
 
+// isCommentPagination reports whether a link pages through reader comments
+// rather than the article: it sits in a comment section, points at a comment
+// page, or its pager is labelled as comment or reply navigation
+func isCommentPagination(link *goquery.Selection, href string) bool {
+	if COMMENT_PAGE_URL_RE.MatchString(href) || dom.InCommentSection(link) {
+		return true
+	}
+
+	for node := link.Parent(); node.Length() > 0 && !node.Is("body"); node = node.Parent() {
+		if COMMENT_PAGER_TEXT_RE.MatchString(node.AttrOr("aria-label", "")) {
+			return true
+		}
+		surrounding := strings.TrimSpace(node.Text())
+		if len(surrounding) > commentPagerMaxTextLength {
+			break
+		}
+		if COMMENT_PAGER_TEXT_RE.MatchString(surrounding) {
+			return true
+		}
+	}
+	return false
+}
+
 // makeSig creates a signature string from a link element
 func makeSig(link *goquery.Selection, linkText string) string {
 	if linkText == "" {
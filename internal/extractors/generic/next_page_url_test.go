@@ -392,4 +392,45 @@ func TestJavaScriptCompatibility_ArsTechnica(t *testing.T) {
 	result := extractor.Extract(doc, articleURL, parsedURL, nil)
 
 	assert.Equal(t, expectedNextURL, result, "Should match JavaScript test expectation")
-}
\ No newline at end of file
+}
+
+func TestNextPageIgnoresCommentPagination(t *testing.T) {
+	fixtureContent, err := os.ReadFile("../../fixtures/blog.example.com--comment-pagination.html")
+	assert.NoError(t, err)
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(fixtureContent)))
+	assert.NoError(t, err)
+
+	articleURL := "https://blog.example.com/2024/03/restoring-a-lathe/"
+	parsedURL, err := url.Parse(articleURL)
+	assert.NoError(t, err)
+
+	extractor := NewGenericNextPageUrlExtractor()
+	result := extractor.Extract(doc, articleURL, parsedURL, nil)
+
+	assert.Equal(t, "https://blog.example.com/2024/03/restoring-a-lathe/2", result, "Should follow the article's pager, not the comment or reply pagers")
+}
+
+func TestIsCommentPagination(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		href     string
+		expected bool
+	}{
+		{"inside comment section", `<div id="comments"><nav><a href="/story/2">Next</a></nav></div>`, "https://example.com/story/2", true},
+		{"comment page url", `<div class="pager"><a href="/story/comment-page-2/">Next</a></div>`, "https://example.com/story/comment-page-2/", true},
+		{"labelled pager", `<nav aria-label="Comments navigation"><a href="/story/2">Next</a></nav>`, "https://example.com/story/2", true},
+		{"replies pager text", `<div class="pager"><span>More replies</span> <a href="/story/2">Next</a></div>`, "https://example.com/story/2", true},
+		{"article pager", `<div class="pagination"><a href="/story/2">Next</a></div>`, "https://example.com/story/2", false},
+		{"article body mentioning comments", `<div><p>` + strings.Repeat("A long paragraph of article text. ", 10) + `Tell us in the comments.</p><div class="pager"><a href="/story/2">Next</a></div></div>`, "https://example.com/story/2", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html><body>" + tt.html + "</body></html>"))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, isCommentPagination(doc.Find("a").First(), tt.href))
+		})
+	}
+}
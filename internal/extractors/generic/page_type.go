@@ -0,0 +1,145 @@
+// ABOUTME: GenericPageTypeExtractor classifies a page as an article, blog post, product or video
+// ABOUTME: Uses JSON-LD @type, then og:type, then price, video and byline heuristics on the original document
+
+package generic
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Page types reported in Result.PageType
+const (
+	PageTypeArticle = "article"
+	PageTypeBlog    = "blog"
+	PageTypeProduct = "product"
+	PageTypeVideo   = "video"
+	PageTypeUnknown = "unknown"
+)
+
+// PAGE_TYPE_JSONLD_TYPES maps schema.org types to page types
+var PAGE_TYPE_JSONLD_TYPES = map[string]string{
+	"Article":               PageTypeArticle,
+	"NewsArticle":           PageTypeArticle,
+	"Report":                PageTypeArticle,
+	"ScholarlyArticle":      PageTypeArticle,
+	"TechArticle":           PageTypeArticle,
+	"OpinionNewsArticle":    PageTypeArticle,
+	"ReportageNewsArticle":  PageTypeArticle,
+	"AnalysisNewsArticle":   PageTypeArticle,
+	"ReviewNewsArticle":     PageTypeArticle,
+	"BackgroundNewsArticle": PageTypeArticle,
+	"BlogPosting":           PageTypeBlog,
+	"Blog":                  PageTypeBlog,
+	"Product":               PageTypeProduct,
+	"ProductGroup":          PageTypeProduct,
+	"ProductModel":          PageTypeProduct,
+	"IndividualProduct":     PageTypeProduct,
+	"VideoObject":           PageTypeVideo,
+}
+
+// Selectors whose presence means the page sells something
+var PAGE_TYPE_PRICE_SELECTORS = []string{
+	`[itemprop="price"]`,
+	`meta[name="product:price:amount"]`,
+	`meta[name="og:price:amount"]`,
+}
+
+// Selectors for an author credit and a publish date, which together mark an article
+var (
+	PAGE_TYPE_BYLINE_SELECTORS = `[rel="author"], [itemprop="author"], .byline, .author`
+	PAGE_TYPE_DATE_SELECTORS   = `time[datetime], [itemprop="datePublished"], meta[name="article:published_time"]`
+)
+
+// A page with a video player and fewer words than this is mostly the video
+const pageTypeVideoMaxWords = 150
+
+// GenericPageTypeExtractor estimates what kind of page a document is
+type GenericPageTypeExtractor struct{}
+
+// Extract returns the page type declared in JSON-LD or og:type, or detected
+// from the page, or "" when nothing decides it. JSON-LD types are read in
+// document order, so the first recognized type wins. Call it before content
+// cleaning removes players and bylines.
+func (extractor *GenericPageTypeExtractor) Extract(selection *goquery.Selection) string {
+	if pageType := extractor.fromJSONLD(selection); pageType != "" {
+		return pageType
+	}
+	if pageType := pageTypeFromOGType(selection.Find(`meta[name="og:type"], meta[property="og:type"]`).First()); pageType != "" {
+		return pageType
+	}
+	return extractor.fromHeuristics(selection)
+}
+
+// fromJSONLD returns the page type of the first recognized JSON-LD @type
+func (extractor *GenericPageTypeExtractor) fromJSONLD(selection *goquery.Selection) string {
+	var pageType string
+	selection.Find(`script[type="application/ld+json"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+		var data interface{}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(s.Text())), &data); err != nil {
+			return true // Skip invalid JSON
+		}
+		walkJSONLDObjects(data, func(obj map[string]interface{}) {
+			if pageType != "" {
+				return
+			}
+			for _, typeName := range jsonLDTextList(obj["@type"]) {
+				if mapped, ok := PAGE_TYPE_JSONLD_TYPES[typeName]; ok {
+					pageType = mapped
+					return
+				}
+			}
+		})
+		return pageType == ""
+	})
+	return pageType
+}
+
+// pageTypeFromOGType maps og:type values such as "article", "video.movie"
+// and "product.item" to a page type
+func pageTypeFromOGType(meta *goquery.Selection) string {
+	ogType := strings.ToLower(strings.TrimSpace(meta.AttrOr("value", meta.AttrOr("content", ""))))
+	ogType = strings.TrimPrefix(ogType, "og:")
+	kind, _, _ := strings.Cut(ogType, ".")
+	switch kind {
+	case "article":
+		return PageTypeArticle
+	case "blog":
+		return PageTypeBlog
+	case "product":
+		return PageTypeProduct
+	case "video":
+		return PageTypeVideo
+	}
+	return ""
+}
+
+// fromHeuristics detects prices, pages built around a video player, and
+// bylined, dated articles
+func (extractor *GenericPageTypeExtractor) fromHeuristics(selection *goquery.Selection) string {
+	for _, selector := range PAGE_TYPE_PRICE_SELECTORS {
+		if selection.Find(selector).Length() > 0 {
+			return PageTypeProduct
+		}
+	}
+
+	hasPlayer := selection.Find("video").Length() > 0
+	selection.Find("iframe").EachWithBreak(func(i int, s *goquery.Selection) bool {
+		hasPlayer = hasPlayer || CanonicalVideoURL(strings.TrimSpace(s.AttrOr("src", s.AttrOr("data-src", "")))) != ""
+		return !hasPlayer
+	})
+	if hasPlayer {
+		body := selection.Find("body").First().Clone()
+		body.Find("script, style, noscript, template").Remove()
+		if len(strings.Fields(body.Text())) < pageTypeVideoMaxWords {
+			return PageTypeVideo
+		}
+	}
+
+	if selection.Find(PAGE_TYPE_BYLINE_SELECTORS).Length() > 0 && selection.Find(PAGE_TYPE_DATE_SELECTORS).Length() > 0 {
+		return PageTypeArticle
+	}
+	return ""
+}
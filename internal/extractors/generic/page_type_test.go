@@ -0,0 +1,45 @@
+// ABOUTME: Tests for GenericPageTypeExtractor
+// ABOUTME: Covers JSON-LD types, og:type values and the price, video and byline heuristics
+
+package generic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericPageTypeExtractor(t *testing.T) {
+	tests := []struct {
+		name     string
+		head     string
+		body     string
+		expected string
+	}{
+		{"jsonld product", `<script type="application/ld+json">{"@type":"Product","name":"Kettle"}</script>`, "", PageTypeProduct},
+		{"jsonld graph blog", `<script type="application/ld+json">{"@graph":[{"@type":"WebSite"},{"@type":["BlogPosting"]}]}</script>`, "", PageTypeBlog},
+		{"jsonld first type wins", `<script type="application/ld+json">[{"@type":"NewsArticle"},{"@type":"VideoObject"}]</script>`, "", PageTypeArticle},
+		{"og video", `<meta name="og:type" value="video.other">`, "", PageTypeVideo},
+		{"og product with prefix", `<meta property="og:type" content="og:product">`, "", PageTypeProduct},
+		{"og website is undecided", `<meta name="og:type" value="website">`, "", ""},
+		{"price", "", `<span itemprop="price" content="24.99">$24.99</span>`, PageTypeProduct},
+		{"player with little text", "", `<iframe src="https://www.youtube.com/embed/abc123"></iframe><p>Watch the launch.</p>`, PageTypeVideo},
+		{"player in a long story", "", `<iframe src="https://www.youtube.com/embed/abc123"></iframe><p>` + strings.Repeat("word ", 200) + `</p>`, ""},
+		{"byline and date", "", `<p class="byline">By Kim Lee</p><time datetime="2024-03-05">March 5</time>`, PageTypeArticle},
+		{"nothing", "", `<p>Hello</p>`, ""},
+	}
+
+	extractor := &GenericPageTypeExtractor{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html><head>" + tt.head + "</head><body>" + tt.body + "</body></html>"))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+			if got := extractor.Extract(doc.Selection); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
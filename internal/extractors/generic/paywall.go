@@ -0,0 +1,181 @@
+// ABOUTME: GenericPaywallExtractor flags articles whose full text is behind a subscription wall
+// ABOUTME: Uses publisher markup, visible paywall overlays and short bodies ending in a subscribe prompt
+
+package generic
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GenericPaywallExtractor detects paywalled articles.
+// Detection is deliberately conservative: a subscribe call-to-action on an
+// otherwise complete article is not a paywall.
+type GenericPaywallExtractor struct{}
+
+// Class or id tokens naming a paywall or registration wall
+var paywallTokenRE = regexp.MustCompile(`(?i)(paywall|pay-wall|regwall|reg-wall|registration-wall|subscriber-wall|metered-wall)`)
+
+// Tokens that mention a paywall only to say it is absent or switched off
+var paywallNegationRE = regexp.MustCompile(`(?i)(^|[-_])(no|non|free|off|disabled|inactive|hidden)([-_]|$)`)
+
+// Prompts that replace the rest of a truncated article
+var subscribePromptPhrases = []string{
+	"subscribe to continue reading",
+	"subscribe to keep reading",
+	"subscribe to read the full",
+	"subscribe to read this",
+	"to continue reading, subscribe",
+	"to continue reading this article",
+	"continue reading with a subscription",
+	"this article is for subscribers",
+	"this article is reserved for subscribers",
+	"this content is for subscribers",
+	"this content is only available to subscribers",
+	"exclusive to subscribers",
+	"available to subscribers only",
+	"for subscribers only",
+	"become a subscriber to read",
+	"already a subscriber? log in",
+	"already a subscriber? sign in",
+}
+
+// A body at least this long is a complete article even if it shows a subscribe prompt
+const paywallMaxTruncatedWords = 200
+
+// Extract reports whether the page is paywalled
+func (extractor *GenericPaywallExtractor) Extract(selection *goquery.Selection) bool {
+	if extractor.isLockedByMarkup(selection) {
+		return true
+	}
+	if extractor.hasPaywallOverlay(selection) {
+		return true
+	}
+	return extractor.isTruncatedWithPrompt(selection)
+}
+
+// isLockedByMarkup checks publisher-declared access: JSON-LD isAccessibleForFree
+// and the Open Graph article:content_tier meta tag
+func (extractor *GenericPaywallExtractor) isLockedByMarkup(selection *goquery.Selection) bool {
+	// Normalized documents carry the meta content in value
+	meta := selection.Find(`meta[property="article:content_tier"], meta[name="article:content_tier"]`).First()
+	tier := strings.ToLower(strings.TrimSpace(meta.AttrOr("value", meta.AttrOr("content", ""))))
+	if tier == "locked" {
+		return true
+	}
+
+	locked := false
+	selection.Find("script[type=\"application/ld+json\"]").EachWithBreak(func(i int, s *goquery.Selection) bool {
+		jsonText := strings.TrimSpace(s.Text())
+		if jsonText == "" {
+			return true
+		}
+
+		var data interface{}
+		if err := json.Unmarshal([]byte(jsonText), &data); err != nil {
+			return true // Skip invalid JSON
+		}
+
+		locked = hasNonFreeAccess(data)
+		return !locked
+	})
+	return locked
+}
+
+// hasNonFreeAccess walks JSON-LD data (objects, arrays, @graph and hasPart) for isAccessibleForFree: false
+func hasNonFreeAccess(data interface{}) bool {
+	switch v := data.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if hasNonFreeAccess(item) {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		switch access := v["isAccessibleForFree"].(type) {
+		case bool:
+			if !access {
+				return true
+			}
+		case string:
+			if strings.EqualFold(strings.TrimSpace(access), "false") {
+				return true
+			}
+		}
+		for _, key := range []string{"@graph", "hasPart", "mainEntity"} {
+			if nested, ok := v[key]; ok && hasNonFreeAccess(nested) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasPaywallOverlay looks for a visible paywall element with a message in it.
+// Empty or hidden containers are skipped, since many sites ship them on every page.
+func (extractor *GenericPaywallExtractor) hasPaywallOverlay(selection *goquery.Selection) bool {
+	found := false
+	selection.Find("[class], [id]").Not("html, body, script, style, template, link, meta").EachWithBreak(func(i int, s *goquery.Selection) bool {
+		if !hasPaywallToken(s.AttrOr("class", "") + " " + s.AttrOr("id", "")) {
+			return true
+		}
+		if isHiddenElement(s) || len(strings.Fields(s.Text())) < 3 {
+			return true
+		}
+		found = true
+		return false
+	})
+	return found
+}
+
+// hasPaywallToken reports whether any class or id token names an active paywall
+func hasPaywallToken(classAndId string) bool {
+	for _, token := range strings.Fields(classAndId) {
+		if paywallTokenRE.MatchString(token) && !paywallNegationRE.MatchString(token) {
+			return true
+		}
+	}
+	return false
+}
+
+// isHiddenElement reports whether the element or an ancestor is hidden in the markup
+func isHiddenElement(s *goquery.Selection) bool {
+	for node := s; node.Length() > 0; node = node.Parent() {
+		if _, hidden := node.Attr("hidden"); hidden {
+			return true
+		}
+		if node.AttrOr("aria-hidden", "") == "true" {
+			return true
+		}
+		style := strings.ToLower(strings.ReplaceAll(node.AttrOr("style", ""), " ", ""))
+		if strings.Contains(style, "display:none") || strings.Contains(style, "visibility:hidden") {
+			return true
+		}
+	}
+	return false
+}
+
+// isTruncatedWithPrompt reports whether a short body ends in a subscribe-to-continue prompt
+func (extractor *GenericPaywallExtractor) isTruncatedWithPrompt(selection *goquery.Selection) bool {
+	body := selection.Find("body").First()
+	if body.Length() == 0 {
+		body = selection
+	}
+
+	visible := body.Clone()
+	visible.Find("script, style, noscript, template, nav, header, footer, aside").Remove()
+	text := strings.ToLower(strings.Join(strings.Fields(visible.Text()), " "))
+	if len(strings.Fields(text)) >= paywallMaxTruncatedWords {
+		return false
+	}
+
+	for _, phrase := range subscribePromptPhrases {
+		if strings.Contains(text, phrase) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+// ABOUTME: Test suite for paywall detection from JSON-LD, paywall overlays and truncated bodies
+// ABOUTME: Verifies that subscribe calls-to-action on complete articles are not flagged
+
+package generic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericPaywallExtractor_Extract(t *testing.T) {
+	longArticle := strings.Repeat("<p>The council met on Tuesday to discuss the new budget and its effect on local schools and roads.</p>", 20)
+
+	tests := []struct {
+		name     string
+		html     string
+		expected bool
+	}{
+		{
+			name: "JSON-LD isAccessibleForFree false",
+			html: `<html><head><script type="application/ld+json">{"@type":"NewsArticle","headline":"Story",
+				"isAccessibleForFree":false,"hasPart":{"@type":"WebPageElement","isAccessibleForFree":false,"cssSelector":".locked"}}</script></head>
+				<body><article>` + longArticle + `</article></body></html>`,
+			expected: true,
+		},
+		{
+			name: "JSON-LD graph with string flag",
+			html: `<script type="application/ld+json">{"@graph":[{"@type":"WebPage"},
+				{"@type":"Article","isAccessibleForFree":"False"}]}</script>`,
+			expected: true,
+		},
+		{
+			name:     "locked content tier meta",
+			html:     `<html><head><meta property="article:content_tier" content="locked"></head><body><p>Story</p></body></html>`,
+			expected: true,
+		},
+		{
+			name: "paywall overlay",
+			html: `<html><body><article><p>The first paragraph of the story.</p></article>
+				<div class="tp-modal article-paywall"><h2>You have reached your free article limit</h2><a href="/subscribe">Subscribe</a></div></body></html>`,
+			expected: true,
+		},
+		{
+			name: "truncated body with subscribe prompt",
+			html: `<html><body><article><p>The first paragraph of the story.</p>
+				<p>Subscribe to continue reading this story and get unlimited access.</p></article></body></html>`,
+			expected: true,
+		},
+		{
+			name: "free article with subscribe call-to-action",
+			html: `<html><head><script type="application/ld+json">{"@type":"NewsArticle","isAccessibleForFree":true}</script></head>
+				<body><article>` + longArticle + `</article>
+				<aside class="newsletter"><p>Subscribe to our newsletter</p></aside>
+				<div class="subscribe-cta"><p>Enjoying this? Subscribe to read this and more stories.</p></div></body></html>`,
+			expected: false,
+		},
+		{
+			name: "empty or hidden paywall containers",
+			html: `<html><body><article>` + longArticle + `</article>
+				<div id="paywall-container"></div>
+				<div class="paywall" style="display: none"><p>Subscribe to continue reading</p></div>
+				<div class="no-paywall"><p>This story is free to read for everyone</p></div></body></html>`,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+
+			extractor := &GenericPaywallExtractor{}
+			if got := extractor.Extract(doc.Selection); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
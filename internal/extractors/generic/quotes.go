@@ -0,0 +1,59 @@
+// ABOUTME: GenericQuoteExtractor lists the blockquotes and pull quotes in article content
+// ABOUTME: Trims and dedupes quote text and appends any cite/footer attribution after an em dash
+
+package generic
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GenericQuoteExtractor extracts quoted passages as plain text
+type GenericQuoteExtractor struct{}
+
+// Elements holding a quoted passage
+const quoteSelector = "blockquote, .pullquote, .pull-quote, .pull_quote"
+
+// Social embeds are built on blockquote but are not quotes from the article
+const quoteEmbedSelector = ".twitter-tweet, .twitter-video, .instagram-media, .tiktok-embed, .reddit-card"
+
+// Elements naming who is quoted
+const quoteAttributionSelector = "cite, footer"
+
+// Extract returns each quote in document order as its text, followed by
+// " — " and the attribution when the quote has a <cite> or <footer>.
+// Quotes nested in another quote are part of the outer one.
+func (extractor *GenericQuoteExtractor) Extract(selection *goquery.Selection) []string {
+	var quotes []string
+	seen := make(map[string]bool)
+	selection.Find(quoteSelector).Each(func(i int, quote *goquery.Selection) {
+		if quote.Is(quoteEmbedSelector) || quote.ParentsFiltered(quoteSelector).Length() > 0 {
+			return
+		}
+
+		body := quote.Clone()
+		attribution := body.Find(quoteAttributionSelector).Last()
+		credit := strings.TrimLeft(strings.Join(strings.Fields(attribution.Text()), " "), "—–-~ ")
+		attribution.Remove()
+
+		text := quoteText(body)
+		if text == "" || seen[text] {
+			return
+		}
+		seen[text] = true
+
+		if credit != "" {
+			text += " — " + credit
+		}
+		quotes = append(quotes, text)
+	})
+	return quotes
+}
+
+// quoteText returns the quote's text with block elements separated by
+// spaces, so paragraphs don't run together. quote must be a clone.
+func quoteText(quote *goquery.Selection) string {
+	quote.Find("p, div, li, blockquote, br").AfterHtml(" ")
+	return strings.Join(strings.Fields(quote.Text()), " ")
+}
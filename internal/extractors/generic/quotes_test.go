@@ -0,0 +1,42 @@
+// ABOUTME: Tests for GenericQuoteExtractor
+// ABOUTME: Covers blockquotes with and without attribution, pull quotes, dedupe and social embeds
+
+package generic
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericQuoteExtractor(t *testing.T) {
+	html := `<div>
+<blockquote><p>We will not close the library while I am mayor.</p><footer>— Mayor Ellen Park</footer></blockquote>
+<p>Residents were unconvinced.</p>
+<blockquote><p>It is a tough year.</p><p>Every service is under review.</p></blockquote>
+<blockquote>
+  <p>The budget simply   does not add up.</p>
+</blockquote>
+<aside class="pullquote">The budget simply does not add up.</aside>
+<blockquote class="twitter-tweet"><p>Save our library!</p></blockquote>
+<blockquote><p>Outer quote <blockquote>inner quote</blockquote></p><cite>Council minutes</cite></blockquote>
+</div>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+
+	extractor := &GenericQuoteExtractor{}
+	expected := []string{
+		"We will not close the library while I am mayor. — Mayor Ellen Park",
+		"It is a tough year. Every service is under review.",
+		"The budget simply does not add up.",
+		"Outer quote inner quote — Council minutes",
+	}
+	if got := extractor.Extract(doc.Selection); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
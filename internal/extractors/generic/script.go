@@ -0,0 +1,107 @@
+// ABOUTME: Detects the dominant writing system of text and the scripts a language code is written in
+// ABOUTME: Lets the parser flag pages whose declared language or direction disagrees with their content
+
+package generic
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Text needs at least this many letters before its script is trusted
+const minScriptLetters = 20
+
+// A script must cover more than this share of letters to be dominant
+const dominantScriptShare = 0.6
+
+// Scripts recognized by DominantScript, in the order they are checked
+var detectedScripts = []struct {
+	name  string
+	table *unicode.RangeTable
+}{
+	{"Latin", unicode.Latin},
+	{"Cyrillic", unicode.Cyrillic},
+	{"Greek", unicode.Greek},
+	{"Arabic", unicode.Arabic},
+	{"Hebrew", unicode.Hebrew},
+	{"Devanagari", unicode.Devanagari},
+	{"Thai", unicode.Thai},
+	{"Hangul", unicode.Hangul},
+	{"Hiragana", unicode.Hiragana},
+	{"Katakana", unicode.Katakana},
+	{"Han", unicode.Han},
+}
+
+// Scripts written right to left
+var rtlScripts = map[string]bool{"Arabic": true, "Hebrew": true}
+
+// Scripts each language is normally written in, keyed by primary language subtag
+var languageScripts = map[string][]string{
+	"ar": {"Arabic"}, "fa": {"Arabic"}, "ur": {"Arabic"}, "ps": {"Arabic"},
+	"he": {"Hebrew"}, "yi": {"Hebrew"},
+	"ru": {"Cyrillic"}, "uk": {"Cyrillic"}, "bg": {"Cyrillic"}, "be": {"Cyrillic"}, "mk": {"Cyrillic"},
+	"sr": {"Cyrillic", "Latin"}, "kk": {"Cyrillic", "Latin"},
+	"el": {"Greek"},
+	"hi": {"Devanagari"}, "mr": {"Devanagari"}, "ne": {"Devanagari"},
+	"th": {"Thai"},
+	"ko": {"Hangul", "Han"},
+	"ja": {"Hiragana", "Katakana", "Han"},
+	"zh": {"Han"},
+	"en": {"Latin"}, "fr": {"Latin"}, "de": {"Latin"}, "es": {"Latin"}, "it": {"Latin"},
+	"pt": {"Latin"}, "nl": {"Latin"}, "sv": {"Latin"}, "da": {"Latin"}, "no": {"Latin"},
+	"nb": {"Latin"}, "nn": {"Latin"}, "fi": {"Latin"}, "pl": {"Latin"}, "cs": {"Latin"},
+	"sk": {"Latin"}, "hu": {"Latin"}, "ro": {"Latin"}, "hr": {"Latin"}, "sl": {"Latin"},
+	"tr": {"Latin"}, "vi": {"Latin"}, "id": {"Latin"}, "ms": {"Latin"}, "ca": {"Latin"},
+	"et": {"Latin"}, "lv": {"Latin"}, "lt": {"Latin"}, "ga": {"Latin"}, "is": {"Latin"},
+}
+
+// DominantScript returns the name of the script most letters in text are
+// written in, such as "Latin", "Arabic" or "Han", or empty when text is too
+// short or no script clearly dominates. Japanese kana and kanji count
+// separately, so mixed Japanese text may have no dominant script.
+func DominantScript(text string) string {
+	counts := make(map[string]int)
+	letters := 0
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		for _, script := range detectedScripts {
+			if unicode.Is(script.table, r) {
+				counts[script.name]++
+				break
+			}
+		}
+	}
+	if letters < minScriptLetters {
+		return ""
+	}
+
+	for _, script := range detectedScripts {
+		if float64(counts[script.name]) > dominantScriptShare*float64(letters) {
+			return script.name
+		}
+	}
+	return ""
+}
+
+// LanguageScripts returns the scripts a BCP 47 language tag such as "en-US"
+// or "zh-Hans" is normally written in, or nil for languages not known here
+func LanguageScripts(lang string) []string {
+	primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(lang)), "-")
+	primary, _, _ = strings.Cut(primary, "_")
+	return languageScripts[primary]
+}
+
+// ScriptDirection returns "rtl" for right-to-left scripts and "ltr" for the
+// rest, or empty when script is empty
+func ScriptDirection(script string) string {
+	switch {
+	case script == "":
+		return NODI
+	case rtlScripts[script]:
+		return RTL
+	}
+	return LTR
+}
@@ -0,0 +1,45 @@
+// ABOUTME: Test suite for dominant script detection and language script lookup
+// ABOUTME: Covers Latin, Arabic, Cyrillic and Han text, short text and mixed scripts
+
+package generic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDominantScript(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{"latin", "The council approved the new harbour plan on Tuesday evening.", "Latin"},
+		{"arabic", "وافق المجلس على خطة الميناء الجديدة مساء يوم الثلاثاء", "Arabic"},
+		{"cyrillic", "Совет одобрил новый план порта во вторник вечером.", "Cyrillic"},
+		{"han", "市议会周二晚上批准了新的港口计划，预计明年开始施工。", "Han"},
+		{"arabic with latin names", "قال المتحدث باسم شركة Acme إن الخطة ستبدأ في الربيع المقبل", "Arabic"},
+		{"too short", "Hello", ""},
+		{"evenly mixed", "Harbour plan approved today وافق المجلس على خطة الميناء", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DominantScript(tt.text))
+		})
+	}
+}
+
+func TestLanguageScripts(t *testing.T) {
+	assert.Equal(t, []string{"Latin"}, LanguageScripts("en-US"))
+	assert.Equal(t, []string{"Arabic"}, LanguageScripts("AR"))
+	assert.Equal(t, []string{"Han"}, LanguageScripts("zh-Hans"))
+	assert.Equal(t, []string{"Latin"}, LanguageScripts("pt_BR"))
+	assert.Nil(t, LanguageScripts("xx"))
+	assert.Nil(t, LanguageScripts(""))
+
+	assert.Equal(t, RTL, ScriptDirection("Hebrew"))
+	assert.Equal(t, LTR, ScriptDirection("Latin"))
+	assert.Equal(t, NODI, ScriptDirection(""))
+}
@@ -0,0 +1,89 @@
+// ABOUTME: GenericSectionExtractor reads the site section an article is filed under
+// ABOUTME: Uses article:section meta, then JSON-LD articleSection, then the breadcrumb leaf as a fallback
+
+package generic
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GenericSectionExtractor extracts the article's primary section, e.g. "Politics"
+type GenericSectionExtractor struct{}
+
+// Extract returns the section declared in meta tags or JSON-LD, or "" when
+// neither declares one. Breadcrumbs are handled by SectionFromBreadcrumbs
+// once the self-referential crumb has been removed.
+func (extractor *GenericSectionExtractor) Extract(selection *goquery.Selection) string {
+	if section := extractor.extractFromMeta(selection); section != "" {
+		return section
+	}
+	return extractor.extractFromJSONLD(selection)
+}
+
+// extractFromMeta reads article:section, normalized to name/value or as written
+func (extractor *GenericSectionExtractor) extractFromMeta(selection *goquery.Selection) string {
+	section := ""
+	selection.Find(`meta[name="article:section"], meta[property="article:section"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+		section = cleanSection(s.AttrOr("value", s.AttrOr("content", "")))
+		return section == ""
+	})
+	return section
+}
+
+// extractFromJSONLD reads articleSection, taking the first entry of a list
+func (extractor *GenericSectionExtractor) extractFromJSONLD(selection *goquery.Selection) string {
+	section := ""
+	selection.Find(`script[type="application/ld+json"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+		jsonText := strings.TrimSpace(s.Text())
+		if jsonText == "" {
+			return true
+		}
+
+		var data interface{}
+		if err := json.Unmarshal([]byte(jsonText), &data); err != nil {
+			return true // Skip invalid JSON
+		}
+
+		walkJSONLDObjects(data, func(obj map[string]interface{}) {
+			if section != "" {
+				return
+			}
+			switch v := obj["articleSection"].(type) {
+			case string:
+				section = cleanSection(v)
+			case []interface{}:
+				if list := jsonLDTextList(v); len(list) > 0 {
+					section = cleanSection(list[0])
+				}
+			}
+		})
+		return section == ""
+	})
+	return section
+}
+
+// SectionFromBreadcrumbs returns the leaf of a breadcrumb trail as the
+// section, skipping a trail that holds only the home crumb. Pass crumbs
+// after RemoveSelfBreadcrumb so the article title is not mistaken for it.
+func SectionFromBreadcrumbs(crumbs []string) string {
+	if len(crumbs) == 0 {
+		return ""
+	}
+	leaf := cleanSection(cleanBreadcrumb(crumbs[len(crumbs)-1]))
+	if strings.EqualFold(leaf, "home") {
+		return ""
+	}
+	return leaf
+}
+
+// cleanSection collapses whitespace and rejects values too long to be a section name
+func cleanSection(section string) string {
+	section = strings.Join(strings.Fields(section), " ")
+	if len(section) > maxBreadcrumbLength {
+		return ""
+	}
+	return section
+}
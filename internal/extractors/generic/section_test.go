@@ -0,0 +1,56 @@
+// ABOUTME: Tests for GenericSectionExtractor and the breadcrumb section fallback
+// ABOUTME: Covers article:section meta, JSON-LD articleSection and breadcrumb leaves
+
+package generic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericSectionExtractor(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected string
+	}{
+		{"normalized meta", `<meta name="article:section" value="Politics">`, "Politics"},
+		{"property meta", `<meta property="article:section" content=" World  News ">`, "World News"},
+		{"jsonld string", `<script type="application/ld+json">{"@type":"NewsArticle","articleSection":"Science"}</script>`, "Science"},
+		{"jsonld list", `<script type="application/ld+json">{"@graph":[{"@type":"NewsArticle","articleSection":["Sport","Football"]}]}</script>`, "Sport"},
+		{"meta wins over jsonld", `<meta name="article:section" value="Business"><script type="application/ld+json">{"articleSection":"Markets"}</script>`, "Business"},
+		{"none", `<meta name="description" value="No section here">`, ""},
+	}
+
+	extractor := &GenericSectionExtractor{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html><head>" + tt.html + "</head><body></body></html>"))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+			if got := extractor.Extract(doc.Selection); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSectionFromBreadcrumbs(t *testing.T) {
+	tests := []struct {
+		crumbs   []string
+		expected string
+	}{
+		{[]string{"Home", "Travel", "Europe"}, "Europe"},
+		{[]string{"Home"}, ""},
+		{nil, ""},
+	}
+
+	for _, tt := range tests {
+		if got := SectionFromBreadcrumbs(tt.crumbs); got != tt.expected {
+			t.Errorf("SectionFromBreadcrumbs(%v) = %q, expected %q", tt.crumbs, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// ABOUTME: GenericSocialMetaExtractor collects every OpenGraph, Twitter card and article meta tag
+// ABOUTME: Reads both name and property attributes and returns content values unmodified
+
+package generic
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GenericSocialMetaExtractor extracts social preview metadata
+type GenericSocialMetaExtractor struct{}
+
+// Meta tag prefixes included in the social metadata
+var socialMetaPrefixes = []string{"og:", "twitter:", "article:"}
+
+// Extract maps each og:*, twitter:* and article:* meta tag to its content.
+// When a tag repeats (e.g. several og:image tags) the first one wins, so
+// structured properties like og:image:width describe the same image.
+func (extractor *GenericSocialMetaExtractor) Extract(selection *goquery.Selection) map[string]string {
+	meta := make(map[string]string)
+
+	selection.Find("meta").Each(func(i int, s *goquery.Selection) {
+		content, exists := s.Attr("content")
+		if !exists {
+			// Some Twitter card tags use value instead of content
+			if content, exists = s.Attr("value"); !exists {
+				return
+			}
+		}
+
+		for _, attr := range []string{"property", "name"} {
+			key := strings.TrimSpace(s.AttrOr(attr, ""))
+			if !isSocialMetaKey(key) {
+				continue
+			}
+			if _, seen := meta[key]; !seen {
+				meta[key] = content
+			}
+		}
+	})
+
+	if len(meta) == 0 {
+		return nil
+	}
+	return meta
+}
+
+// isSocialMetaKey reports whether a meta name or property belongs in the social metadata
+func isSocialMetaKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, prefix := range socialMetaPrefixes {
+		if strings.HasPrefix(lower, prefix) && len(lower) > len(prefix) {
+			return true
+		}
+	}
+	return false
+}
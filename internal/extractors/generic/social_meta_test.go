@@ -0,0 +1,59 @@
+// ABOUTME: Test suite for OpenGraph, Twitter card and article meta tag collection
+// ABOUTME: Verifies name and property attributes, unmodified values and first-wins duplicates
+
+package generic
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericSocialMetaExtractor_Extract(t *testing.T) {
+	html := `<html><head>
+		<meta property="og:title" content="  Budget Vote ">
+		<meta property="og:image" content="https://example.com/a.jpg">
+		<meta property="og:image:width" content="1200">
+		<meta property="og:image" content="https://example.com/b.jpg">
+		<meta name="twitter:card" content="summary_large_image">
+		<meta name="twitter:label1" value="Reading time">
+		<meta property="article:published_time" content="2024-03-01T09:00:00Z">
+		<meta name="description" content="Not social metadata">
+		<meta property="og:" content="Empty key">
+		<meta property="og:locale">
+	</head><body></body></html>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+
+	extractor := &GenericSocialMetaExtractor{}
+	got := extractor.Extract(doc.Selection)
+
+	expected := map[string]string{
+		"og:title":               "  Budget Vote ",
+		"og:image":               "https://example.com/a.jpg",
+		"og:image:width":         "1200",
+		"twitter:card":           "summary_large_image",
+		"twitter:label1":         "Reading time",
+		"article:published_time": "2024-03-01T09:00:00Z",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestGenericSocialMetaExtractor_NoTags(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<html><head><meta name="author" content="A"></head></html>`))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+
+	extractor := &GenericSocialMetaExtractor{}
+	if got := extractor.Extract(doc.Selection); got != nil {
+		t.Errorf("Expected nil, got %v", got)
+	}
+}
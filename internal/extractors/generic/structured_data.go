@@ -0,0 +1,161 @@
+// ABOUTME: GenericStructuredDataExtractor reads Recipe and HowTo JSON-LD into plain structured data
+// ABOUTME: Flattens ingredient lists, instruction sections and how-to steps into ordered string lists
+
+package generic
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GenericStructuredDataExtractor extracts recipes and how-to guides declared in JSON-LD
+type GenericStructuredDataExtractor struct{}
+
+// Extract returns the recognized schema types keyed by "recipe" and "how_to",
+// or nil when the page declares neither. A recipe holds "name", "ingredients"
+// and "instructions"; a how-to holds "name" and "steps". Lists are []string
+// in page order.
+func (extractor *GenericStructuredDataExtractor) Extract(selection *goquery.Selection) map[string]interface{} {
+	var structured map[string]interface{}
+	add := func(key string, value map[string]interface{}) {
+		if structured == nil {
+			structured = make(map[string]interface{})
+		}
+		if _, exists := structured[key]; !exists {
+			structured[key] = value
+		}
+	}
+
+	selection.Find(`script[type="application/ld+json"]`).Each(func(i int, s *goquery.Selection) {
+		jsonText := strings.TrimSpace(s.Text())
+		if jsonText == "" {
+			return
+		}
+
+		var data interface{}
+		if err := json.Unmarshal([]byte(jsonText), &data); err != nil {
+			return // Skip invalid JSON
+		}
+
+		walkJSONLDObjects(data, func(obj map[string]interface{}) {
+			switch {
+			case hasJSONLDType(obj["@type"], "Recipe"):
+				if recipe := recipeFromJSONLD(obj); recipe != nil {
+					add("recipe", recipe)
+				}
+			case hasJSONLDType(obj["@type"], "HowTo"):
+				if howTo := howToFromJSONLD(obj); howTo != nil {
+					add("how_to", howTo)
+				}
+			}
+		})
+	})
+
+	return structured
+}
+
+// walkJSONLDObjects calls fn for every object in JSON-LD data, descending into arrays and @graph
+func walkJSONLDObjects(data interface{}, fn func(map[string]interface{})) {
+	switch v := data.(type) {
+	case []interface{}:
+		for _, item := range v {
+			walkJSONLDObjects(item, fn)
+		}
+	case map[string]interface{}:
+		fn(v)
+		for _, key := range []string{"@graph", "mainEntity"} {
+			if nested, ok := v[key]; ok {
+				walkJSONLDObjects(nested, fn)
+			}
+		}
+	}
+}
+
+// recipeFromJSONLD reads the name, ingredients and instructions of a Recipe object
+func recipeFromJSONLD(obj map[string]interface{}) map[string]interface{} {
+	ingredients := jsonLDTextList(obj["recipeIngredient"])
+	if len(ingredients) == 0 {
+		// Older markup uses the superseded "ingredients" property
+		ingredients = jsonLDTextList(obj["ingredients"])
+	}
+	instructions := jsonLDSteps(obj["recipeInstructions"])
+	if len(ingredients) == 0 && len(instructions) == 0 {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"name":         jsonLDString(obj["name"]),
+		"ingredients":  ingredients,
+		"instructions": instructions,
+	}
+}
+
+// howToFromJSONLD reads the name and steps of a HowTo object
+func howToFromJSONLD(obj map[string]interface{}) map[string]interface{} {
+	steps := jsonLDSteps(obj["step"])
+	if len(steps) == 0 {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"name":  jsonLDString(obj["name"]),
+		"steps": steps,
+	}
+}
+
+// jsonLDSteps flattens instructions given as text, a list of strings,
+// HowToStep objects or HowToSection objects into one ordered list
+func jsonLDSteps(value interface{}) []string {
+	var steps []string
+	switch v := value.(type) {
+	case string:
+		for _, line := range strings.Split(v, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				steps = append(steps, line)
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			steps = append(steps, jsonLDSteps(item)...)
+		}
+	case map[string]interface{}:
+		if items, ok := v["itemListElement"]; ok {
+			// HowToSection groups its own steps
+			return jsonLDSteps(items)
+		}
+		if text := jsonLDString(v["text"]); text != "" {
+			steps = append(steps, text)
+		} else if name := jsonLDString(v["name"]); name != "" {
+			steps = append(steps, name)
+		}
+	}
+	return steps
+}
+
+// jsonLDTextList reads a string or list of strings, dropping empty entries
+func jsonLDTextList(value interface{}) []string {
+	var list []string
+	switch v := value.(type) {
+	case string:
+		if text := strings.TrimSpace(v); text != "" {
+			list = append(list, text)
+		}
+	case []interface{}:
+		for _, item := range v {
+			if text := jsonLDString(item); text != "" {
+				list = append(list, text)
+			}
+		}
+	}
+	return list
+}
+
+// jsonLDString returns a trimmed string value, or "" for other types
+func jsonLDString(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return strings.TrimSpace(s)
+	}
+	return ""
+}
@@ -0,0 +1,102 @@
+// ABOUTME: Test suite for Recipe and HowTo structured data extraction from JSON-LD
+// ABOUTME: Verifies ingredient and step flattening across the common instruction shapes
+
+package generic
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const recipeJSONLD = `<script type="application/ld+json">{"@context":"https://schema.org","@graph":[
+	{"@type":"WebPage","name":"Pancakes"},
+	{"@type":"Recipe","name":"Weekend Pancakes",
+		"recipeIngredient":["200g flour","2 eggs","300ml milk"],
+		"recipeInstructions":[
+			{"@type":"HowToSection","name":"Batter","itemListElement":[
+				{"@type":"HowToStep","text":"Whisk the flour, eggs and milk."},
+				{"@type":"HowToStep","text":"Rest the batter for 20 minutes."}
+			]},
+			{"@type":"HowToStep","text":"Fry in a hot buttered pan."}
+		]}
+]}</script>`
+
+func TestGenericStructuredDataExtractor_Extract(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected map[string]interface{}
+	}{
+		{
+			name: "Recipe with instruction sections",
+			html: recipeJSONLD,
+			expected: map[string]interface{}{
+				"recipe": map[string]interface{}{
+					"name":        "Weekend Pancakes",
+					"ingredients": []string{"200g flour", "2 eggs", "300ml milk"},
+					"instructions": []string{
+						"Whisk the flour, eggs and milk.",
+						"Rest the batter for 20 minutes.",
+						"Fry in a hot buttered pan.",
+					},
+				},
+			},
+		},
+		{
+			name: "Recipe with text instructions",
+			html: `<script type="application/ld+json">{"@type":"Recipe","name":"Tea","recipeIngredient":"1 tea bag","recipeInstructions":"Boil water.\nSteep for 3 minutes."}</script>`,
+			expected: map[string]interface{}{
+				"recipe": map[string]interface{}{
+					"name":         "Tea",
+					"ingredients":  []string{"1 tea bag"},
+					"instructions": []string{"Boil water.", "Steep for 3 minutes."},
+				},
+			},
+		},
+		{
+			name: "HowTo steps",
+			html: `<script type="application/ld+json">{"@type":"HowTo","name":"Change a tire","step":[
+				{"@type":"HowToStep","name":"Loosen the nuts"},
+				{"@type":"HowToStep","text":"Jack up the car."}
+			]}</script>`,
+			expected: map[string]interface{}{
+				"how_to": map[string]interface{}{
+					"name":  "Change a tire",
+					"steps": []string{"Loosen the nuts", "Jack up the car."},
+				},
+			},
+		},
+		{
+			name: "Article only",
+			html: `<script type="application/ld+json">{"@type":"NewsArticle","headline":"Story"}</script>`,
+		},
+		{
+			name: "Invalid JSON",
+			html: `<script type="application/ld+json">{"@type":"Recipe",</script>`,
+		},
+	}
+
+	extractor := &GenericStructuredDataExtractor{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+
+			result := extractor.Extract(doc.Selection)
+			if tt.expected == nil {
+				if result != nil {
+					t.Errorf("Expected nil, got %v", result)
+				}
+				return
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Extract() = %#v, want %#v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// ABOUTME: GenericTableExtractor converts tables in article content into grids of cell text
+// ABOUTME: Expands colspan and rowspan by repeating the spanning cell's text in each covered position
+
+package generic
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GenericTableExtractor extracts tables as structured data
+type GenericTableExtractor struct{}
+
+// Upper bound for colspan and rowspan values, guarding against absurd spans
+const maxTableSpan = 100
+
+// Extract returns one grid of cell text per table, in document order.
+// Nested tables are extracted as tables of their own.
+func (extractor *GenericTableExtractor) Extract(selection *goquery.Selection) [][][]string {
+	var tables [][][]string
+	selection.Find("table").Each(func(i int, table *goquery.Selection) {
+		if grid := tableGrid(table); len(grid) > 0 {
+			tables = append(tables, grid)
+		}
+	})
+	return tables
+}
+
+// pendingSpan is a cell from an earlier row still covering rows below it
+type pendingSpan struct {
+	text string
+	rows int
+}
+
+// tableGrid lays out a table's cells, filling spanned positions with the spanning cell's text
+func tableGrid(table *goquery.Selection) [][]string {
+	var grid [][]string
+	spans := make(map[int]*pendingSpan)
+
+	// fillSpans appends cells still covered by rowspans from earlier rows, starting at col
+	fillSpans := func(row []string, col int, untilCell bool) []string {
+		for {
+			span := spans[col]
+			if span == nil || span.rows == 0 {
+				if untilCell || !hasSpansFrom(spans, col) {
+					return row
+				}
+				row = append(row, "")
+				col++
+				continue
+			}
+			row = append(row, span.text)
+			span.rows--
+			col++
+		}
+	}
+
+	for _, rows := range tableRows(table) {
+		rows.Each(func(i int, tr *goquery.Selection) {
+			var row []string
+			tr.Children().Filter("th, td").Each(func(j int, cell *goquery.Selection) {
+				row = fillSpans(row, len(row), true)
+
+				text := strings.Join(strings.Fields(cellText(cell)), " ")
+				colspan := spanAttr(cell, "colspan")
+				rowspan := spanAttr(cell, "rowspan")
+				for k := 0; k < colspan; k++ {
+					if rowspan > 1 {
+						spans[len(row)] = &pendingSpan{text: text, rows: rowspan - 1}
+					}
+					row = append(row, text)
+				}
+			})
+			row = fillSpans(row, len(row), false)
+
+			if len(row) > 0 {
+				grid = append(grid, row)
+			}
+		})
+	}
+
+	return grid
+}
+
+// tableRows returns a table's own rows, excluding rows of tables nested inside it.
+// Header rows come first, then body rows, then footer rows.
+func tableRows(table *goquery.Selection) []*goquery.Selection {
+	return []*goquery.Selection{
+		table.ChildrenFiltered("thead").ChildrenFiltered("tr"),
+		table.ChildrenFiltered("tr").AddSelection(table.ChildrenFiltered("tbody").ChildrenFiltered("tr")),
+		table.ChildrenFiltered("tfoot").ChildrenFiltered("tr"),
+	}
+}
+
+// hasSpansFrom reports whether any rowspan still covers a column at or after col
+func hasSpansFrom(spans map[int]*pendingSpan, col int) bool {
+	for c, span := range spans {
+		if c >= col && span.rows > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// cellText returns a cell's text without the text of tables nested in it
+func cellText(cell *goquery.Selection) string {
+	if cell.Find("table").Length() == 0 {
+		return cell.Text()
+	}
+	clone := cell.Clone()
+	clone.Find("table").Remove()
+	return clone.Text()
+}
+
+// spanAttr reads a colspan or rowspan attribute, defaulting to 1
+func spanAttr(cell *goquery.Selection, attr string) int {
+	span, err := strconv.Atoi(strings.TrimSpace(cell.AttrOr(attr, "1")))
+	if err != nil || span < 1 {
+		return 1
+	}
+	if span > maxTableSpan {
+		return maxTableSpan
+	}
+	return span
+}
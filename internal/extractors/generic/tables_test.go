@@ -0,0 +1,93 @@
+// ABOUTME: Test suite for table extraction into grids of cell text
+// ABOUTME: Verifies header rows, colspan/rowspan expansion and nested tables
+
+package generic
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericTableExtractor_Extract(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected [][][]string
+	}{
+		{
+			name: "simple table",
+			html: `<table>
+				<tr><th>City</th><th>Population</th></tr>
+				<tr><td>Oslo</td><td> 709,037 </td></tr>
+				<tr><td>Bergen</td><td>291,940</td></tr>
+			</table>`,
+			expected: [][][]string{{
+				{"City", "Population"},
+				{"Oslo", "709,037"},
+				{"Bergen", "291,940"},
+			}},
+		},
+		{
+			name: "colspan header",
+			html: `<table>
+				<thead>
+					<tr><th rowspan="2">Team</th><th colspan="2">Goals</th></tr>
+					<tr><th>For</th><th>Against</th></tr>
+				</thead>
+				<tbody>
+					<tr><td>Rovers</td><td>12</td><td>4</td></tr>
+				</tbody>
+			</table>`,
+			expected: [][][]string{{
+				{"Team", "Goals", "Goals"},
+				{"Team", "For", "Against"},
+				{"Rovers", "12", "4"},
+			}},
+		},
+		{
+			name: "rowspan in last column and footer after body",
+			html: `<table>
+				<tfoot><tr><td>Total</td><td>3</td></tr></tfoot>
+				<tbody>
+					<tr><td>a</td><td rowspan="2">shared</td></tr>
+					<tr><td>b</td></tr>
+				</tbody>
+			</table>`,
+			expected: [][][]string{{
+				{"a", "shared"},
+				{"b", "shared"},
+				{"Total", "3"},
+			}},
+		},
+		{
+			name: "nested table",
+			html: `<table><tr><td>outer<table><tr><td>inner</td></tr></table></td></tr></table>`,
+			expected: [][][]string{
+				{{"outer"}},
+				{{"inner"}},
+			},
+		},
+		{
+			name:     "no tables",
+			html:     `<p>Just prose.</p><table></table>`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+
+			extractor := &GenericTableExtractor{}
+			if got := extractor.Extract(doc.Selection); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// ABOUTME: GenericThemeColorExtractor reads the browser UI color a page declares with meta theme-color
+// ABOUTME: Prefers the light color scheme variant of media-scoped pairs and rejects values that are not CSS colors
+
+package generic
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GenericThemeColorExtractor extracts the page's declared theme color
+type GenericThemeColorExtractor struct{}
+
+// Ranks for theme-color tags, lower wins: a light scheme color, then one
+// without a media query, then one scoped to any other media such as dark mode
+const (
+	themeColorLight = iota
+	themeColorUnscoped
+	themeColorOther
+)
+
+// Extract returns the theme-color meta value, or empty when the page declares
+// none or only values that are not CSS colors. When several are declared with
+// media queries, the light color scheme one is preferred.
+func (extractor *GenericThemeColorExtractor) Extract(selection *goquery.Selection) string {
+	best, bestRank := "", themeColorOther+1
+	selection.Find("meta[name]").Each(func(i int, s *goquery.Selection) {
+		if !strings.EqualFold(strings.TrimSpace(s.AttrOr("name", "")), "theme-color") {
+			return
+		}
+		// Prepared documents carry meta content in value
+		color := strings.TrimSpace(s.AttrOr("value", s.AttrOr("content", "")))
+		if !isCSSColor(color) {
+			return
+		}
+		if rank := themeColorRank(s.AttrOr("media", "")); rank < bestRank {
+			best, bestRank = color, rank
+		}
+	})
+	return best
+}
+
+// themeColorRank ranks a theme-color media attribute
+func themeColorRank(media string) int {
+	media = strings.ToLower(strings.Join(strings.Fields(media), ""))
+	switch {
+	case media == "":
+		return themeColorUnscoped
+	case strings.Contains(media, "prefers-color-scheme:light"):
+		return themeColorLight
+	}
+	return themeColorOther
+}
+
+// Hex colors in the #rgb, #rgba, #rrggbb and #rrggbbaa forms
+var hexColorRe = regexp.MustCompile(`^#([0-9a-fA-F]{3,4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+
+// Color functions such as rgb(), hsl() and oklch() with plain numeric arguments
+var colorFunctionRe = regexp.MustCompile(`(?i)^(rgba?|hsla?|hwb|lab|lch|oklab|oklch|color)\(\s*[-+0-9a-z.%\s,/]+\)$`)
+
+// isCSSColor reports whether value is a CSS color: a hex color, a color
+// function or a named color. System keywords such as currentcolor are not
+// accepted since they mean nothing outside a stylesheet.
+func isCSSColor(value string) bool {
+	value = strings.TrimSpace(value)
+	switch {
+	case value == "":
+		return false
+	case strings.HasPrefix(value, "#"):
+		return hexColorRe.MatchString(value)
+	case strings.Contains(value, "("):
+		return colorFunctionRe.MatchString(value)
+	}
+	return namedColors[strings.ToLower(value)]
+}
+
+// CSS named colors, plus transparent
+var namedColors = map[string]bool{
+	"aliceblue": true, "antiquewhite": true, "aqua": true, "aquamarine": true, "azure": true,
+	"beige": true, "bisque": true, "black": true, "blanchedalmond": true, "blue": true,
+	"blueviolet": true, "brown": true, "burlywood": true, "cadetblue": true, "chartreuse": true,
+	"chocolate": true, "coral": true, "cornflowerblue": true, "cornsilk": true, "crimson": true,
+	"cyan": true, "darkblue": true, "darkcyan": true, "darkgoldenrod": true, "darkgray": true,
+	"darkgreen": true, "darkgrey": true, "darkkhaki": true, "darkmagenta": true, "darkolivegreen": true,
+	"darkorange": true, "darkorchid": true, "darkred": true, "darksalmon": true, "darkseagreen": true,
+	"darkslateblue": true, "darkslategray": true, "darkslategrey": true, "darkturquoise": true, "darkviolet": true,
+	"deeppink": true, "deepskyblue": true, "dimgray": true, "dimgrey": true, "dodgerblue": true,
+	"firebrick": true, "floralwhite": true, "forestgreen": true, "fuchsia": true, "gainsboro": true,
+	"ghostwhite": true, "gold": true, "goldenrod": true, "gray": true, "green": true,
+	"greenyellow": true, "grey": true, "honeydew": true, "hotpink": true, "indianred": true,
+	"indigo": true, "ivory": true, "khaki": true, "lavender": true, "lavenderblush": true,
+	"lawngreen": true, "lemonchiffon": true, "lightblue": true, "lightcoral": true, "lightcyan": true,
+	"lightgoldenrodyellow": true, "lightgray": true, "lightgreen": true, "lightgrey": true, "lightpink": true,
+	"lightsalmon": true, "lightseagreen": true, "lightskyblue": true, "lightslategray": true, "lightslategrey": true,
+	"lightsteelblue": true, "lightyellow": true, "lime": true, "limegreen": true, "linen": true,
+	"magenta": true, "maroon": true, "mediumaquamarine": true, "mediumblue": true, "mediumorchid": true,
+	"mediumpurple": true, "mediumseagreen": true, "mediumslateblue": true, "mediumspringgreen": true, "mediumturquoise": true,
+	"mediumvioletred": true, "midnightblue": true, "mintcream": true, "mistyrose": true, "moccasin": true,
+	"navajowhite": true, "navy": true, "oldlace": true, "olive": true, "olivedrab": true,
+	"orange": true, "orangered": true, "orchid": true, "palegoldenrod": true, "palegreen": true,
+	"paleturquoise": true, "palevioletred": true, "papayawhip": true, "peachpuff": true, "peru": true,
+	"pink": true, "plum": true, "powderblue": true, "purple": true, "rebeccapurple": true,
+	"red": true, "rosybrown": true, "royalblue": true, "saddlebrown": true, "salmon": true,
+	"sandybrown": true, "seagreen": true, "seashell": true, "sienna": true, "silver": true,
+	"skyblue": true, "slateblue": true, "slategray": true, "slategrey": true, "snow": true,
+	"springgreen": true, "steelblue": true, "tan": true, "teal": true, "thistle": true,
+	"tomato": true, "turquoise": true, "violet": true, "wheat": true, "white": true,
+	"whitesmoke": true, "yellow": true, "yellowgreen": true, "transparent": true,
+}
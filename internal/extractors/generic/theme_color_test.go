@@ -0,0 +1,79 @@
+// ABOUTME: Test suite for theme-color extraction
+// ABOUTME: Covers plain and media-scoped declarations and rejection of values that are not CSS colors
+
+package generic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenericThemeColorExtractor_Extract(t *testing.T) {
+	tests := []struct {
+		name     string
+		head     string
+		expected string
+	}{
+		{
+			name:     "simple theme color",
+			head:     `<meta name="theme-color" content="#4285f4">`,
+			expected: "#4285f4",
+		},
+		{
+			name: "media-scoped pair prefers light",
+			head: `<meta name="theme-color" media="(prefers-color-scheme: dark)" content="#1a1a1a">
+				<meta name="theme-color" media="(prefers-color-scheme: light)" content="rgb(255, 255, 255)">`,
+			expected: "rgb(255, 255, 255)",
+		},
+		{
+			name: "unscoped beats dark",
+			head: `<meta name="theme-color" media="(prefers-color-scheme: dark)" content="black">
+				<meta name="Theme-Color" content="RebeccaPurple">`,
+			expected: "RebeccaPurple",
+		},
+		{
+			name:     "only a dark color",
+			head:     `<meta name="theme-color" media="(prefers-color-scheme:dark)" content="#000">`,
+			expected: "#000",
+		},
+		{
+			name: "invalid light value is skipped",
+			head: `<meta name="theme-color" media="(prefers-color-scheme: light)" content="brandblue">
+				<meta name="theme-color" content="hsl(210 50% 40% / 0.9)">`,
+			expected: "hsl(210 50% 40% / 0.9)",
+		},
+		{
+			name:     "normalized meta value",
+			head:     `<meta name="theme-color" value="teal">`,
+			expected: "teal",
+		},
+		{
+			name:     "invalid hex",
+			head:     `<meta name="theme-color" content="#12345">`,
+			expected: "",
+		},
+		{
+			name:     "script in value",
+			head:     `<meta name="theme-color" content="url(javascript:alert(1))">`,
+			expected: "",
+		},
+		{
+			name:     "absent",
+			head:     `<meta name="description" content="No theme">`,
+			expected: "",
+		},
+	}
+
+	extractor := &GenericThemeColorExtractor{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html><head>" + tt.head + "</head><body></body></html>"))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, extractor.Extract(doc.Selection))
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// ABOUTME: GenericPublishTimezoneExtractor infers the timezone an article was published in
+// ABOUTME: Reads UTC offsets on declared publish dates, a timezone meta tag, then single-zone site locales
+
+package generic
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GenericPublishTimezoneExtractor extracts the publish timezone as an IANA name or UTC offset
+type GenericPublishTimezoneExtractor struct{}
+
+// Trailing UTC offset on a date-time, e.g. "T09:30:00+05:30", "T09:30:00-0400", "T09:30:00Z"
+var dateOffsetRE = regexp.MustCompile(`(?i)[T ]\d{1,2}:\d{2}(?::\d{2}(?:\.\d+)?)?\s*(Z|[+-]\d{2}:?\d{2})$`)
+
+// Explicit offset or IANA zone name in a timezone meta tag
+var (
+	timezoneOffsetRE = regexp.MustCompile(`^(?:UTC|GMT)?\s*([+-]\d{2}:?\d{2})$`)
+	timezoneNameRE   = regexp.MustCompile(`^(?:UTC|[A-Z][A-Za-z_]+(?:/[A-Z][A-Za-z_+-]+)+)$`)
+)
+
+// Regions whose whole territory shares one timezone, so a locale such as
+// "de-DE" pins the zone. Multi-zone countries (US, CA, AU, BR, RU...) are
+// deliberately absent.
+var localeRegionTimezones = map[string]string{
+	"at": "Europe/Vienna",
+	"be": "Europe/Brussels",
+	"ch": "Europe/Zurich",
+	"cz": "Europe/Prague",
+	"de": "Europe/Berlin",
+	"dk": "Europe/Copenhagen",
+	"fi": "Europe/Helsinki",
+	"fr": "Europe/Paris",
+	"gb": "Europe/London",
+	"gr": "Europe/Athens",
+	"ie": "Europe/Dublin",
+	"in": "Asia/Kolkata",
+	"it": "Europe/Rome",
+	"jp": "Asia/Tokyo",
+	"kr": "Asia/Seoul",
+	"nl": "Europe/Amsterdam",
+	"no": "Europe/Oslo",
+	"nz": "Pacific/Auckland",
+	"pl": "Europe/Warsaw",
+	"se": "Europe/Stockholm",
+	"sg": "Asia/Singapore",
+	"tw": "Asia/Taipei",
+	"uk": "Europe/London",
+}
+
+// Extract returns the publish timezone, or "" when the page gives no clue.
+// An offset written on the publish date wins because it reflects daylight
+// saving at publish time; a timezone meta tag comes next and the site
+// locale last.
+func (extractor *GenericPublishTimezoneExtractor) Extract(selection *goquery.Selection) string {
+	if offset := publishDateOffset(selection); offset != "" {
+		return offset
+	}
+	if tz := timezoneFromMeta(selection); tz != "" {
+		return tz
+	}
+	return timezoneFromLocale(selection)
+}
+
+// publishDateOffset reads the UTC offset of the raw publish date in meta tags or JSON-LD
+func publishDateOffset(selection *goquery.Selection) string {
+	for _, name := range DATE_PUBLISHED_META_TAGS {
+		meta := selection.Find(`meta[name="` + name + `"], meta[property="` + name + `"]`).First()
+		if meta.Length() == 0 {
+			continue
+		}
+		value := strings.TrimSpace(meta.AttrOr("value", meta.AttrOr("content", "")))
+		// Only the first declared date counts, matching the date extractor
+		return formatDateOffset(value)
+	}
+
+	offset := ""
+	selection.Find(`script[type="application/ld+json"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+		var data interface{}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(s.Text())), &data); err != nil {
+			return true // Skip invalid JSON
+		}
+		walkJSONLDObjects(data, func(obj map[string]interface{}) {
+			if offset == "" {
+				offset = formatDateOffset(jsonLDString(obj["datePublished"]))
+			}
+		})
+		return offset == ""
+	})
+	return offset
+}
+
+// formatDateOffset returns a date-time's offset as "+hh:mm", or "" when it has none
+func formatDateOffset(date string) string {
+	match := dateOffsetRE.FindStringSubmatch(date)
+	if match == nil {
+		return ""
+	}
+	return normalizeOffset(match[1])
+}
+
+// normalizeOffset writes "Z", "+0530" and "+05:30" all as "+hh:mm"
+func normalizeOffset(offset string) string {
+	if strings.EqualFold(offset, "Z") {
+		return "+00:00"
+	}
+	offset = strings.Replace(offset, ":", "", 1)
+	return offset[:3] + ":" + offset[3:]
+}
+
+// timezoneFromMeta reads an explicit timezone meta tag holding an IANA name or offset
+func timezoneFromMeta(selection *goquery.Selection) string {
+	meta := selection.Find(`meta[name="timezone"], meta[property="timezone"]`).First()
+	value := strings.TrimSpace(meta.AttrOr("value", meta.AttrOr("content", "")))
+	if match := timezoneOffsetRE.FindStringSubmatch(value); match != nil {
+		return normalizeOffset(match[1])
+	}
+	if timezoneNameRE.MatchString(value) {
+		return value
+	}
+	return ""
+}
+
+// timezoneFromLocale maps a region-qualified site locale to its timezone
+func timezoneFromLocale(selection *goquery.Selection) string {
+	ogLocale := selection.Find(`meta[name="og:locale"], meta[property="og:locale"]`).First()
+	contentLanguage := selection.Find(`meta[http-equiv="content-language"]`).First()
+	locales := []string{
+		ogLocale.AttrOr("value", ogLocale.AttrOr("content", "")),
+		selection.Find("html").AttrOr("lang", ""),
+		contentLanguage.AttrOr("value", contentLanguage.AttrOr("content", "")),
+	}
+	for _, locale := range locales {
+		_, region, found := strings.Cut(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"), "-")
+		if !found {
+			continue
+		}
+		if tz, ok := localeRegionTimezones[strings.ToLower(region)]; ok {
+			return tz
+		}
+	}
+	return ""
+}
@@ -0,0 +1,55 @@
+// ABOUTME: Tests for GenericPublishTimezoneExtractor
+// ABOUTME: Covers publish date offsets, timezone meta tags, locale inference and pages with no clue
+
+package generic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericPublishTimezoneExtractor(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected string
+	}{
+		{"meta date with offset", `<head><meta name="article:published_time" value="2024-03-10T09:30:00+05:30"></head>`, "+05:30"},
+		{"meta date with compact offset", `<head><meta property="article:published_time" content="2024-03-10T09:30:00-0400"></head>`, "-04:00"},
+		{"meta date in UTC", `<head><meta name="article:published_time" value="2024-03-10T09:30:00Z"></head>`, "+00:00"},
+		{"jsonld date with offset", `<head><script type="application/ld+json">{"@type":"NewsArticle","datePublished":"2024-03-10T09:30:00+09:00"}</script></head>`, "+09:00"},
+		{"date without offset", `<head><meta name="article:published_time" value="2024-03-10T09:30:00"></head>`, ""},
+		{"timezone meta name", `<head><meta name="timezone" content="America/Chicago"></head>`, "America/Chicago"},
+		{"timezone meta offset", `<head><meta name="timezone" content="GMT+0100"></head>`, "+01:00"},
+		{"date offset wins over meta", `<head><meta name="article:published_time" value="2024-03-10T09:30:00+01:00"><meta name="timezone" content="Europe/Lisbon"></head>`, "+01:00"},
+		{"single-zone locale", `<head><meta property="og:locale" content="de_DE"></head>`, "Europe/Berlin"},
+		{"multi-zone locale", `<head><meta property="og:locale" content="en_US"></head>`, ""},
+		{"no clue", `<head><title>Nothing here</title></head>`, ""},
+	}
+
+	extractor := &GenericPublishTimezoneExtractor{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html>" + tt.html + "<body></body></html>"))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+			if got := extractor.Extract(doc.Selection); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGenericPublishTimezoneExtractorHTMLLang(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<html lang="ja-JP"><head></head><body></body></html>`))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+	extractor := &GenericPublishTimezoneExtractor{}
+	if got := extractor.Extract(doc.Selection); got != "Asia/Tokyo" {
+		t.Errorf("Expected Asia/Tokyo, got %q", got)
+	}
+}
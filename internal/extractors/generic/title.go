@@ -4,6 +4,7 @@
 package generic
 
 import (
+	"encoding/json"
 	"net/url"
 	"regexp"
 	"strings"
@@ -66,6 +67,21 @@ var (
 		"title",
 	}
 
+	// Social titles preferred over a weak heuristic title, in order
+	SOCIAL_TITLE_META_TAGS = []string{
+		"og:title",
+		"twitter:title",
+	}
+
+	// Meta tags naming the site, which a heuristic title sometimes is
+	SITE_NAME_META_TAGS = []string{
+		"og:site_name",
+		"application-name",
+	}
+
+	// Heuristic titles with fewer words than this count as weak
+	MIN_TITLE_WORDS = 3
+
 	// Regular expression for title separators
 	TITLE_SPLITTERS_RE = regexp.MustCompile(`(: | - | \| )`)
 
@@ -78,58 +94,163 @@ var GenericTitleExtractor = struct {
 	Extract func(doc *goquery.Selection, url string, metaCache []string) string
 }{
 	Extract: func(doc *goquery.Selection, url string, metaCache []string) string {
-		// Convert selection to document for meta tag extraction
-		// Get the full HTML from the selection to create a proper document
-		html := "<html></html>" // Default fallback
-		if doc.Length() > 0 {
-			if fullHtml, err := doc.Html(); err == nil && fullHtml != "" {
-				html = "<html>" + fullHtml + "</html>"
-			} else {
-				// Try to get the parent document HTML
-				if doc.Parent().Length() > 0 {
-					if parentHtml, err := doc.Parent().Html(); err == nil {
-						html = "<html>" + parentHtml + "</html>"
-					}
+		title, _ := ExtractTitleWithSource(doc, url, metaCache)
+		return title
+	},
+}
+
+// ExtractTitleWithSource extracts the article title like GenericTitleExtractor
+// and also reports which signal it came from (SourceMeta, SourceJSONLD or
+// SourceSelector). The source is empty when no title is found.
+func ExtractTitleWithSource(doc *goquery.Selection, url string, metaCache []string) (string, string) {
+	// Convert selection to document for meta tag extraction
+	// Get the full HTML from the selection to create a proper document
+	html := "<html></html>" // Default fallback
+	if doc.Length() > 0 {
+		if fullHtml, err := doc.Html(); err == nil && fullHtml != "" {
+			html = "<html>" + fullHtml + "</html>"
+		} else {
+			// Try to get the parent document HTML
+			if doc.Parent().Length() > 0 {
+				if parentHtml, err := doc.Parent().Html(); err == nil {
+					html = "<html>" + parentHtml + "</html>"
 				}
 			}
-		} else {
-			return ""
 		}
+	} else {
+		return "", ""
+	}
 
-		document, err := goquery.NewDocumentFromReader(strings.NewReader(html))
-		if err != nil {
-			return ""
-		}
+	document, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		return "", ""
+	}
 
-		// First, check to see if we have a matching meta tag that we can make
-		// use of that is strongly associated with the headline.
-		title := dom.ExtractFromMeta(document, STRONG_TITLE_META_TAGS, metaCache, true)
-		if title != nil && *title != "" {
-			return cleanTitle(*title, url, doc)
-		}
+	title, source := extractHeuristicTitle(doc, document, url, metaCache)
 
-		// Second, look through our content selectors for the most likely
-		// article title that is strongly associated with the headline.
-		title = dom.ExtractFromSelectors(doc, STRONG_TITLE_SELECTORS, 1, true)
-		if title != nil && *title != "" {
-			return cleanTitle(*title, url, doc)
+	// A navigational or truncated heuristic title gives way to the social
+	// title when that one says more
+	if isWeakTitle(title, doc) {
+		if social := extractSocialTitle(doc, document, url, metaCache); social != "" && !isWeakTitle(social, doc) && wordCount(social) > wordCount(title) {
+			return social, SourceMeta
 		}
+	}
+	return title, source
+}
+
+// extractHeuristicTitle runs the title signals from strongest to weakest and
+// returns the first match with its source
+func extractHeuristicTitle(doc *goquery.Selection, document *goquery.Document, url string, metaCache []string) (string, string) {
+	// First, check to see if we have a matching meta tag that we can make
+	// use of that is strongly associated with the headline.
+	title := dom.ExtractFromMeta(document, STRONG_TITLE_META_TAGS, metaCache, true)
+	if title != nil && *title != "" {
+		return cleanTitle(*title, url, doc), SourceMeta
+	}
 
-		// Third, check for weaker meta tags that may match.
-		title = dom.ExtractFromMeta(document, WEAK_TITLE_META_TAGS, metaCache, true)
-		if title != nil && *title != "" {
-			return cleanTitle(*title, url, doc)
+	// Second, look through our content selectors for the most likely
+	// article title that is strongly associated with the headline.
+	title = dom.ExtractFromSelectors(doc, STRONG_TITLE_SELECTORS, 1, true)
+	if title != nil && *title != "" {
+		return cleanTitle(*title, url, doc), SourceSelector
+	}
+
+	// Third, use the headline from JSON-LD article data, which is free of the
+	// branding og:title tends to carry.
+	if headline := extractTitleFromJSONLD(doc); headline != "" {
+		return cleanTitle(headline, url, doc), SourceJSONLD
+	}
+
+	// Fourth, check for weaker meta tags that may match.
+	title = dom.ExtractFromMeta(document, WEAK_TITLE_META_TAGS, metaCache, true)
+	if title != nil && *title != "" {
+		return cleanTitle(*title, url, doc), SourceMeta
+	}
+
+	// Last, look for weaker selector tags that may match.
+	title = dom.ExtractFromSelectors(doc, WEAK_TITLE_SELECTORS, 1, true)
+	if title != nil && *title != "" {
+		return cleanTitle(*title, url, doc), SourceSelector
+	}
+
+	// If no matches, return an empty string
+	return "", ""
+}
+
+// extractSocialTitle returns the cleaned og:title or twitter:title
+func extractSocialTitle(doc *goquery.Selection, document *goquery.Document, url string, metaCache []string) string {
+	title := dom.ExtractFromMeta(document, SOCIAL_TITLE_META_TAGS, metaCache, true)
+	if title == nil || *title == "" {
+		return ""
+	}
+	return cleanTitle(*title, url, doc)
+}
+
+// isWeakTitle reports whether title looks like a navigational or truncated
+// heading rather than a headline: empty, shorter than MIN_TITLE_WORDS words,
+// all capitals, or just the site's name
+func isWeakTitle(title string, doc *goquery.Selection) bool {
+	if wordCount(title) < MIN_TITLE_WORDS {
+		return true
+	}
+	if strings.ToUpper(title) == title && strings.ToLower(title) != title {
+		return true
+	}
+	siteName := firstMetaValue(doc, SITE_NAME_META_TAGS)
+	return siteName != "" && strings.EqualFold(title, siteName)
+}
+
+// wordCount returns the number of space-separated words in s
+func wordCount(s string) int {
+	return len(strings.Fields(s))
+}
+
+// extractTitleFromJSONLD returns the headline of the first JSON-LD article object
+func extractTitleFromJSONLD(doc *goquery.Selection) string {
+	var headline string
+	doc.Find(`script[type="application/ld+json"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+		jsonText := strings.TrimSpace(s.Text())
+		if jsonText == "" {
+			return true
 		}
 
-		// Last, look for weaker selector tags that may match.
-		title = dom.ExtractFromSelectors(doc, WEAK_TITLE_SELECTORS, 1, true)
-		if title != nil && *title != "" {
-			return cleanTitle(*title, url, doc)
+		var data interface{}
+		if err := json.Unmarshal([]byte(jsonText), &data); err != nil {
+			return true // Skip invalid JSON
 		}
 
-		// If no matches, return an empty string
-		return ""
-	},
+		headline = findJSONLDHeadline(data)
+		return headline == ""
+	})
+	return headline
+}
+
+// findJSONLDHeadline walks JSON-LD data (objects, arrays and @graph) for an article headline
+func findJSONLDHeadline(data interface{}) string {
+	switch v := data.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if headline := findJSONLDHeadline(item); headline != "" {
+				return headline
+			}
+		}
+	case map[string]interface{}:
+		for _, articleType := range []string{"Article", "NewsArticle", "BlogPosting", "Report", "ScholarlyArticle"} {
+			if hasJSONLDType(v["@type"], articleType) {
+				if headline, ok := v["headline"].(string); ok && strings.TrimSpace(headline) != "" {
+					return strings.TrimSpace(headline)
+				}
+			}
+		}
+		for _, key := range []string{"@graph", "mainEntity"} {
+			if nested, ok := v[key]; ok {
+				if headline := findJSONLDHeadline(nested); headline != "" {
+					return headline
+				}
+			}
+		}
+	}
+	return ""
 }
 
 // cleanTitle cleans and normalizes the title text
@@ -253,6 +253,122 @@ func TestGenericTitleExtractor_Extract(t *testing.T) {
 	}
 }
 
+func TestExtractTitleWithSource(t *testing.T) {
+	tests := []struct {
+		name           string
+		html           string
+		expectedTitle  string
+		expectedSource string
+	}{
+		{
+			name: "strong meta tag",
+			html: `<html><head><meta name="dc.title" value="Council Passes Budget"></head>
+				<body><h1>Something Else</h1></body></html>`,
+			expectedTitle:  "Council Passes Budget",
+			expectedSource: SourceMeta,
+		},
+		{
+			name: "JSON-LD headline beats og:title",
+			html: `<html><head><meta name="og:title" value="Council Passes Budget | City News">
+				<script type="application/ld+json">{"@context":"https://schema.org","@graph":[{"@type":"WebSite","name":"City News"},{"@type":"NewsArticle","headline":"Council Passes Budget"}]}</script>
+				</head><body><h1>Council Passes Budget</h1></body></html>`,
+			expectedTitle:  "Council Passes Budget",
+			expectedSource: SourceJSONLD,
+		},
+		{
+			name:           "weak selector",
+			html:           `<html><body><article><h1>Council Passes Budget</h1></article></body></html>`,
+			expectedTitle:  "Council Passes Budget",
+			expectedSource: SourceSelector,
+		},
+		{
+			name: "no title",
+			html: `<html><body><p>No headline here</p></body></html>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+
+			title, source := ExtractTitleWithSource(doc.Selection, "https://example.com/article", []string{"dc.title", "og:title"})
+			if title != tt.expectedTitle {
+				t.Errorf("Expected title %q, got %q", tt.expectedTitle, title)
+			}
+			if source != tt.expectedSource {
+				t.Errorf("Expected source %q, got %q", tt.expectedSource, source)
+			}
+		})
+	}
+}
+
+func TestExtractTitleWithSource_WeakTitleFallback(t *testing.T) {
+	tests := []struct {
+		name           string
+		html           string
+		expectedTitle  string
+		expectedSource string
+	}{
+		{
+			name: "short heading recovered from og:title",
+			html: `<html><head><meta property="og:title" content="Council Passes Budget After Long Debate">
+				</head><body><article><h1>Budget</h1></article></body></html>`,
+			expectedTitle:  "Council Passes Budget After Long Debate",
+			expectedSource: SourceMeta,
+		},
+		{
+			name: "all-caps heading recovered from twitter:title",
+			html: `<html><head><meta name="twitter:title" content="Council Passes Budget After Long Debate">
+				</head><body><article><h1>BREAKING NEWS TODAY</h1></article></body></html>`,
+			expectedTitle:  "Council Passes Budget After Long Debate",
+			expectedSource: SourceMeta,
+		},
+		{
+			name: "site name heading recovered from og:title",
+			html: `<html><head><meta property="og:site_name" content="The Springfield Daily Herald">
+				<meta property="og:title" content="Council Passes Budget After Long Debate">
+				</head><body><article><h1>The Springfield Daily Herald</h1></article></body></html>`,
+			expectedTitle:  "Council Passes Budget After Long Debate",
+			expectedSource: SourceMeta,
+		},
+		{
+			name: "descriptive headline stays primary",
+			html: `<html><head><meta property="og:title" content="Council Passes Budget After Long Debate">
+				<script type="application/ld+json">{"@type":"NewsArticle","headline":"Council Passes Budget"}</script>
+				</head><body></body></html>`,
+			expectedTitle:  "Council Passes Budget",
+			expectedSource: SourceJSONLD,
+		},
+		{
+			name: "weak heading kept when twitter:title is no better",
+			html: `<html><head><meta name="twitter:title" content="BUDGET NEWS TODAY">
+				</head><body><div class="hentry"><h1 class="entry-title">Budget</h1></div></body></html>`,
+			expectedTitle:  "Budget",
+			expectedSource: SourceSelector,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+
+			title, source := ExtractTitleWithSource(doc.Selection, "https://example.com/article", []string{"og:title", "twitter:title"})
+			if title != tt.expectedTitle {
+				t.Errorf("Expected title %q, got %q", tt.expectedTitle, title)
+			}
+			if source != tt.expectedSource {
+				t.Errorf("Expected source %q, got %q", tt.expectedSource, source)
+			}
+		})
+	}
+}
+
 func TestCleanTitle_SplitTitleResolution(t *testing.T) {
 	tests := []struct {
 		name     string
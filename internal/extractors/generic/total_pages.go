@@ -0,0 +1,58 @@
+// ABOUTME: GenericTotalPagesExtractor detects how many pages a paginated article spans
+// ABOUTME: Reads "Page X of Y" indicators and the page number of rel=last links
+
+package generic
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/BumpyClock/hermes/internal/utils/text"
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GenericTotalPagesExtractor extracts the total page count of an article series
+type GenericTotalPagesExtractor struct{}
+
+// "Page 2 of 4", "page 2/4"
+var pageOfTotalRE = regexp.MustCompile(`(?i)\bpage\s+(\d{1,3})\s*(?:of|/)\s*(\d{1,3})\b`)
+
+// Extract returns the total number of pages, or 0 when the page shows no
+// pagination. A rel=last link wins over indicator text because it is markup
+// rather than prose.
+func (extractor *GenericTotalPagesExtractor) Extract(selection *goquery.Selection) int {
+	if total := totalPagesFromLastLink(selection); total > 0 {
+		return total
+	}
+	return totalPagesFromIndicator(selection.Text())
+}
+
+// totalPagesFromLastLink reads the page number from a link[rel=last] or a[rel=last] href
+func totalPagesFromLastLink(selection *goquery.Selection) int {
+	total := 0
+	selection.Find(`link[rel~="last"], a[rel~="last"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+		href, err := url.Parse(s.AttrOr("href", ""))
+		if err != nil {
+			return true
+		}
+		// Only the path and query carry the page number; hosts like 127.0.0.1 would match too
+		if pageNum := text.PageNumFromURL(href.RequestURI()); pageNum != nil && *pageNum > 1 {
+			total = *pageNum
+		}
+		return total == 0
+	})
+	return total
+}
+
+// totalPagesFromIndicator reads the total from the first plausible "Page X of Y" text
+func totalPagesFromIndicator(content string) int {
+	for _, match := range pageOfTotalRE.FindAllStringSubmatch(content, -1) {
+		current, _ := strconv.Atoi(match[1])
+		total, _ := strconv.Atoi(match[2])
+		if current >= 1 && total > 1 && current <= total {
+			return total
+		}
+	}
+	return 0
+}
@@ -0,0 +1,41 @@
+// ABOUTME: Tests for GenericTotalPagesExtractor
+// ABOUTME: Covers page indicators, rel=last links and pages without pagination
+
+package generic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericTotalPagesExtractor(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected int
+	}{
+		{"page of total", `<div class="pager">Page 1 of 4</div>`, 4},
+		{"page slash total", `<span>page 2/3</span>`, 3},
+		{"rel last link", `<link rel="last" href="/story/page/5">`, 5},
+		{"rel last anchor", `<a rel="last" href="/story?page=7">Last</a>`, 7},
+		{"rel last absolute", `<a rel="last" href="http://10.0.0.1/story?page=7">Last</a>`, 7},
+		{"rel last wins over indicator", `<a rel="last" href="/story?p=9">Last</a><p>Page 1 of 2</p>`, 9},
+		{"current past total ignored", `<p>Page 5 of 3</p>`, 0},
+		{"no pagination", `<p>This page has a single article.</p>`, 0},
+	}
+
+	extractor := &GenericTotalPagesExtractor{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader("<html><body>" + tt.html + "</body></html>"))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+			if got := extractor.Extract(doc.Selection); got != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// ABOUTME: GenericVideoExtractor lists the videos embedded in article content
+// ABOUTME: Reads kept iframes and native <video> sources, normalizing YouTube/Vimeo embeds to watch URLs
+
+package generic
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/BumpyClock/hermes/internal/utils/dom"
+	"github.com/PuerkitoBio/goquery"
+)
+
+// GenericVideoExtractor extracts embedded video URLs
+type GenericVideoExtractor struct{}
+
+// Extract returns absolute, deduplicated video URLs in document order.
+// YouTube and Vimeo embeds are normalized to their canonical watch URLs.
+func (extractor *GenericVideoExtractor) Extract(selection *goquery.Selection, pageURL string) []string {
+	base, _ := url.Parse(pageURL)
+
+	var videos []string
+	seen := make(map[string]bool)
+	add := func(raw string) {
+		videoURL := resolveMediaURL(raw, base)
+		if videoURL == "" || seen[videoURL] {
+			return
+		}
+		seen[videoURL] = true
+		videos = append(videos, videoURL)
+	}
+
+	selection.Find("iframe, video, video source").Each(func(i int, s *goquery.Selection) {
+		src := strings.TrimSpace(s.AttrOr("src", s.AttrOr("data-src", "")))
+		if src == "" {
+			return
+		}
+
+		if goquery.NodeName(s) != "iframe" {
+			add(src)
+			return
+		}
+
+		// Only video players count, other kept iframes are resolved as-is
+		if canonical := CanonicalVideoURL(src); canonical != "" {
+			add(canonical)
+		} else if s.HasClass(dom.KEEP_CLASS) {
+			add(src)
+		}
+	})
+
+	return videos
+}
+
+// resolveMediaURL makes a video or audio URL absolute, dropping non-HTTP schemes such as blob: and data:
+func resolveMediaURL(raw string, base *url.URL) string {
+	if raw == "" {
+		return ""
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	if base != nil {
+		parsed = base.ResolveReference(parsed)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return ""
+	}
+	return parsed.String()
+}
+
+// CanonicalVideoURL converts a YouTube or Vimeo embed or share URL to its watch URL.
+// Returns "" for URLs that are not recognized video player URLs.
+func CanonicalVideoURL(raw string) string {
+	if strings.HasPrefix(raw, "//") {
+		raw = "https:" + raw
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+	host = strings.TrimPrefix(host, "m.")
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+
+	switch host {
+	case "youtube.com", "youtube-nocookie.com":
+		if len(segments) >= 2 {
+			switch segments[0] {
+			case "embed", "v", "shorts", "live":
+				// Playlist embeds carry the list in the query instead of a video id
+				if segments[1] == "videoseries" {
+					if list := parsed.Query().Get("list"); list != "" {
+						return "https://www.youtube.com/playlist?list=" + url.QueryEscape(list)
+					}
+					return ""
+				}
+				return youTubeWatchURL(segments[1])
+			}
+		}
+		if segments[0] == "watch" {
+			return youTubeWatchURL(parsed.Query().Get("v"))
+		}
+	case "youtu.be":
+		return youTubeWatchURL(segments[0])
+	case "player.vimeo.com":
+		if len(segments) >= 2 && segments[0] == "video" && isDigits(segments[1]) {
+			// Unlisted videos need their privacy hash to be playable
+			if hash := parsed.Query().Get("h"); hash != "" {
+				return "https://vimeo.com/" + segments[1] + "/" + url.PathEscape(hash)
+			}
+			return "https://vimeo.com/" + segments[1]
+		}
+	case "vimeo.com":
+		if len(segments) >= 1 && isDigits(segments[0]) {
+			return "https://vimeo.com/" + strings.Join(segments, "/")
+		}
+	}
+
+	return ""
+}
+
+// youTubeWatchURL builds a watch URL, rejecting ids with unexpected characters
+func youTubeWatchURL(id string) string {
+	if id == "" {
+		return ""
+	}
+	for _, r := range id {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return ""
+		}
+	}
+	return "https://www.youtube.com/watch?v=" + id
+}
+
+// isDigits reports whether s is a non-empty run of ASCII digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
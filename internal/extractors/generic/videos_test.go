@@ -0,0 +1,103 @@
+// ABOUTME: Test suite for embedded video extraction from iframes and native video elements
+// ABOUTME: Verifies YouTube/Vimeo normalization, absolute URL resolution and deduplication
+
+package generic
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestGenericVideoExtractor_Extract(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected []string
+	}{
+		{
+			name:     "YouTube embed",
+			html:     `<div><iframe src="https://www.youtube.com/embed/dQw4w9WgXcQ?rel=0" width="560" height="315"></iframe></div>`,
+			expected: []string{"https://www.youtube.com/watch?v=dQw4w9WgXcQ"},
+		},
+		{
+			name:     "Vimeo embed",
+			html:     `<div><iframe src="https://player.vimeo.com/video/76979871?color=ffffff"></iframe></div>`,
+			expected: []string{"https://vimeo.com/76979871"},
+		},
+		{
+			name: "native video with sources",
+			html: `<div><video controls poster="/poster.jpg">
+				<source src="/media/clip.webm" type="video/webm">
+				<source src="https://cdn.example.com/clip.mp4" type="video/mp4">
+			</video></div>`,
+			expected: []string{"https://example.com/media/clip.webm", "https://cdn.example.com/clip.mp4"},
+		},
+		{
+			name:     "native video with src attribute",
+			html:     `<video src="clip.mp4"></video>`,
+			expected: []string{"https://example.com/articles/clip.mp4"},
+		},
+		{
+			name: "deduplicates embeds of the same video",
+			html: `<div>
+				<iframe src="//www.youtube-nocookie.com/embed/dQw4w9WgXcQ"></iframe>
+				<iframe src="https://www.youtube.com/embed/dQw4w9WgXcQ?autoplay=1"></iframe>
+			</div>`,
+			expected: []string{"https://www.youtube.com/watch?v=dQw4w9WgXcQ"},
+		},
+		{
+			name:     "ignores non-video iframes",
+			html:     `<div><iframe src="https://ads.example.net/banner"></iframe></div>`,
+			expected: nil,
+		},
+		{
+			name:     "keeps other marked iframes as-is",
+			html:     `<div><iframe class="hermes-parser-keep" src="https://www.redditmedia.com/r/test/comments/abc"></iframe></div>`,
+			expected: []string{"https://www.redditmedia.com/r/test/comments/abc"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			if err != nil {
+				t.Fatalf("Failed to parse HTML: %v", err)
+			}
+
+			extractor := &GenericVideoExtractor{}
+			result := extractor.Extract(doc.Selection, "https://example.com/articles/story")
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCanonicalVideoURL(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"https://www.youtube.com/embed/abc123XYZ_-", "https://www.youtube.com/watch?v=abc123XYZ_-"},
+		{"https://youtu.be/abc123", "https://www.youtube.com/watch?v=abc123"},
+		{"https://m.youtube.com/watch?v=abc123&t=30", "https://www.youtube.com/watch?v=abc123"},
+		{"https://www.youtube.com/shorts/abc123", "https://www.youtube.com/watch?v=abc123"},
+		{"https://www.youtube.com/embed/videoseries?list=PL123", "https://www.youtube.com/playlist?list=PL123"},
+		{"https://player.vimeo.com/video/123456?h=deadbeef", "https://vimeo.com/123456/deadbeef"},
+		{"https://vimeo.com/123456", "https://vimeo.com/123456"},
+		{"https://vimeo.com/channels/staffpicks", ""},
+		{"https://example.com/embed/abc", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := CanonicalVideoURL(tt.input); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
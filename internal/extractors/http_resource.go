@@ -0,0 +1,96 @@
+// ABOUTME: HTTPResource fetches follow-on pages for CollectAllPages over the network
+// ABOUTME: Uses the same validation, HTTP client and document preparation as a regular parse
+
+package extractors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/BumpyClock/hermes/internal/resource"
+	"github.com/BumpyClock/hermes/internal/validation"
+	"github.com/PuerkitoBio/goquery"
+)
+
+// HTTPResource implements ResourceInterface and ContextResourceInterface by
+// fetching pages the way a parse fetches its target URL: each URL is checked
+// against the SSRF rules in Validation, then requested with Client, which
+// applies its timeouts and follows redirects once each hop passes the same
+// rules, and the body is decompressed,
+// decoded from its charset and prepared as a document.
+type HTTPResource struct {
+	Client     *resource.HTTPClient         // nil uses resource.CreateDefaultHTTPClient
+	Headers    map[string]string            // Sent with every page, overridden by per-call headers
+	Validation validation.ValidationOptions // Rules every page URL must pass before it is requested
+}
+
+// NewHTTPResource creates an HTTPResource that requests pages with client,
+// or a default client when nil, refusing URLs that fail opts. Every redirect
+// hop is validated before it is followed. A caller-supplied client keeps its
+// own transport, so unless it dials with validation.PinnedDialContext the
+// address checked is not guaranteed to be the one connected to, and a host
+// that changes its DNS answer between the two (DNS rebinding) is not caught.
+func NewHTTPResource(client *http.Client, headers map[string]string, opts validation.ValidationOptions) *HTTPResource {
+	r := &HTTPResource{
+		Headers:    headers,
+		Validation: opts,
+	}
+	if client != nil {
+		r.Client = &resource.HTTPClient{Client: client, Headers: headers}
+	}
+	return r
+}
+
+// Create fetches rawURL, as CreateWithContext does with a background context
+func (r *HTTPResource) Create(rawURL string, preparedResponse string, parsedURL interface{}, headers map[string]string) (*goquery.Document, error) {
+	return r.CreateWithContext(context.Background(), rawURL, preparedResponse, parsedURL, headers)
+}
+
+// CreateWithContext fetches rawURL and returns the prepared document. A
+// non-empty preparedResponse is used as the page body without a request.
+// parsedURL may be the *url.URL for rawURL or nil.
+func (r *HTTPResource) CreateWithContext(ctx context.Context, rawURL string, preparedResponse string, parsedURL interface{}, headers map[string]string) (*goquery.Document, error) {
+	parsed, ok := parsedURL.(*url.URL)
+	if !ok || parsed == nil {
+		var err error
+		if parsed, err = url.Parse(rawURL); err != nil {
+			return nil, fmt.Errorf("invalid page URL %q: %w", rawURL, err)
+		}
+	}
+
+	if preparedResponse == "" {
+		if err := validation.ValidateURL(ctx, rawURL, r.Validation); err != nil {
+			return nil, fmt.Errorf("URL validation failed: %w", err)
+		}
+		// The redirect check, and a pinned dialer if the transport has one, read the rules from the context
+		ctx = validation.ContextWithOptions(ctx, r.Validation)
+	}
+
+	var client resource.HTTPClient
+	if r.Client != nil {
+		client = *r.Client
+	} else {
+		client = *resource.CreateDefaultHTTPClient()
+		client.Headers = r.Headers
+	}
+	client.Client = validation.GuardRedirects(client.Client)
+
+	return resource.NewResource().CreateWithClient(ctx, rawURL, preparedResponse, parsed, mergeResourceHeaders(r.Headers, headers), &client)
+}
+
+// mergeResourceHeaders layers per-call headers over the resource's own
+func mergeResourceHeaders(base, overrides map[string]string) map[string]string {
+	if len(overrides) == 0 {
+		return base
+	}
+	merged := make(map[string]string, len(base)+len(overrides))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range overrides {
+		merged[key] = value
+	}
+	return merged
+}
@@ -0,0 +1,148 @@
+// ABOUTME: Integration tests for collecting multi-page articles over HTTP with HTTPResource
+// ABOUTME: Serves paginated pages from an httptest server and checks SSRF rules apply to every page
+
+package extractors
+
+import (
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/BumpyClock/hermes/internal/validation"
+)
+
+// paginatedPage builds an article page long enough for generic content
+// extraction, linking to next when it is not empty
+func paginatedPage(body, next string) string {
+	link := ""
+	if next != "" {
+		link = `<a href="` + next + `" rel="next">Next Page</a>`
+	}
+	return `<html><head><title>Multi-Page Article</title></head><body><article>
+<p>` + body + `, and the council has promised to publish the full figures, ward by ward, before the next meeting.</p>
+<p>Residents who attended the consultation said the plans had changed considerably since the first draft, though many questions remain.</p>
+</article>` + link + `</body></html>`
+}
+
+// newPaginatedServer serves pages 2 and 3 of an article, gzip-compressing
+// the last, and counts page requests
+func newPaginatedServer(t *testing.T, requests *int32) *httptest.Server {
+	t.Helper()
+	pages := map[string]string{
+		"/page-2": paginatedPage("This is the second page of the multi-page article", "/page-3"),
+		"/page-3": paginatedPage("This is the final page of the multi-page article", ""),
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		html, ok := pages[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		atomic.AddInt32(requests, 1)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if r.URL.Path == "/page-3" && strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			w.Header().Set("Content-Encoding", "gzip")
+			gz := gzip.NewWriter(w)
+			defer gz.Close()
+			gz.Write([]byte(html))
+			return
+		}
+		w.Write([]byte(html))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func collectFromServer(t *testing.T, server *httptest.Server, opts validation.ValidationOptions) map[string]interface{} {
+	t.Helper()
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(firstPageHTML))
+	require.NoError(t, err)
+
+	return CollectAllPages(CollectAllPagesOptions{
+		NextPageURL: server.URL + "/page-2",
+		HTML:        firstPageHTML,
+		Doc:         doc,
+		MetaCache:   map[string]interface{}{},
+		Result: map[string]interface{}{
+			"title":   "Multi-Page Article",
+			"content": "<p>This is the first page of the multi-page article.</p>",
+		},
+		Extractor:     map[string]interface{}{"domain": "*"},
+		Title:         "Multi-Page Article",
+		URL:           server.URL + "/page-1",
+		Resource:      NewHTTPResource(server.Client(), nil, opts),
+		RootExtractor: &RootExtractorInterface{},
+	})
+}
+
+func TestHTTPResource_CollectAllPages(t *testing.T) {
+	var requests int32
+	server := newPaginatedServer(t, &requests)
+
+	opts := validation.DefaultValidationOptions()
+	opts.AllowPrivateNetworks = true
+	opts.AllowLocalhost = true
+	result := collectFromServer(t, server, opts)
+
+	assert.Equal(t, 3, result["total_pages"])
+	assert.Equal(t, 3, result["rendered_pages"])
+	content := result["content"].(string)
+	assert.Contains(t, content, "<hr><h4>Page 2</h4>")
+	assert.Contains(t, content, "This is the second page of the multi-page article")
+	assert.Contains(t, content, "<hr><h4>Page 3</h4>")
+	assert.Contains(t, content, "This is the final page of the multi-page article")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestHTTPResource_RefusesPrivateNetworks(t *testing.T) {
+	var requests int32
+	server := newPaginatedServer(t, &requests)
+
+	result := collectFromServer(t, server, validation.DefaultValidationOptions())
+
+	assert.Equal(t, 1, result["rendered_pages"])
+	assert.NotContains(t, result["content"].(string), "second page")
+	assert.Equal(t, int32(0), atomic.LoadInt32(&requests), "Expected no request to a refused URL")
+}
+
+func TestHTTPResource_PreparedResponse(t *testing.T) {
+	resource := NewHTTPResource(nil, nil, validation.DefaultValidationOptions())
+
+	doc, err := resource.Create("http://127.0.0.1/prepared", paginatedPage("Prepared page", ""), nil, nil)
+	require.NoError(t, err)
+	assert.Contains(t, doc.Find("article").Text(), "Prepared page")
+}
+
+func TestHTTPResource_RefusesDeniedRedirects(t *testing.T) {
+	var targetHit int32
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.StoreInt32(&targetHit, 1)
+	}))
+	defer target.Close()
+
+	deniedURL := strings.Replace(target.URL, "127.0.0.1", "localhost", 1) + "/admin"
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, deniedURL, http.StatusFound)
+	}))
+	defer origin.Close()
+
+	opts := validation.DefaultValidationOptions()
+	opts.AllowPrivateNetworks = true
+	opts.AllowLocalhost = true
+	opts.DenyHosts = []string{"localhost"}
+
+	for name, client := range map[string]*http.Client{"default client": nil, "supplied client": origin.Client()} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewHTTPResource(client, nil, opts).Create(origin.URL+"/page/2", "", nil, nil)
+			assert.Error(t, err)
+			assert.Equal(t, int32(0), atomic.LoadInt32(&targetHit), "Expected the denied redirect target never to be requested")
+		})
+	}
+}
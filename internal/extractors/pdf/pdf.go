@@ -0,0 +1,576 @@
+// ABOUTME: Minimal PDF text extractor reading the document info title and text drawn by content streams
+// ABOUTME: Handles uncompressed and FlateDecode streams and object streams; encrypted or unreadable documents return ErrUnsupported
+
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// ErrUnsupported is returned for encrypted PDFs or PDFs without extractable text
+var ErrUnsupported = errors.New("unsupported PDF")
+
+// Maximum size of a single decompressed stream, guards against zip bombs
+const maxStreamSize = 20 * 1024 * 1024
+
+// Maximum size of all decompressed streams in one document, so many small
+// bombs cannot add up to the same damage as one large one
+const maxTotalDecodedSize = 64 * 1024 * 1024
+
+// Document is the text extracted from a PDF
+type Document struct {
+	Title      string
+	Author     string
+	Paragraphs []string
+}
+
+// Text returns the document body as plain text with blank lines between paragraphs
+func (d *Document) Text() string {
+	return strings.Join(d.Paragraphs, "\n\n")
+}
+
+var (
+	// Matches the start of an indirect object and captures its number
+	objectStartRE = regexp.MustCompile(`(\d+)\s+\d+\s+obj\b`)
+	// Matches the end of a stream dictionary and the stream keyword
+	streamKeywordRE = regexp.MustCompile(`>>\s*stream\r?\n`)
+	// Matches /Title and /Author entries in the document info dictionary
+	infoTitleRE  = regexp.MustCompile(`/Title\s*(\(|<)`)
+	infoAuthorRE = regexp.MustCompile(`/Author\s*(\(|<)`)
+	// Matches the trailer /Encrypt entry
+	encryptRE = regexp.MustCompile(`/Encrypt\s+\d+\s+\d+\s+R`)
+	// Matches compressed object streams and their header entries
+	objStmRE      = regexp.MustCompile(`/Type\s*/ObjStm\b`)
+	objStmNRE     = regexp.MustCompile(`/N\s+(\d+)`)
+	objStmFirstRE = regexp.MustCompile(`/First\s+(\d+)`)
+	// Matches font dictionaries and the font entries of a resource dictionary
+	fontTypeRE    = regexp.MustCompile(`/Type\s*/Font\b`)
+	fontDictRE    = regexp.MustCompile(`/Font\s*<<([^>]*)>>`)
+	fontRefRE     = regexp.MustCompile(`/Font\s+(\d+)\s+\d+\s+R`)
+	fontEntryRE   = regexp.MustCompile(`/([^\s/<>\[\]()]+)\s+(\d+)\s+\d+\s+R`)
+	undecodableRE = regexp.MustCompile(`/Subtype\s*/Type0\b|/Identity-[HV]\b`)
+)
+
+// object is an indirect object: its dictionary or value, and its raw stream data if any
+type object struct {
+	num    int
+	body   []byte
+	stream []byte
+}
+
+// IsPDF checks the %PDF- file header
+func IsPDF(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimLeft(data, "\x00\t\r\n "), []byte("%PDF-"))
+}
+
+// Extract extracts the title and text of a PDF document
+func Extract(data []byte) (*Document, error) {
+	if !IsPDF(data) {
+		return nil, fmt.Errorf("%w: missing PDF header", ErrUnsupported)
+	}
+	if encryptRE.Match(data) {
+		return nil, fmt.Errorf("%w: document is encrypted", ErrUnsupported)
+	}
+
+	objects := scanObjects(data)
+	var contents, objectStreams [][]byte
+	var total int
+
+	for i := 0; i < len(objects); i++ {
+		obj := objects[i]
+		// Images and fonts never contain page text
+		if obj.stream == nil || bytes.Contains(obj.body, []byte("/Image")) || bytes.Contains(obj.body, []byte("/FontFile")) {
+			continue
+		}
+
+		limit := maxStreamSize
+		if remaining := maxTotalDecodedSize - total; remaining < limit {
+			limit = remaining
+		}
+		stream, ok := decodeStream(string(obj.body), obj.stream, int64(limit)+1)
+		if !ok {
+			continue
+		}
+		total += len(stream)
+		if total > maxTotalDecodedSize {
+			return nil, fmt.Errorf("%w: decompressed content exceeds %d bytes", ErrUnsupported, maxTotalDecodedSize)
+		}
+
+		// Objects packed in an object stream join the scan, so their fonts are found too
+		if objStmRE.Match(obj.body) {
+			objectStreams = append(objectStreams, stream)
+			objects = append(objects, unpackObjectStream(obj.body, stream)...)
+			continue
+		}
+		contents = append(contents, stream)
+	}
+
+	doc := &Document{}
+	// The info dictionary may live in the file body or inside a compressed object stream
+	for _, source := range append([][]byte{data}, objectStreams...) {
+		if doc.Title == "" {
+			doc.Title = findInfoString(source, infoTitleRE)
+		}
+		if doc.Author == "" {
+			doc.Author = findInfoString(source, infoAuthorRE)
+		}
+	}
+
+	skipFonts := undecodableFonts(objects)
+	for _, stream := range contents {
+		if !bytes.Contains(stream, []byte("BT")) {
+			continue
+		}
+		doc.Paragraphs = append(doc.Paragraphs, extractText(stream, skipFonts)...)
+	}
+
+	if len(doc.Paragraphs) == 0 {
+		return nil, fmt.Errorf("%w: no extractable text", ErrUnsupported)
+	}
+
+	if doc.Title == "" && len(doc.Paragraphs[0]) <= 200 {
+		doc.Title = doc.Paragraphs[0]
+	}
+
+	return doc, nil
+}
+
+// scanObjects splits the file into indirect objects in a single pass. Stream
+// data is skipped as a whole so bytes inside it are never taken for objects.
+func scanObjects(data []byte) []object {
+	var objects []object
+	for pos := 0; pos < len(data); {
+		loc := objectStartRE.FindSubmatchIndex(data[pos:])
+		if loc == nil {
+			break
+		}
+		num, _ := strconv.Atoi(string(data[pos+loc[2] : pos+loc[3]]))
+		start := pos + loc[1]
+		rest := data[start:]
+
+		end := bytes.Index(rest, []byte("endobj"))
+		if end < 0 {
+			end = len(rest)
+		}
+		pos = start + end
+
+		// The stream keyword always comes before the first endobj, even one
+		// that happens to appear inside the stream data
+		keyword := streamKeywordRE.FindIndex(rest[:end])
+		if keyword == nil {
+			objects = append(objects, object{num: num, body: rest[:end]})
+			continue
+		}
+		raw := rest[keyword[1]:]
+		streamEnd := bytes.Index(raw, []byte("endstream"))
+		if streamEnd < 0 {
+			continue
+		}
+		objects = append(objects, object{num: num, body: rest[:keyword[0]], stream: raw[:streamEnd]})
+		pos = start + keyword[1] + streamEnd + len("endstream")
+	}
+	return objects
+}
+
+// unpackObjectStream returns the objects stored in a decoded /ObjStm stream,
+// whose header lists object numbers and offsets relative to /First
+func unpackObjectStream(dict []byte, stream []byte) []object {
+	n := objStmNRE.FindSubmatch(dict)
+	first := objStmFirstRE.FindSubmatch(dict)
+	if n == nil || first == nil {
+		return nil
+	}
+	count, _ := strconv.Atoi(string(n[1]))
+	base, _ := strconv.Atoi(string(first[1]))
+	if base > len(stream) {
+		return nil
+	}
+
+	header := strings.Fields(string(stream[:base]))
+	var objects []object
+	for i := 0; i < count && 2*i+1 < len(header); i++ {
+		num, err1 := strconv.Atoi(header[2*i])
+		offset, err2 := strconv.Atoi(header[2*i+1])
+		if err1 != nil || err2 != nil || base+offset > len(stream) {
+			break
+		}
+		end := len(stream)
+		if 2*i+3 < len(header) {
+			if next, err := strconv.Atoi(header[2*i+3]); err == nil && base+next >= base+offset && base+next <= len(stream) {
+				end = base + next
+			}
+		}
+		objects = append(objects, object{num: num, body: stream[base+offset : end]})
+	}
+	return objects
+}
+
+// undecodableFonts returns the resource names of fonts whose string bytes are
+// not character codes this extractor can read: composite (Type0/CID) fonts,
+// Identity encodings, and fonts that rely on a ToUnicode map with no standard
+// encoding. Text drawn with them is dropped rather than emitted as raw bytes.
+// A name used for several fonts is skipped if any of them is undecodable.
+func undecodableFonts(objects []object) map[string]bool {
+	bodies := make(map[int][]byte, len(objects))
+	undecodable := make(map[int]bool)
+	for _, obj := range objects {
+		bodies[obj.num] = obj.body
+		if fontTypeRE.Match(obj.body) {
+			undecodable[obj.num] = undecodableRE.Match(obj.body) ||
+				(bytes.Contains(obj.body, []byte("/ToUnicode")) && !bytes.Contains(obj.body, []byte("/Encoding")))
+		}
+	}
+
+	skip := make(map[string]bool)
+	addEntries := func(entries []byte) {
+		for _, entry := range fontEntryRE.FindAllSubmatch(entries, -1) {
+			num, _ := strconv.Atoi(string(entry[2]))
+			if undecodable[num] {
+				skip[string(entry[1])] = true
+			}
+		}
+	}
+	for _, obj := range objects {
+		for _, dict := range fontDictRE.FindAllSubmatch(obj.body, -1) {
+			addEntries(dict[1])
+		}
+		// Resource dictionaries may point at a separate font dictionary object
+		for _, ref := range fontRefRE.FindAllSubmatch(obj.body, -1) {
+			num, _ := strconv.Atoi(string(ref[1]))
+			addEntries(bodies[num])
+		}
+	}
+	return skip
+}
+
+// decodeStream applies the stream filter, only FlateDecode and unfiltered
+// streams are supported. At most limit bytes are decompressed.
+func decodeStream(dict string, raw []byte, limit int64) ([]byte, bool) {
+	if !strings.Contains(dict, "/Filter") {
+		return raw, true
+	}
+	if !strings.Contains(dict, "/FlateDecode") || strings.Contains(dict, "/DecodeParms") {
+		return nil, false
+	}
+
+	reader, err := zlib.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, false
+	}
+	defer reader.Close()
+
+	// Truncated streams are common, keep whatever decompressed cleanly
+	out, err := io.ReadAll(io.LimitReader(reader, limit))
+	if err != nil && len(out) == 0 {
+		return nil, false
+	}
+	return out, true
+}
+
+// findInfoString reads the string value following an info dictionary key
+func findInfoString(data []byte, keyRE *regexp.Regexp) string {
+	loc := keyRE.FindSubmatchIndex(data)
+	if loc == nil {
+		return ""
+	}
+
+	lex := &lexer{data: data, pos: loc[2]}
+	var value []byte
+	if data[loc[2]] == '(' {
+		value = lex.literalString()
+	} else {
+		value = lex.hexString()
+	}
+	return strings.TrimSpace(decodeTextString(value))
+}
+
+// decodeTextString decodes a PDF text string, which is UTF-16BE when it starts with a BOM
+func decodeTextString(value []byte) string {
+	if len(value) >= 2 && value[0] == 0xFE && value[1] == 0xFF {
+		units := make([]uint16, 0, len(value)/2)
+		for i := 2; i+1 < len(value); i += 2 {
+			units = append(units, uint16(value[i])<<8|uint16(value[i+1]))
+		}
+		return string(utf16.Decode(units))
+	}
+
+	// PDFDocEncoding matches Latin-1 for printable characters
+	runes := make([]rune, 0, len(value))
+	for _, b := range value {
+		runes = append(runes, rune(b))
+	}
+	return string(runes)
+}
+
+// extractText runs the text operators of a content stream and returns its paragraphs.
+// Each BT/ET text object becomes a paragraph, and line moves within it become spaces.
+// Strings shown with a font named in skipFonts are left out.
+func extractText(stream []byte, skipFonts map[string]bool) []string {
+	var paragraphs []string
+	var current strings.Builder
+	var operands [][]byte
+	var name string
+	skipping := false
+
+	flush := func() {
+		if text := strings.Join(strings.Fields(current.String()), " "); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+		current.Reset()
+	}
+
+	lex := &lexer{data: stream}
+	for {
+		token, kind := lex.next()
+		if kind == tokenEOF {
+			break
+		}
+
+		switch kind {
+		case tokenString:
+			operands = append(operands, token)
+		case tokenArray:
+			operands = append(operands, token)
+		case tokenName:
+			name = string(token)
+		case tokenOperator:
+			switch string(token) {
+			case "Tf":
+				skipping = skipFonts[name]
+			case "Tj", "'", "\"", "TJ":
+				if string(token) != "Tj" && string(token) != "TJ" {
+					current.WriteByte(' ')
+				}
+				if skipping {
+					break
+				}
+				for _, operand := range operands {
+					current.WriteString(decodeTextString(operand))
+				}
+			case "Td", "TD", "T*", "Tm":
+				current.WriteByte(' ')
+			case "ET":
+				flush()
+			}
+			operands = operands[:0]
+			name = ""
+		}
+	}
+	flush()
+
+	return paragraphs
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenString
+	tokenArray
+	tokenName
+	tokenOperator
+	tokenOther
+)
+
+// lexer is a small tokenizer for PDF content streams
+type lexer struct {
+	data []byte
+	pos  int
+}
+
+// next returns the next token; arrays are flattened to their concatenated strings
+func (l *lexer) next() ([]byte, tokenKind) {
+	l.skipSpace()
+	if l.pos >= len(l.data) {
+		return nil, tokenEOF
+	}
+
+	c := l.data[l.pos]
+	switch {
+	case c == '(':
+		return l.literalString(), tokenString
+	case c == '<' && l.pos+1 < len(l.data) && l.data[l.pos+1] == '<':
+		l.pos += 2
+		return nil, tokenOther
+	case c == '>' && l.pos+1 < len(l.data) && l.data[l.pos+1] == '>':
+		l.pos += 2
+		return nil, tokenOther
+	case c == '<':
+		return l.hexString(), tokenString
+	case c == '[':
+		return l.array(), tokenArray
+	case c == '/':
+		l.pos++
+		return l.word(), tokenName
+	case c == '%':
+		for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+			l.pos++
+		}
+		return nil, tokenOther
+	case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+		l.word()
+		return nil, tokenOther
+	case isDelimiter(c):
+		l.pos++
+		return nil, tokenOther
+	default:
+		return l.word(), tokenOperator
+	}
+}
+
+// array reads a TJ array, keeping strings and turning large kerning gaps into spaces
+func (l *lexer) array() []byte {
+	l.pos++ // skip [
+	var out []byte
+	for l.pos < len(l.data) {
+		l.skipSpace()
+		if l.pos >= len(l.data) {
+			break
+		}
+		c := l.data[l.pos]
+		switch {
+		case c == ']':
+			l.pos++
+			return out
+		case c == '(':
+			out = append(out, l.literalString()...)
+		case c == '<':
+			out = append(out, l.hexString()...)
+		case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+			// Offsets of 100 or more thousandths of an em are word gaps
+			if offset, err := strconv.ParseFloat(string(l.word()), 64); err == nil && offset <= -100 {
+				out = append(out, ' ')
+			}
+		default:
+			l.pos++
+		}
+	}
+	return out
+}
+
+// literalString reads a (...) string, handling nesting and escapes
+func (l *lexer) literalString() []byte {
+	l.pos++ // skip (
+	depth := 1
+	var out []byte
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '\\':
+			if l.pos >= len(l.data) {
+				return out
+			}
+			e := l.data[l.pos]
+			l.pos++
+			switch e {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b':
+				out = append(out, '\b')
+			case 'f':
+				out = append(out, '\f')
+			case '\r', '\n':
+				// Line continuation
+			default:
+				if e >= '0' && e <= '7' {
+					value := int(e - '0')
+					for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						value = value*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					out = append(out, byte(value))
+				} else {
+					out = append(out, e)
+				}
+			}
+		case '(':
+			depth++
+			out = append(out, c)
+		case ')':
+			depth--
+			if depth == 0 {
+				return out
+			}
+			out = append(out, c)
+		default:
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// hexString reads a <...> string
+func (l *lexer) hexString() []byte {
+	l.pos++ // skip <
+	var digits []byte
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		if c := l.data[l.pos]; isHexDigit(c) {
+			digits = append(digits, c)
+		}
+		l.pos++
+	}
+	l.pos++ // skip >
+
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	out := make([]byte, len(digits)/2)
+	for i := range out {
+		out[i] = hexValue(digits[2*i])<<4 | hexValue(digits[2*i+1])
+	}
+	return out
+}
+
+// word reads a run of regular characters
+func (l *lexer) word() []byte {
+	start := l.pos
+	for l.pos < len(l.data) && !isSpace(l.data[l.pos]) && !isDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	if l.pos == start {
+		l.pos++
+	}
+	return l.data[start:l.pos]
+}
+
+func (l *lexer) skipSpace() {
+	for l.pos < len(l.data) && isSpace(l.data[l.pos]) {
+		l.pos++
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' || c == 0
+}
+
+func isDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func hexValue(c byte) byte {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0'
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
@@ -0,0 +1,149 @@
+// ABOUTME: Tests for PDF text extraction using the sample fixture and hand-built documents
+// ABOUTME: Covers info dictionary titles, text operators, undecodable fonts, encrypted, oversized and unreadable PDFs
+
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestExtractFixture(t *testing.T) {
+	data, err := os.ReadFile("../../fixtures/sample.pdf")
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+
+	doc, err := Extract(data)
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	if doc.Title != "Quarterly Report Q3" {
+		t.Errorf("Expected title from info dictionary, got %q", doc.Title)
+	}
+	if doc.Author != "Finance Team" {
+		t.Errorf("Expected author from info dictionary, got %q", doc.Author)
+	}
+
+	expected := []string{
+		"Quarterly Report",
+		"Revenue grew steadily across all regions during the quarter, driven by strong demand for the new product line and improved retention.",
+		"Operating costs remained flat while the team invested in (modest) infrastructure upgrades.",
+	}
+	if len(doc.Paragraphs) != len(expected) {
+		t.Fatalf("Expected %d paragraphs, got %d: %q", len(expected), len(doc.Paragraphs), doc.Paragraphs)
+	}
+	for i, paragraph := range expected {
+		if doc.Paragraphs[i] != paragraph {
+			t.Errorf("Paragraph %d: expected %q, got %q", i, paragraph, doc.Paragraphs[i])
+		}
+	}
+}
+
+func TestExtractErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "not a PDF",
+			data: "<html><body>Not a PDF</body></html>",
+		},
+		{
+			name: "encrypted PDF",
+			data: "%PDF-1.6\n1 0 obj\n<< /Length 20 >>\nstream\nBT (Secret) Tj ET\nendstream\nendobj\ntrailer\n<< /Root 2 0 R /Encrypt 3 0 R >>\n%%EOF",
+		},
+		{
+			name: "no text content",
+			data: "%PDF-1.4\n1 0 obj\n<< /Type /Catalog >>\nendobj\ntrailer\n<< /Root 1 0 R >>\n%%EOF",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Extract([]byte(tt.data))
+			if !errors.Is(err, ErrUnsupported) {
+				t.Errorf("Expected ErrUnsupported, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDecodeTextString(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    []byte
+		expected string
+	}{
+		{"PDFDocEncoding", []byte("Caf\xe9"), "Café"},
+		{"UTF-16BE with BOM", []byte("\xfe\xff\x00H\x00i\x20\x14"), "Hi—"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := decodeTextString(tt.value); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestExtractTextUncompressed(t *testing.T) {
+	data := "%PDF-1.4\n1 0 obj\n<< /Length 44 >>\nstream\nBT /F1 12 Tf <48656C6C6F> Tj ( world) Tj ET\nendstream\nendobj\n%%EOF"
+
+	doc, err := Extract([]byte(data))
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+	if got := doc.Text(); !strings.Contains(got, "Hello world") {
+		t.Errorf("Expected hex and literal strings to be decoded, got %q", got)
+	}
+	if doc.Title != "Hello world" {
+		t.Errorf("Expected first paragraph as fallback title, got %q", doc.Title)
+	}
+}
+
+func TestExtractSkipsUndecodableFonts(t *testing.T) {
+	content := "BT /F1 12 Tf (Readable text) Tj ET BT /F2 12 Tf <00410042> Tj ET"
+	data := "%PDF-1.4\n" +
+		"1 0 obj\n<< /Type /Page /Resources << /Font << /F1 2 0 R /F2 3 0 R >> >> /Contents 4 0 R >>\nendobj\n" +
+		"2 0 obj\n<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>\nendobj\n" +
+		"3 0 obj\n<< /Type /Font /Subtype /Type0 /BaseFont /NotoSans /Encoding /Identity-H /ToUnicode 5 0 R >>\nendobj\n" +
+		fmt.Sprintf("4 0 obj\n<< /Length %d >>\nstream\n%s\nendstream\nendobj\n%%%%EOF", len(content), content)
+
+	doc, err := Extract([]byte(data))
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+	if len(doc.Paragraphs) != 1 || doc.Paragraphs[0] != "Readable text" {
+		t.Errorf("Expected only the text drawn with the simple font, got %q", doc.Paragraphs)
+	}
+}
+
+func TestExtractTotalDecompressedLimit(t *testing.T) {
+	// Each stream stays under the per-stream limit, together they exceed the total
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	zw.Write(make([]byte, maxStreamSize))
+	zw.Close()
+
+	var data bytes.Buffer
+	data.WriteString("%PDF-1.4\n")
+	for i := 1; i <= maxTotalDecodedSize/maxStreamSize+1; i++ {
+		fmt.Fprintf(&data, "%d 0 obj\n<< /Length %d /Filter /FlateDecode >>\nstream\n", i, compressed.Len())
+		data.Write(compressed.Bytes())
+		data.WriteString("\nendstream\nendobj\n")
+	}
+	data.WriteString("%%EOF")
+
+	_, err := Extract(data.Bytes())
+	if !errors.Is(err, ErrUnsupported) || !strings.Contains(err.Error(), "exceeds") {
+		t.Errorf("Expected ErrUnsupported for the decompressed size, got %v", err)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/PuerkitoBio/goquery"
 	"github.com/BumpyClock/hermes/internal/cleaners"
 	"github.com/BumpyClock/hermes/internal/extractors/generic"
+	"github.com/BumpyClock/hermes/internal/pools"
 	"github.com/BumpyClock/hermes/internal/utils/dom"
 )
 
@@ -52,8 +53,8 @@ func TransformElements(content *goquery.Selection, doc *goquery.Document, opts m
 		matches.Each(func(i int, node *goquery.Selection) {
 			switch v := value.(type) {
 			case string:
-				// If value is a string, convert directly
-				dom.ConvertNodeTo(node, v)
+				// If value is a string, apply the named transform or convert directly
+				applyStringTransform(node, doc, v)
 			case func(*goquery.Selection, *goquery.Document) string:
 				// If value is function, apply function to node
 				result := v(node, doc)
@@ -143,12 +144,12 @@ func selectHTML(matchingSelector interface{}, doc *goquery.Document, opts Select
 		content = doc.Find(selector)
 		
 		// Create wrapper div and append all matches
-		wrapperDoc, _ := goquery.NewDocumentFromReader(strings.NewReader("<div></div>"))
-		wrapper := wrapperDoc.Find("div").First()
+		wrapper := pools.GlobalWrapperPool.Get()
+		defer wrapper.Release()
 		content.Each(func(i int, el *goquery.Selection) {
-			wrapper.AppendSelection(el.Clone())
+			wrapper.Element.AppendSelection(el.Clone())
 		})
-		content = wrapper
+		content = wrapper.Element
 	case string:
 		content = doc.Find(sel)
 	}
@@ -159,10 +160,10 @@ func selectHTML(matchingSelector interface{}, doc *goquery.Document, opts Select
 
 	// Wrap in div so transformation can take place on root element
 	if content.Parent().Length() == 0 {
-		wrapperDoc, _ := goquery.NewDocumentFromReader(strings.NewReader("<div></div>"))
-		wrapper := wrapperDoc.Find("div").First()
-		wrapper.AppendSelection(content.Clone())
-		content = wrapper
+		wrapper := pools.GlobalWrapperPool.Get()
+		defer wrapper.Release()
+		wrapper.Element.AppendSelection(content.Clone())
+		content = wrapper.Element
 	}
 
 	// Apply transforms and cleaning
@@ -183,15 +184,15 @@ func selectHTML(matchingSelector interface{}, doc *goquery.Document, opts Select
 	if allowMultiple, ok := extractionOpts["allowMultiple"].(bool); ok && allowMultiple {
 		var results []string
 		content.Children().Each(func(i int, el *goquery.Selection) {
-			if html, err := el.Html(); err == nil {
+			if html, err := pools.InnerHTML(el); err == nil {
 				results = append(results, html)
 			}
 		})
 		return results
 	}
 
-	// Return HTML content
-	html, err := content.Html()
+	// Return HTML content, rendered before the wrapper goes back to the pool
+	html, err := pools.InnerHTML(content)
 	if err != nil {
 		return nil
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/PuerkitoBio/goquery"
 	"github.com/BumpyClock/hermes/internal/cleaners"
 	"github.com/BumpyClock/hermes/internal/extractors/generic"
+	"github.com/BumpyClock/hermes/internal/pools"
 	"github.com/BumpyClock/hermes/internal/utils/dom"
 )
 
@@ -59,8 +60,8 @@ func TransformElementsList(content *goquery.Selection, doc *goquery.Document, tr
 		matches.Each(func(i int, node *goquery.Selection) {
 			switch v := value.(type) {
 			case string:
-				// If value is a string, convert directly
-				dom.ConvertNodeTo(node, v)
+				// If value is a string, apply the named transform or convert directly
+				applyStringTransform(node, doc, v)
 			case func(*goquery.Selection, *goquery.Document) string:
 				// If value is function, apply function to node
 				result := v(node, doc)
@@ -306,12 +307,12 @@ func selectHTMLContent(matchingSelector interface{}, doc *goquery.Document, opts
 		content = doc.Find(selector)
 		
 		// Create wrapper div and append all matches
-		wrapperDoc, _ := goquery.NewDocumentFromReader(strings.NewReader("<div></div>"))
-		wrapper := wrapperDoc.Find("div").First()
+		wrapper := pools.GlobalWrapperPool.Get()
+		defer wrapper.Release()
 		content.Each(func(i int, el *goquery.Selection) {
-			wrapper.AppendSelection(el.Clone())
+			wrapper.Element.AppendSelection(el.Clone())
 		})
-		content = wrapper
+		content = wrapper.Element
 	case string:
 		content = doc.Find(sel)
 	}
@@ -338,15 +339,15 @@ func selectHTMLContent(matchingSelector interface{}, doc *goquery.Document, opts
 	if allowMultiple, ok := extractionOpts["allowMultiple"].(bool); ok && allowMultiple {
 		var results []string
 		content.Children().Each(func(i int, el *goquery.Selection) {
-			if html, err := el.Html(); err == nil {
+			if html, err := pools.InnerHTML(el); err == nil {
 				results = append(results, html)
 			}
 		})
 		return results
 	}
 
-	// Return HTML content
-	html, err := content.Html()
+	// Return HTML content, rendered before the wrapper goes back to the pool
+	html, err := pools.InnerHTML(content)
 	if err != nil {
 		return nil
 	}
@@ -0,0 +1,57 @@
+// ABOUTME: Registry of named content transforms shared across custom extractor configs
+// ABOUTME: Lets transform rules reference a registered TransformFunc by name instead of embedding the function
+
+package extractors
+
+import (
+	"sync"
+
+	"github.com/BumpyClock/hermes/internal/utils/dom"
+	"github.com/PuerkitoBio/goquery"
+)
+
+var (
+	namedTransforms      = make(map[string]TransformFunc)
+	namedTransformsMutex sync.RWMutex
+)
+
+// RegisterTransform registers fn under name so that extractor configs can apply it
+// by using the name as a transform value, e.g. {"figure": "figure-to-img"}.
+// A registered name takes precedence over the default tag-rename meaning of a
+// string value, so names should not collide with HTML tag names. Registering an
+// existing name replaces it; a nil fn removes it.
+func RegisterTransform(name string, fn TransformFunc) {
+	if name == "" {
+		return
+	}
+
+	namedTransformsMutex.Lock()
+	defer namedTransformsMutex.Unlock()
+
+	if fn == nil {
+		delete(namedTransforms, name)
+		return
+	}
+	namedTransforms[name] = fn
+}
+
+// lookupTransform returns the transform registered under name
+func lookupTransform(name string) (TransformFunc, bool) {
+	namedTransformsMutex.RLock()
+	defer namedTransformsMutex.RUnlock()
+
+	fn, ok := namedTransforms[name]
+	return fn, ok
+}
+
+// applyStringTransform applies the transform registered under value, or renames
+// the node to the tag value when no transform has that name
+func applyStringTransform(node *goquery.Selection, doc *goquery.Document, value string) {
+	if fn, ok := lookupTransform(value); ok {
+		if result, ok := fn(node, doc).(string); ok && result != "" {
+			dom.ConvertNodeTo(node, result)
+		}
+		return
+	}
+	dom.ConvertNodeTo(node, value)
+}
@@ -0,0 +1,68 @@
+// ABOUTME: Tests for the named transform registry
+// ABOUTME: Verifies configs can apply registered transforms by name and fall back to tag renames
+
+package extractors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const figureHTML = `
+	<html>
+		<body>
+			<figure class="media"><img src="/photo.jpg"><figcaption>Caption</figcaption></figure>
+			<h1>Heading</h1>
+		</body>
+	</html>`
+
+func TestRegisterTransform(t *testing.T) {
+	RegisterTransform("figure-to-img", func(node *goquery.Selection, doc *goquery.Document) interface{} {
+		if src, ok := node.Find("img").Attr("src"); ok {
+			node.ReplaceWithHtml(`<img src="` + src + `">`)
+		}
+		return nil
+	})
+	t.Cleanup(func() { RegisterTransform("figure-to-img", nil) })
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(figureHTML))
+	require.NoError(t, err)
+
+	content := doc.Find("body")
+	result := TransformElements(content, doc, map[string]map[string]interface{}{
+		"transforms": {
+			"figure": "figure-to-img",
+			"h1":     "h2",
+		},
+	})
+
+	assert.Equal(t, 0, result.Find("figure").Length())
+	src, ok := result.Find("img").Attr("src")
+	assert.True(t, ok)
+	assert.Equal(t, "/photo.jpg", src)
+
+	// Unregistered names keep their tag-rename meaning
+	assert.Equal(t, 0, result.Find("h1").Length())
+	assert.Equal(t, 1, result.Find("h2").Length())
+}
+
+func TestRegisterTransformReturnsTag(t *testing.T) {
+	RegisterTransform("caption-to-paragraph", func(node *goquery.Selection, doc *goquery.Document) interface{} {
+		return "p"
+	})
+	t.Cleanup(func() { RegisterTransform("caption-to-paragraph", nil) })
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(figureHTML))
+	require.NoError(t, err)
+
+	result := TransformElementsList(doc.Find("body"), doc, map[string]interface{}{
+		"figcaption": "caption-to-paragraph",
+	})
+
+	assert.Equal(t, 0, result.Find("figcaption").Length())
+	assert.Equal(t, "Caption", result.Find("figure p").Text())
+}
@@ -50,6 +50,23 @@ Chain multiple validators for complex validation:
 	
 	err := pipeline.Validate("https://example.com")
 
+Validators run in the order they were added. Validators lists them in that
+order, and RemoveValidator drops one without rebuilding the pipeline:
+
+	pipeline.RemoveValidator("length")
+	for _, v := range pipeline.Validators() {
+		fmt.Println(v.Name) // url
+	}
+
+# Reachability
+
+NewURLValidator checks format only. To also confirm a URL answers with a 2xx
+status, add the opt-in reachability validator, which makes a HEAD request
+(falling back to GET) per value and refuses private network addresses:
+
+	reachable := validation.NewReachabilityValidator(httpClient, 5*time.Second)
+	err := reachable.ValidateContext(ctx, result.LeadImageURL)
+
 # Field Registry
 
 Register custom field definitions dynamically:
@@ -69,6 +86,15 @@ Register custom field definitions dynamically:
 		log.Printf("Field registration failed: %v", err)
 	}
 
+Validate a whole struct against the registered fields, matched by json tag name:
+
+	pipeline := validation.NewValidationPipeline()
+	pipeline.SetErrorAggregation(true)
+
+	if err := pipeline.ValidateStruct(result); err != nil {
+		log.Printf("Result validation failed: %v", err)
+	}
+
 # Extended Field Types
 
 Work with specialized field types:
@@ -85,6 +111,11 @@ Work with specialized field types:
 	tags := tagsExtractor.Extract([]string{"Web Development", "Go Programming"})
 	normalizedTags := tags.([]string) // ["web-development", "go-programming"]
 
+	// Drop stop words for the article's language, English when none is listed
+	frenchTags := fields.NewTagsExtractorWithStopWords(map[string][]string{
+		"fr": {"le", "la", "les", "de", "et"},
+	}).Extract(map[string]interface{}{"tags": "la, politique", "language": "fr"})
+
 # Field Transformers
 
 Transform extracted data to standard formats:
@@ -5,6 +5,8 @@ package validation
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 	"sync"
 	"time"
 )
@@ -44,13 +46,54 @@ func NewValidationPipeline() *ValidationPipeline {
 	}
 }
 
-// AddValidator adds a validator to the pipeline
+// NamedValidator pairs a validator with the name it was added under
+type NamedValidator struct {
+	Name      string
+	Validator ValidatorInterface
+}
+
+// AddValidator adds a validator to the end of the pipeline. Adding a name
+// that is already present replaces that validator in its current position.
 func (vp *ValidationPipeline) AddValidator(name string, validator ValidatorInterface) {
 	vp.mu.Lock()
 	defer vp.mu.Unlock()
 	
+	if _, exists := vp.validators[name]; !exists {
+		vp.validatorOrder = append(vp.validatorOrder, name)
+	}
 	vp.validators[name] = validator
-	vp.validatorOrder = append(vp.validatorOrder, name)
+}
+
+// RemoveValidator removes the named validator from the pipeline, keeping the
+// order of the rest. It reports whether the name was present.
+func (vp *ValidationPipeline) RemoveValidator(name string) bool {
+	vp.mu.Lock()
+	defer vp.mu.Unlock()
+	
+	if _, exists := vp.validators[name]; !exists {
+		return false
+	}
+	delete(vp.validators, name)
+	for i, existing := range vp.validatorOrder {
+		if existing == name {
+			vp.validatorOrder = append(vp.validatorOrder[:i], vp.validatorOrder[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// Validators returns the pipeline's validators in the order they run. The
+// slice is a copy; to reorder, remove validators and add them back.
+func (vp *ValidationPipeline) Validators() []NamedValidator {
+	vp.mu.RLock()
+	defer vp.mu.RUnlock()
+	
+	validators := make([]NamedValidator, 0, len(vp.validatorOrder))
+	for _, name := range vp.validatorOrder {
+		validators = append(validators, NamedValidator{Name: name, Validator: vp.validators[name]})
+	}
+	return validators
 }
 
 // SetErrorAggregation enables or disables error aggregation
@@ -93,6 +136,106 @@ func (vp *ValidationPipeline) Validate(value interface{}) error {
 	return nil
 }
 
+// ValidateStruct validates each field of a struct, or pointer to struct, against the
+// registered field definition of the same name. Fields are matched by json tag name,
+// falling back to the Go field name; fields without a registered definition or
+// validators are skipped. With error aggregation enabled every failing field is
+// reported as a sub-error, otherwise the first failing field is returned.
+func (vp *ValidationPipeline) ValidateStruct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return &ValidationError{Message: "cannot validate nil value", Errors: []error{fmt.Errorf("value is nil")}}
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return &ValidationError{Message: "cannot validate non-struct value", Errors: []error{fmt.Errorf("expected struct, got %T", v)}}
+	}
+
+	vp.mu.RLock()
+	aggregate := vp.errorAggregation
+	vp.mu.RUnlock()
+
+	var errors []error
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		structField := rt.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+		name := structFieldName(structField)
+		if name == "" {
+			continue
+		}
+		field, exists := GetFieldDefinition(name)
+		if !exists || (len(field.Validators) == 0 && !field.Required) {
+			continue
+		}
+
+		if err := validateStructField(field, name, rv.Field(i), aggregate); err != nil {
+			if !aggregate {
+				return err
+			}
+			errors = append(errors, err)
+		}
+	}
+
+	if len(errors) > 0 {
+		return &ValidationError{
+			Message: "Multiple validation failures",
+			Errors:  errors,
+		}
+	}
+
+	return nil
+}
+
+// structFieldName returns the name a struct field is registered under: its json tag
+// name if present, otherwise the Go field name. Fields tagged json:"-" return "".
+func structFieldName(structField reflect.StructField) string {
+	tag := structField.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name
+	}
+	return structField.Name
+}
+
+// validateStructField runs a field definition's validators against one struct field value.
+// Empty optional fields are skipped, matching FieldValidator.
+func validateStructField(field FieldDefinition, name string, value reflect.Value, aggregate bool) error {
+	if value.IsZero() {
+		if field.Required {
+			return &ValidationError{Field: name, Message: "field is required but missing", Errors: []error{fmt.Errorf("field is required but missing")}}
+		}
+		return nil
+	}
+	for value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+
+	var errors []error
+	for _, validator := range field.Validators {
+		if !validator.IsEnabled() {
+			continue
+		}
+		if err := validator.Validate(value.Interface()); err != nil {
+			errors = append(errors, fmt.Errorf("validator '%s': %w", validator.Name(), err))
+			if !aggregate {
+				break
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return &ValidationError{Field: name, Message: "Multiple validation failures", Errors: errors}
+	}
+	return nil
+}
+
 // ValidationError represents validation failures
 type ValidationError struct {
 	Message string
@@ -308,6 +451,7 @@ type DateOptions struct {
 	MinAge        time.Duration
 	MaxAge        time.Duration
 	AllowedFormats []string
+	Now           func() time.Time // Reference time for the age and past/future checks, nil uses time.Now
 }
 
 type ImageOptions struct {
@@ -0,0 +1,157 @@
+// ABOUTME: Opt-in validator that checks a URL actually resolves with a HEAD request, falling back to GET
+// ABOUTME: Adds a network round trip per value, so it is never part of the default validation profiles
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	urlvalidation "github.com/BumpyClock/hermes/internal/validation"
+)
+
+// DefaultReachabilityTimeout bounds each reachability check when none is given
+const DefaultReachabilityTimeout = 10 * time.Second
+
+// ReachabilityValidator fails URLs that do not answer with a 2xx status.
+// Private and internal addresses are refused before any request is made,
+// and on every redirect hop, unless SetAllowPrivateNetworks(true) is called.
+type ReachabilityValidator struct {
+	BaseValidator
+	client               *http.Client
+	timeout              time.Duration
+	allowPrivateNetworks bool
+	allowHosts           []string
+	denyHosts            []string
+	mu                   sync.RWMutex
+}
+
+// NewReachabilityValidator creates a validator that requests each URL with
+// client, or a default client that pins connections to validated addresses
+// when nil. Redirect targets are validated before they are followed; a
+// caller-supplied client keeps its own transport and so is not protected
+// against DNS rebinding unless it dials with urlvalidation.PinnedDialContext.
+// Each check is bounded by timeout, or DefaultReachabilityTimeout when
+// timeout is zero or less.
+func NewReachabilityValidator(client *http.Client, timeout time.Duration) *ReachabilityValidator {
+	if client == nil {
+		client = &http.Client{
+			Transport: &http.Transport{
+				DialContext: urlvalidation.PinnedDialContext(&net.Dialer{
+					Timeout:   30 * time.Second,
+					KeepAlive: 30 * time.Second,
+				}),
+				MaxIdleConns:    10,
+				IdleConnTimeout: 90 * time.Second,
+			},
+		}
+	}
+	client = urlvalidation.GuardRedirects(client)
+	if timeout <= 0 {
+		timeout = DefaultReachabilityTimeout
+	}
+	return &ReachabilityValidator{
+		BaseValidator: NewBaseValidator("reachability", "url"),
+		client:        client,
+		timeout:       timeout,
+	}
+}
+
+// SetAllowPrivateNetworks allows checking URLs on loopback and private
+// network addresses, for trusted input such as tests or intranet crawls
+func (rv *ReachabilityValidator) SetAllowPrivateNetworks(allow bool) {
+	rv.mu.Lock()
+	defer rv.mu.Unlock()
+	rv.allowPrivateNetworks = allow
+}
+
+// SetSSRFHosts sets hosts reachable despite the private network block and
+// hosts always refused. Entries are hostnames, "*.domain" wildcards, IPs or
+// CIDR ranges; a denied entry always wins.
+func (rv *ReachabilityValidator) SetSSRFHosts(allow, deny []string) {
+	rv.mu.Lock()
+	defer rv.mu.Unlock()
+	rv.allowHosts = append([]string(nil), allow...)
+	rv.denyHosts = append([]string(nil), deny...)
+}
+
+// Validate checks that a URL value resolves, as ValidateContext does with a
+// background context
+func (rv *ReachabilityValidator) Validate(value interface{}) error {
+	return rv.ValidateContext(context.Background(), value)
+}
+
+// ValidateContext checks that a URL value resolves. A HEAD request is tried
+// first; servers that refuse HEAD get a GET whose body is not read. Network
+// errors and non-2xx responses are reported as a ValidationError.
+func (rv *ReachabilityValidator) ValidateContext(ctx context.Context, value interface{}) error {
+	if !rv.IsEnabled() {
+		return nil
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("expected string URL, got %T", value)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, rv.timeout)
+	defer cancel()
+
+	opts := rv.validationOptions()
+	if err := urlvalidation.ValidateURL(ctx, str, opts); err != nil {
+		return rv.unreachable(str, err)
+	}
+	// The pinned dialer and the redirect check read the rules from the context
+	ctx = urlvalidation.ContextWithOptions(ctx, opts)
+
+	status, err := rv.request(ctx, http.MethodHead, str)
+	if err == nil && !isSuccessStatus(status) {
+		status, err = rv.request(ctx, http.MethodGet, str)
+	}
+	if err != nil {
+		return rv.unreachable(str, err)
+	}
+	if !isSuccessStatus(status) {
+		return rv.unreachable(str, fmt.Errorf("unexpected status %d", status))
+	}
+	return nil
+}
+
+// validationOptions returns the SSRF rules for the current settings
+func (rv *ReachabilityValidator) validationOptions() urlvalidation.ValidationOptions {
+	rv.mu.RLock()
+	defer rv.mu.RUnlock()
+	return urlvalidation.SSRFOptions(rv.allowPrivateNetworks, rv.allowHosts, rv.denyHosts)
+}
+
+// request sends one request to rawURL and returns the response status
+func (rv *ReachabilityValidator) request(ctx context.Context, method, rawURL string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := rv.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+// unreachable wraps err in a ValidationError naming the URL
+func (rv *ReachabilityValidator) unreachable(rawURL string, err error) error {
+	return &ValidationError{
+		Message: fmt.Sprintf("URL %s is not reachable", rawURL),
+		Errors:  []error{err},
+		Field:   rv.Name(),
+	}
+}
+
+// isSuccessStatus reports whether status is a 2xx code
+func isSuccessStatus(status int) bool {
+	return status >= 200 && status < 300
+}
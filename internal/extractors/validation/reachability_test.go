@@ -0,0 +1,147 @@
+// ABOUTME: Tests for the opt-in URL reachability validator
+// ABOUTME: Uses httptest servers for reachable, HEAD-refusing, missing, closed and slow URLs
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReachabilityValidator(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusOK)
+		case "/no-head":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closedURL := closed.URL + "/gone"
+	closed.Close()
+
+	validator := NewReachabilityValidator(server.Client(), time.Second)
+	validator.SetAllowPrivateNetworks(true)
+
+	tests := []struct {
+		name      string
+		url       string
+		reachable bool
+	}{
+		{"2xx on HEAD", server.URL + "/ok", true},
+		{"GET after HEAD is refused", server.URL + "/no-head", true},
+		{"404", server.URL + "/missing", false},
+		{"connection refused", closedURL, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.Validate(tt.url)
+			if tt.reachable {
+				if err != nil {
+					t.Errorf("Expected %s to be reachable, got error: %v", tt.url, err)
+				}
+				return
+			}
+
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Expected a ValidationError for %s, got %v", tt.url, err)
+			}
+			if len(validationErr.Errors) != 1 {
+				t.Errorf("Expected the cause to be wrapped, got %v", validationErr.Errors)
+			}
+		})
+	}
+}
+
+func TestReachabilityValidatorContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	validator := NewReachabilityValidator(server.Client(), time.Minute)
+	validator.SetAllowPrivateNetworks(true)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := validator.ValidateContext(ctx, server.URL); err == nil {
+		t.Error("Expected a cancelled check to fail")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the check to stop with the context, took %v", elapsed)
+	}
+}
+
+func TestReachabilityValidatorRefusesPrivateNetworks(t *testing.T) {
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer server.Close()
+
+	validator := NewReachabilityValidator(server.Client(), time.Second)
+	if err := validator.Validate(server.URL); err == nil {
+		t.Error("Expected a loopback URL to be refused by default")
+	}
+	if requested {
+		t.Error("Expected no request to be made to a refused URL")
+	}
+
+	validator.SetEnabled(false)
+	if err := validator.Validate(server.URL); err != nil {
+		t.Errorf("Expected a disabled validator to pass, got %v", err)
+	}
+}
+
+func TestReachabilityValidatorRefusesRedirectsToDeniedHosts(t *testing.T) {
+	var targetHit int32
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.StoreInt32(&targetHit, 1)
+	}))
+	defer target.Close()
+
+	deniedURL := strings.Replace(target.URL, "127.0.0.1", "localhost", 1) + "/metadata"
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, deniedURL, http.StatusFound)
+	}))
+	defer origin.Close()
+
+	for name, client := range map[string]*http.Client{"default client": nil, "supplied client": origin.Client()} {
+		t.Run(name, func(t *testing.T) {
+			validator := NewReachabilityValidator(client, time.Second)
+			validator.SetAllowPrivateNetworks(true)
+			validator.SetSSRFHosts(nil, []string{"localhost"})
+
+			if err := validator.Validate(origin.URL); err == nil {
+				t.Error("Expected a redirect to a denied host to fail")
+			}
+			if atomic.LoadInt32(&targetHit) != 0 {
+				t.Error("Expected the denied redirect target never to be requested")
+			}
+		})
+	}
+}
@@ -96,6 +96,21 @@ func TestValidatorInterface(t *testing.T) {
 		}
 	})
 
+	t.Run("DateValidator measures age against its clock", func(t *testing.T) {
+		now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+		validator := NewDateValidator(DateOptions{
+			MaxAge: 30 * 24 * time.Hour,
+			Now:    func() time.Time { return now },
+		})
+
+		if err := validator.Validate("2020-05-20T12:00:00Z"); err != nil {
+			t.Errorf("Expected a date 12 days before the clock to pass, got error: %v", err)
+		}
+		if err := validator.Validate("2020-04-01T12:00:00Z"); err == nil {
+			t.Error("Expected a date 61 days before the clock to be too old")
+		}
+	})
+
 	t.Run("ImageValidator validates images correctly", func(t *testing.T) {
 		validator := NewImageValidator(ImageOptions{
 			RequireHTTPS:    false,
@@ -188,6 +203,160 @@ func TestValidationPipeline(t *testing.T) {
 	})
 }
 
+// recordingValidator accepts every value and notes its name each time it runs
+type recordingValidator struct {
+	*BaseValidator
+	calls *[]string
+}
+
+func newRecordingValidator(name string, calls *[]string) recordingValidator {
+	base := NewBaseValidator(name, "string")
+	return recordingValidator{BaseValidator: &base, calls: calls}
+}
+
+func (v recordingValidator) Validate(value interface{}) error {
+	*v.calls = append(*v.calls, v.Name())
+	return nil
+}
+
+func TestValidationPipelineRemoveValidator(t *testing.T) {
+	var calls []string
+	pipeline := NewValidationPipeline()
+	for _, name := range []string{"first", "second", "third"} {
+		pipeline.AddValidator(name, newRecordingValidator(name, &calls))
+	}
+
+	if !pipeline.RemoveValidator("second") {
+		t.Error("Expected removing a present validator to report true")
+	}
+	if pipeline.RemoveValidator("second") {
+		t.Error("Expected removing a missing validator to report false")
+	}
+
+	var names []string
+	for _, nv := range pipeline.Validators() {
+		names = append(names, nv.Name)
+	}
+	if fmt.Sprint(names) != "[first third]" {
+		t.Errorf("Expected validators [first third] in order, got %v", names)
+	}
+
+	if err := pipeline.Validate("value"); err != nil {
+		t.Fatalf("Expected value to pass, got error: %v", err)
+	}
+	if fmt.Sprint(calls) != "[first third]" {
+		t.Errorf("Expected Validate to run [first third], got %v", calls)
+	}
+
+	// Re-adding moves a validator to the end; replacing one keeps its place
+	calls = nil
+	pipeline.RemoveValidator("first")
+	pipeline.AddValidator("first", newRecordingValidator("first", &calls))
+	pipeline.AddValidator("third", NewStringValidator(StringOptions{MinLength: 10}))
+	names = nil
+	for _, nv := range pipeline.Validators() {
+		names = append(names, nv.Name)
+	}
+	if fmt.Sprint(names) != "[third first]" {
+		t.Errorf("Expected validators [third first] in order, got %v", names)
+	}
+	if err := pipeline.Validate("short"); err == nil {
+		t.Error("Expected the replacement third validator to reject a short value")
+	}
+}
+
+func TestValidationPipelineValidateStruct(t *testing.T) {
+	registerTestField(t, FieldDefinition{
+		Name:       "title",
+		Type:       "string",
+		Validators: []ValidatorInterface{NewStringValidator(StringOptions{MinLength: 10})},
+	})
+	registerTestField(t, FieldDefinition{
+		Name:       "url",
+		Type:       "url",
+		Required:   true,
+		Validators: []ValidatorInterface{NewURLValidator(URLOptions{})},
+	})
+
+	invalid := &ValidatedResult{
+		Title:   "Short",
+		URL:     "javascript:alert(1)",
+		Content: "x", // No registered validators, so skipped
+	}
+
+	t.Run("Aggregates one sub-error per invalid field", func(t *testing.T) {
+		pipeline := NewValidationPipeline()
+		pipeline.SetErrorAggregation(true)
+
+		err := pipeline.ValidateStruct(invalid)
+		aggErr, ok := err.(*ValidationError)
+		if !ok {
+			t.Fatalf("Expected ValidationError, got %v", err)
+		}
+		if len(aggErr.Errors) != 2 {
+			t.Fatalf("Expected 2 sub-errors, got %d: %v", len(aggErr.Errors), aggErr.Errors)
+		}
+
+		fields := make(map[string]bool)
+		for _, subErr := range aggErr.Errors {
+			if fieldErr, ok := subErr.(*ValidationError); ok {
+				fields[fieldErr.Field] = true
+			}
+		}
+		if !fields["title"] || !fields["url"] {
+			t.Errorf("Expected sub-errors for title and url, got %v", aggErr.Errors)
+		}
+	})
+
+	t.Run("Fails fast on the first invalid field", func(t *testing.T) {
+		err := NewValidationPipeline().ValidateStruct(*invalid)
+		fieldErr, ok := err.(*ValidationError)
+		if !ok {
+			t.Fatalf("Expected ValidationError, got %v", err)
+		}
+		if fieldErr.Field != "title" {
+			t.Errorf("Expected first failure for title, got %q", fieldErr.Field)
+		}
+	})
+
+	t.Run("Valid fields produce no sub-errors", func(t *testing.T) {
+		pipeline := NewValidationPipeline()
+		pipeline.SetErrorAggregation(true)
+
+		err := pipeline.ValidateStruct(&ValidatedResult{Title: "Council approves budget", URL: invalid.URL})
+		aggErr, ok := err.(*ValidationError)
+		if !ok || len(aggErr.Errors) != 1 {
+			t.Fatalf("Expected only the url sub-error, got %v", err)
+		}
+	})
+
+	t.Run("Missing required field fails", func(t *testing.T) {
+		err := NewValidationPipeline().ValidateStruct(&ValidatedResult{})
+		if fieldErr, ok := err.(*ValidationError); !ok || fieldErr.Field != "url" {
+			t.Errorf("Expected required url error, got %v", err)
+		}
+	})
+
+	t.Run("Rejects non-struct values", func(t *testing.T) {
+		if err := NewValidationPipeline().ValidateStruct("not a struct"); err == nil {
+			t.Error("Expected error for non-struct value")
+		}
+	})
+}
+
+// registerTestField registers a field definition for the duration of a test
+func registerTestField(t *testing.T, field FieldDefinition) {
+	t.Helper()
+	if err := RegisterField(field); err != nil {
+		t.Fatalf("Failed to register field %s: %v", field.Name, err)
+	}
+	t.Cleanup(func() {
+		registryMutex.Lock()
+		defer registryMutex.Unlock()
+		delete(fieldRegistry, field.Name)
+	})
+}
+
 func TestValidationConfiguration(t *testing.T) {
 	t.Run("Validation profiles work correctly", func(t *testing.T) {
 		// Test strict profile
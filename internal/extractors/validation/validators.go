@@ -194,6 +194,9 @@ func (dv *DateValidator) Validate(value interface{}) error {
 	}
 	
 	now := time.Now()
+	if dv.options.Now != nil {
+		now = dv.options.Now()
+	}
 	
 	// Check future requirement
 	if dv.options.RequireFuture && parsedTime.Before(now) {
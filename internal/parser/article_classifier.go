@@ -0,0 +1,98 @@
+// ABOUTME: Heuristic classifier telling single articles apart from home pages and listings
+// ABOUTME: Measures link density and headings before extraction, then weighs them against the extracted result
+
+package parser
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ErrNotArticle is returned under RejectNonArticles when the page looks like a home page or listing
+var ErrNotArticle = errors.New("page does not look like a single article")
+
+// Share of body words inside links above which a page reads like a link list
+const listingLinkDensity = 0.5
+
+// Extracted content below this many words, or below this share of the body,
+// means no single block dominates the page
+const (
+	articleMinWords     = 150
+	articleMinBodyShare = 0.25
+)
+
+// A page is classified as a listing only when at least this many signals agree
+const listingMinSignals = 3
+
+// JSON-LD types that declare the page to be an article
+var articleJSONLDTypeRE = regexp.MustCompile(`"@type"\s*:\s*\[?\s*"(Article|NewsArticle|BlogPosting|Report|ScholarlyArticle|TechArticle|OpinionNewsArticle|ReportageNewsArticle|AnalysisNewsArticle|ReviewNewsArticle)"`)
+
+// pageSignals are measured on the document before extraction rewrites it
+type pageSignals struct {
+	bodyWords   int
+	linkDensity float64
+	h1Count     int
+	declared    bool // og:type or JSON-LD declares an article
+}
+
+// measurePage collects the page-level signals used by isArticle
+func measurePage(doc *goquery.Document) pageSignals {
+	var signals pageSignals
+
+	body := doc.Find("body").First().Clone()
+	body.Find("script, style, noscript, template").Remove()
+	signals.bodyWords = len(strings.Fields(body.Text()))
+	if signals.bodyWords > 0 {
+		linkWords := 0
+		body.Find("a").Each(func(i int, a *goquery.Selection) {
+			linkWords += len(strings.Fields(a.Text()))
+		})
+		signals.linkDensity = float64(linkWords) / float64(signals.bodyWords)
+	}
+	signals.h1Count = body.Find("h1").Length()
+
+	// Normalized documents rename property to name and content to value
+	ogMeta := doc.Find(`meta[name="og:type"], meta[property="og:type"]`).First()
+	ogType := ogMeta.AttrOr("value", ogMeta.AttrOr("content", ""))
+	if strings.EqualFold(strings.TrimSpace(ogType), "article") {
+		signals.declared = true
+	}
+	doc.Find(`script[type="application/ld+json"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+		signals.declared = signals.declared || articleJSONLDTypeRE.MatchString(s.Text())
+		return !signals.declared
+	})
+
+	return signals
+}
+
+// isArticle reports whether the page is a single article. It is deliberately
+// conservative: a declared article always counts, and otherwise several
+// listing signals must agree before a page is rejected.
+func (signals pageSignals) isArticle(result *Result, opts *ParserOptions) bool {
+	if signals.declared {
+		return true
+	}
+
+	listing := 0
+	if signals.linkDensity > listingLinkDensity {
+		listing++
+	}
+	if opts.MetadataOnly {
+		// No content was extracted, so only the size of the whole page is known
+		if signals.bodyWords < articleMinWords {
+			listing++
+		}
+	} else if result.TotalWordCount < articleMinWords || float64(result.TotalWordCount) < articleMinBodyShare*float64(signals.bodyWords) {
+		listing++
+	}
+	if signals.h1Count > 1 {
+		listing++
+	}
+	if result.DatePublished == nil {
+		listing++
+	}
+	return listing < listingMinSignals
+}
@@ -129,4 +129,47 @@ func BenchmarkDifferentContentTypes(b *testing.B) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+// BenchmarkExtractionConcurrency compares running a single document's field
+// extractions on parallel goroutines with running them in order. Each field
+// takes microseconds, so goroutine startup and scheduling can cost more than
+// the parallelism saves.
+func BenchmarkExtractionConcurrency(b *testing.B) {
+	fixtureFile := "../../internal/fixtures/www.nytimes.com.html"
+	html, err := ioutil.ReadFile(fixtureFile)
+	if err != nil {
+		b.Skip("Fixture file not available:", err)
+	}
+
+	htmlStr := string(html)
+	url := "https://www.nytimes.com/test-article"
+
+	for _, limit := range []struct {
+		name string
+		n    int
+	}{
+		{"parallel", 0},
+		{"bounded-4", 4},
+		{"sequential", 1},
+	} {
+		b.Run(limit.name, func(b *testing.B) {
+			p := parser.New()
+			opts := parser.ParserOptions{
+				MaxExtractionConcurrency: limit.n,
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				result, err := p.ParseHTML(htmlStr, url, &opts)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if result.IsError() {
+					b.Fatal(result.Message)
+				}
+			}
+		})
+	}
+}
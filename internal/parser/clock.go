@@ -0,0 +1,14 @@
+// ABOUTME: The parser's source of the current time for relative dates and freshness
+// ABOUTME: Uses ParserOptions.Clock when set so results can be pinned to a fixed moment
+
+package parser
+
+import "time"
+
+// now returns the configured clock's time, or the system time when none is set
+func (opts ParserOptions) now() time.Time {
+	if opts.Clock == nil {
+		return time.Now()
+	}
+	return opts.Clock()
+}
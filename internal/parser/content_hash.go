@@ -0,0 +1,85 @@
+// ABOUTME: Stable hash of the extracted content's text for change detection
+// ABOUTME: Strips markup and collapses whitespace so only real text changes alter the hash
+
+package parser
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Elements whose boundaries separate words, so "<p>a</p><p>b</p>" reads "a b"
+var hashBlockElements = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "br": true,
+	"dd": true, "div": true, "dl": true, "dt": true, "figcaption": true, "figure": true,
+	"footer": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"header": true, "hr": true, "li": true, "ol": true, "p": true, "pre": true,
+	"section": true, "table": true, "td": true, "th": true, "tr": true, "ul": true,
+}
+
+// applyContentHash sets result.ContentHash from the text of result.Content.
+// It runs before frontmatter is prepended so metadata never affects the hash.
+func applyContentHash(result *Result, opts *ParserOptions) {
+	if result == nil {
+		return
+	}
+	result.ContentHash = contentHash(result.Content, opts.ContentType)
+}
+
+// contentHash returns the SHA-256 hex digest of content's whitespace-collapsed
+// text, or "" when there is no text
+func contentHash(content, contentType string) string {
+	var plain string
+	switch contentType {
+	case "text", ContentTypeTextSentences:
+		plain = content
+	case "markdown":
+		plain = summaryText(content, contentType)
+	default:
+		plain = htmlText(content)
+	}
+
+	normalized := strings.Join(strings.Fields(plain), " ")
+	if normalized == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// htmlText returns the text of an HTML fragment with a space at every block boundary
+func htmlText(content string) string {
+	root, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return ""
+	}
+
+	var b strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			b.WriteString(n.Data)
+			return
+		case html.ElementNode:
+			if n.Data == "script" || n.Data == "style" {
+				return
+			}
+		}
+		block := n.Type == html.ElementNode && hashBlockElements[n.Data]
+		if block {
+			b.WriteByte(' ')
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+		if block {
+			b.WriteByte(' ')
+		}
+	}
+	walk(root)
+	return b.String()
+}
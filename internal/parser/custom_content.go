@@ -0,0 +1,86 @@
+// ABOUTME: Joins the matches of custom extractor content selectors into one block of HTML
+// ABOUTME: Tracks captured nodes so overlapping parent and child selectors never duplicate content
+
+package parser
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+)
+
+// contentMatches accumulates the inner HTML of selector matches in the order
+// they are added, keeping every DOM node at most once
+type contentMatches struct {
+	captured map[*html.Node]bool
+	b        strings.Builder
+}
+
+// add appends the inner HTML of each element in sel. An element inside one
+// already captured is skipped, and an element wrapping earlier captures is
+// appended without them, so a broad selector listed after a narrow one only
+// contributes what the narrow one missed.
+func (m *contentMatches) add(sel *goquery.Selection) {
+	if m.captured == nil {
+		m.captured = make(map[*html.Node]bool)
+	}
+	sel.Each(func(i int, el *goquery.Selection) {
+		node := el.Nodes[0]
+		if m.covers(node) {
+			return
+		}
+
+		if m.capturesWithin(node) {
+			el = el.Clone()
+			m.prune(node, el.Nodes[0])
+		}
+		if content, err := el.Html(); err == nil && strings.TrimSpace(content) != "" {
+			m.b.WriteString(content)
+			m.b.WriteString("\n")
+		}
+		m.captured[node] = true
+	})
+}
+
+// String returns the combined HTML
+func (m *contentMatches) String() string {
+	return strings.TrimSpace(m.b.String())
+}
+
+// covers reports whether node or one of its ancestors was captured
+func (m *contentMatches) covers(node *html.Node) bool {
+	for n := node; n != nil; n = n.Parent {
+		if m.captured[n] {
+			return true
+		}
+	}
+	return false
+}
+
+// capturesWithin reports whether a descendant of node was captured
+func (m *contentMatches) capturesWithin(node *html.Node) bool {
+	for captured := range m.captured {
+		for n := captured.Parent; n != nil; n = n.Parent {
+			if n == node {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// prune removes from clone the copies of captured descendants of orig. The
+// clone mirrors orig node for node, so both trees are walked in step.
+func (m *contentMatches) prune(orig, clone *html.Node) {
+	o, c := orig.FirstChild, clone.FirstChild
+	for o != nil && c != nil {
+		nextO, nextC := o.NextSibling, c.NextSibling
+		if m.captured[o] {
+			clone.RemoveChild(c)
+		} else {
+			m.prune(o, c)
+		}
+		o, c = nextO, nextC
+	}
+}
@@ -0,0 +1,154 @@
+// ABOUTME: EPUB chapter output that renders sanitized content as a strict XHTML document
+// ABOUTME: Void elements are self-closed and the result is checked with an XML decoder before returning
+
+package parser
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"github.com/BumpyClock/hermes/internal/resource"
+	"github.com/BumpyClock/hermes/internal/utils/security"
+	nethtml "golang.org/x/net/html"
+)
+
+// ContentTypeEPUBChapter is the content type for XHTML chapter documents ready for EPUB packaging
+const ContentTypeEPUBChapter = "epub-chapter"
+
+const xhtmlNamespace = "http://www.w3.org/1999/xhtml"
+
+// xhtmlBody sanitizes content and renders it as XHTML. The HTML renderer
+// already self-closes void elements; what it cannot express in XML, such as
+// comments, control characters and attribute names XML rejects, is removed
+// first, and the result is checked for well-formedness.
+func xhtmlBody(content string) (string, error) {
+	doc, err := parseFragment(security.SanitizeHTML(content))
+	if err != nil {
+		return "", err
+	}
+
+	body := doc.Find("body")
+	for _, node := range body.Nodes {
+		makeXMLSafe(node)
+	}
+
+	out, err := body.Html()
+	if err != nil {
+		return "", fmt.Errorf("%w: failed to render content fragment: %w", resource.ErrMalformedHTML, err)
+	}
+	if err := checkWellFormed("<body>" + out + "</body>"); err != nil {
+		return "", err
+	}
+	return out, nil
+}
+
+// makeXMLSafe removes from the tree under node what has no XML form
+func makeXMLSafe(node *nethtml.Node) {
+	for child := node.FirstChild; child != nil; {
+		next := child.NextSibling
+		switch child.Type {
+		case nethtml.CommentNode, nethtml.DoctypeNode:
+			node.RemoveChild(child)
+		case nethtml.TextNode:
+			child.Data = xmlChars(child.Data)
+		case nethtml.ElementNode:
+			attrs := child.Attr[:0]
+			for _, attr := range child.Attr {
+				if attr.Namespace == "" && isXMLName(attr.Key) {
+					attr.Val = xmlChars(attr.Val)
+					attrs = append(attrs, attr)
+				}
+			}
+			child.Attr = attrs
+			makeXMLSafe(child)
+		}
+		child = next
+	}
+}
+
+// xmlChars drops the characters XML 1.0 does not allow in documents
+func xmlChars(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\t' || r == '\n' || r == '\r':
+			return r
+		case r < 0x20, r >= 0xD800 && r <= 0xDFFF, r == 0xFFFE || r == 0xFFFF:
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// isXMLName reports whether name is usable as an unprefixed XML attribute name
+func isXMLName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z':
+		case i > 0 && (r == '-' || r == '.' || r >= '0' && r <= '9'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// checkWellFormed reads doc with a strict XML decoder, reporting the first
+// syntax error as ErrMalformedHTML
+func checkWellFormed(doc string) error {
+	decoder := xml.NewDecoder(strings.NewReader(doc))
+	decoder.Strict = true
+	for {
+		_, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: content is not well-formed XHTML: %w", resource.ErrMalformedHTML, err)
+		}
+	}
+}
+
+// applyEPUBChapter wraps XHTML content in a chapter document with the title
+// as its heading. It runs after the content-derived fields are computed, so
+// word counts, summaries and the content hash see only the body.
+func applyEPUBChapter(result *Result, opts *ParserOptions) {
+	if result == nil || !strings.EqualFold(opts.ContentType, ContentTypeEPUBChapter) || opts.MetadataOnly {
+		return
+	}
+
+	title := html.EscapeString(xmlChars(result.Title))
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	sb.WriteString("<!DOCTYPE html>\n")
+	sb.WriteString(`<html xmlns="` + xhtmlNamespace + `"`)
+	if lang := result.Language; lang != "" && isLanguageTag(lang) {
+		sb.WriteString(` xml:lang="` + lang + `" lang="` + lang + `"`)
+	}
+	sb.WriteString(">\n<head>\n<title>" + title + "</title>\n</head>\n<body>\n")
+	// Content that opens with the title as its own heading keeps just that one
+	heading := "<h1>" + title + "</h1>"
+	if title != "" && !strings.HasPrefix(strings.TrimSpace(result.Content), heading) {
+		sb.WriteString(heading + "\n")
+	}
+	// PDF paragraphs arrive unfiltered, so control characters are dropped here too
+	sb.WriteString(xmlChars(result.Content))
+	sb.WriteString("\n</body>\n</html>\n")
+	result.Content = sb.String()
+}
+
+// isLanguageTag reports whether lang looks like a BCP 47 tag, e.g. "en" or "pt-BR"
+func isLanguageTag(lang string) bool {
+	for _, r := range lang {
+		if !(r == '-' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -9,17 +9,24 @@ import (
 	"net"
 	"net/url"
 	"strings"
+
+	"github.com/BumpyClock/hermes/internal/resource"
 )
 
 // These constants mirror the public ErrorCode values
 // We use int here to avoid import cycles - the caller will convert to their ErrorCode type
 const (
-	errInvalidURL = 0 // ErrInvalidURL
-	errFetch      = 1 // ErrFetch
-	errTimeout    = 2 // ErrTimeout
-	errSSRF       = 3 // ErrSSRF
-	errExtract    = 4 // ErrExtract
-	errContext    = 5 // ErrContext (not used internally but keeps constants aligned)
+	errInvalidURL             = 0  // ErrInvalidURL
+	errFetch                  = 1  // ErrFetch
+	errTimeout                = 2  // ErrTimeout
+	errSSRF                   = 3  // ErrSSRF
+	errExtract                = 4  // ErrExtract
+	errContext                = 5  // ErrContext (not used internally but keeps constants aligned)
+	errNotModified            = 6  // ErrNotModified
+	errUnsupportedContentType = 7  // ErrUnsupportedContentType
+	errJavaScriptRequired     = 8  // ErrJavaScriptRequired
+	errParse                  = 9  // ErrParse
+	errNotArticle             = 10 // ErrNotArticle
 )
 
 // ClassifyErrorCode determines the appropriate error code based on the error type and context
@@ -30,6 +37,31 @@ func ClassifyErrorCode(err error, ctx context.Context, op string) int {
 		return errFetch // Default fallback, shouldn't happen
 	}
 	
+	// A 304 response is not a failure, report it distinctly
+	if errors.Is(err, resource.ErrNotModified) {
+		return errNotModified
+	}
+	
+	// Binary payloads such as PDFs or images are not a fetch failure either
+	if errors.Is(err, resource.ErrUnsupportedContentType) {
+		return errUnsupportedContentType
+	}
+	
+	// Client-rendered shells need a headless browser rather than a retry
+	if errors.Is(err, ErrJavaScriptRequired) {
+		return errJavaScriptRequired
+	}
+	
+	// Home pages and listings rejected under RejectNonArticles
+	if errors.Is(err, ErrNotArticle) {
+		return errNotArticle
+	}
+	
+	// HTML that could not be turned into a usable DOM, and extraction panics
+	if errors.Is(err, resource.ErrMalformedHTML) || errors.Is(err, ErrExtractionPanic) {
+		return errParse
+	}
+	
 	// Check for context errors first (timeout/cancellation)
 	if ctx.Err() != nil {
 		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
@@ -75,8 +107,10 @@ func ClassifyErrorCode(err error, ctx context.Context, op string) int {
 	// This is less ideal but necessary for some internal errors
 	errMsg := strings.ToLower(err.Error())
 	if strings.Contains(errMsg, "no children found") ||
-		strings.Contains(errMsg, "failed to parse html") ||
-		strings.Contains(errMsg, "content does not appear to be text") ||
+		strings.Contains(errMsg, "failed to parse html") {
+		return errParse
+	}
+	if strings.Contains(errMsg, "content does not appear to be text") ||
 		strings.Contains(errMsg, "document size") ||
 		strings.Contains(errMsg, "dom too complex") {
 		return errExtract
@@ -16,6 +16,8 @@ import (
 	"github.com/BumpyClock/hermes/internal/cleaners"
 	"github.com/BumpyClock/hermes/internal/extractors/custom"
 	"github.com/BumpyClock/hermes/internal/extractors/generic"
+	"github.com/BumpyClock/hermes/internal/resource"
+	"github.com/BumpyClock/hermes/internal/utils/dom"
 	"github.com/BumpyClock/hermes/internal/utils/security"
 	"github.com/BumpyClock/hermes/internal/utils/text"
 )
@@ -42,96 +44,276 @@ func (h *Hermes) extractAllFieldsWithContext(ctx context.Context, doc *goquery.D
 		opts.ContentType = "html"
 	}
 	// Enable fallback by default if no explicit preference is given
-	// This is detected by checking if ALL options are zero values (empty struct)
-	if opts.ContentType == "html" && !opts.Fallback && opts.Headers == nil && !opts.FetchAllPages {
+	// This is detected by checking if ALL options are zero values (empty struct),
+	// unless FallbackExplicit says Fallback was chosen deliberately
+	if !opts.FallbackExplicit && opts.ContentType == "html" && !opts.Fallback && opts.Headers == nil && !opts.FetchAllPages {
 		// Likely an empty ParserOptions{}, so enable fallback for better UX
 		opts.Fallback = true
 	}
 	
 	// Create base result
 	result := &Result{
-		URL:    targetURL,
-		Domain: parsedURL.Host,
+		URL:          targetURL,
+		Domain:       parsedURL.Host,
+		CommentCount: -1,
+		TotalPages:   1,
 	}
 	
 	// Build meta cache first for use by both custom and generic extractors
 	metaCache := buildMetaCache(doc)
 	
+	// Relative dates like "2 hours ago" are resolved against one moment per parse
+	now := opts.now()
+	
 	// Extract site metadata first (independent of custom/generic extractor choice)
-	var wg sync.WaitGroup
+	group := newFieldGroup(opts.MaxExtractionConcurrency)
 	var mu sync.Mutex
 	
 	// Start parallel site metadata extractions
-	wg.Add(6)
 	
 	// Extract site name
-	go func() {
-		defer wg.Done()
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
 		siteNameExtractor := &generic.GenericSiteNameExtractor{}
 		if siteName := siteNameExtractor.Extract(doc.Selection, targetURL, metaCache); siteName != "" {
 			mu.Lock()
 			result.SiteName = siteName
 			mu.Unlock()
 		}
-	}()
+	})
 	
 	// Extract site title  
-	go func() {
-		defer wg.Done()
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
 		siteTitleExtractor := &generic.GenericSiteTitleExtractor{}
 		if siteTitle := siteTitleExtractor.Extract(doc.Selection, targetURL, metaCache); siteTitle != "" {
 			mu.Lock()
 			result.SiteTitle = siteTitle
 			mu.Unlock()
 		}
-	}()
+	})
 	
 	// Extract site image
-	go func() {
-		defer wg.Done()
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
 		siteImageExtractor := &generic.GenericSiteImageExtractor{}
 		if siteImage := siteImageExtractor.Extract(doc.Selection, targetURL, metaCache); siteImage != "" {
 			mu.Lock()
 			result.SiteImage = siteImage
 			mu.Unlock()
 		}
-	}()
+	})
 	
 	// Extract favicon
-	go func() {
-		defer wg.Done()
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
 		faviconExtractor := &generic.GenericFaviconExtractor{}
-		if favicon := faviconExtractor.Extract(doc.Selection, targetURL, metaCache); favicon != "" {
-			mu.Lock()
+		favicon := faviconExtractor.Extract(doc.Selection, targetURL, metaCache)
+		icons := faviconExtractor.ExtractIcons(doc.Selection, targetURL)
+		mu.Lock()
+		if favicon != "" {
 			result.Favicon = favicon
+		}
+		result.Icons = icons
+		mu.Unlock()
+	})
+	
+	// Extract the declared theme color
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		themeColorExtractor := &generic.GenericThemeColorExtractor{}
+		if themeColor := themeColorExtractor.Extract(doc.Selection); themeColor != "" {
+			mu.Lock()
+			result.ThemeColor = themeColor
 			mu.Unlock()
 		}
-	}()
+	})
 	
 	// Extract description
-	go func() {
-		defer wg.Done()
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
 		descriptionExtractor := &generic.GenericDescriptionExtractor{}
 		if description := descriptionExtractor.Extract(doc.Selection, targetURL, metaCache); description != "" {
 			mu.Lock()
 			result.Description = description
 			mu.Unlock()
 		}
-	}()
+	})
 	
 	// Extract language
-	go func() {
-		defer wg.Done()
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
 		languageExtractor := &generic.GenericLanguageExtractor{}
 		if language := languageExtractor.Extract(doc.Selection, targetURL, metaCache); language != "" {
 			mu.Lock()
 			result.Language = language
 			mu.Unlock()
 		}
-	}()
+	})
+	
+	// Extract breadcrumbs
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		breadcrumbsExtractor := &generic.GenericBreadcrumbsExtractor{}
+		if breadcrumbs := breadcrumbsExtractor.Extract(doc.Selection, targetURL, metaCache); len(breadcrumbs) > 0 {
+			mu.Lock()
+			result.Breadcrumbs = breadcrumbs
+			mu.Unlock()
+		}
+	})
+	
+	// Infer the publish timezone before dates are normalized to UTC
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		timezoneExtractor := &generic.GenericPublishTimezoneExtractor{}
+		if timezone := timezoneExtractor.Extract(doc.Selection); timezone != "" {
+			mu.Lock()
+			result.PublishTimezone = timezone
+			mu.Unlock()
+		}
+	})
+	
+	// Extract hreflang alternates
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		alternatesExtractor := &generic.GenericAlternatesExtractor{}
+		if alternates := alternatesExtractor.Extract(doc.Selection, targetURL); alternates != nil {
+			mu.Lock()
+			result.Alternates = alternates
+			mu.Unlock()
+		}
+	})
+	
+	// Extract the declared section from article:section or JSON-LD
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		sectionExtractor := &generic.GenericSectionExtractor{}
+		if section := sectionExtractor.Extract(doc.Selection); section != "" {
+			mu.Lock()
+			result.Section = section
+			mu.Unlock()
+		}
+	})
+	
+	// Extract the last update time from modified-time meta tags or JSON-LD
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		dateModifiedExtractor := &generic.GenericDateModifiedExtractor{Now: now}
+		if dateStr := dateModifiedExtractor.Extract(doc.Selection, opts.Locale); dateStr != nil && *dateStr != "" {
+			if date, err := parseDate(*dateStr, opts.Locale, now); err == nil {
+				mu.Lock()
+				result.DateModified = &date
+				mu.Unlock()
+			}
+		}
+	})
+	
+	// Classify the page before cleaners remove players and bylines
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		pageTypeExtractor := &generic.GenericPageTypeExtractor{}
+		if pageType := pageTypeExtractor.Extract(doc.Selection); pageType != "" {
+			mu.Lock()
+			result.PageType = pageType
+			mu.Unlock()
+		}
+	})
+	
+	// Find the audio enclosure before cleaners remove players
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		audioExtractor := &generic.GenericAudioExtractor{}
+		if audioURL := audioExtractor.Extract(doc.Selection, targetURL); audioURL != "" {
+			mu.Lock()
+			result.AudioURL = audioURL
+			mu.Unlock()
+		}
+	})
+	
+	// Extract where the story takes place
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		locationExtractor := &generic.GenericLocationExtractor{}
+		if location, point := locationExtractor.Extract(doc.Selection); location != "" || point != nil {
+			mu.Lock()
+			result.Location = location
+			result.Geo = point
+			mu.Unlock()
+		}
+	})
+	
+	// Extract comment count before cleaners remove the comment section
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		commentCountExtractor := &generic.GenericCommentCountExtractor{}
+		if count := commentCountExtractor.Extract(doc.Selection); count >= 0 {
+			mu.Lock()
+			result.CommentCount = count
+			mu.Unlock()
+		}
+	})
+	
+	// Detect paywalls before cleaners remove overlays and subscribe prompts
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		paywallExtractor := &generic.GenericPaywallExtractor{}
+		if paywallExtractor.Extract(doc.Selection) {
+			mu.Lock()
+			result.Paywalled = true
+			mu.Unlock()
+		}
+	})
+	
+	// Collect OpenGraph, Twitter card and article meta tags
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		socialMetaExtractor := &generic.GenericSocialMetaExtractor{}
+		if socialMeta := socialMetaExtractor.Extract(doc.Selection); socialMeta != nil {
+			mu.Lock()
+			result.SocialMeta = socialMeta
+			mu.Unlock()
+		}
+	})
+	
+	// Detect series pagination from page indicators and rel=last links
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		totalPagesExtractor := &generic.GenericTotalPagesExtractor{}
+		if totalPages := totalPagesExtractor.Extract(doc.Selection); totalPages > 0 {
+			mu.Lock()
+			result.TotalPages = totalPages
+			mu.Unlock()
+		}
+	})
+	
+	// Read recipe and how-to schema when requested
+	if opts.StructuredData {
+		group.Go(func() {
+			defer recoverFieldPanic(opts)
+			structuredDataExtractor := &generic.GenericStructuredDataExtractor{}
+			if structured := structuredDataExtractor.Extract(doc.Selection); structured != nil {
+				mu.Lock()
+				result.Structured = structured
+				mu.Unlock()
+			}
+		})
+	}
+	
+	// Separate authors from editors and other contributors when requested
+	if opts.Contributors {
+		group.Go(func() {
+			defer recoverFieldPanic(opts)
+			contributorsExtractor := &generic.GenericContributorsExtractor{}
+			authors, contributors := contributorsExtractor.Extract(doc.Selection)
+			mu.Lock()
+			result.Authors = authors
+			result.Contributors = contributors
+			mu.Unlock()
+		})
+	}
 	
 	// Wait for site metadata extraction to complete
-	wg.Wait()
+	group.Wait()
 	
 	// Check context after metadata extraction
 	select {
@@ -142,54 +324,65 @@ func (h *Hermes) extractAllFieldsWithContext(ctx context.Context, doc *goquery.D
 	
 	// Try to use custom extractor, passing the result with site metadata
 	if customResult := h.tryCustomExtractor(doc, targetURL, parsedURL, opts, result); customResult != nil {
+		customResult.Breadcrumbs = generic.RemoveSelfBreadcrumb(customResult.Breadcrumbs, customResult.Title)
+		if customResult.Section == "" {
+			customResult.Section = generic.SectionFromBreadcrumbs(customResult.Breadcrumbs)
+		}
+		applyDefaultPageType(customResult)
+		applyLeadImageDimensions(customResult, doc, targetURL)
+		verifyFaviconFallback(ctx, customResult, doc, targetURL, &opts)
+		applyWarnings(customResult, doc, &opts)
 		return customResult, nil
 	}
+	opts.logger().Debugf("no custom extractor for %s, using generic extraction", parsedURL.Host)
 
 	// Parallel extraction for independent fields (meta cache already built)
-	wg.Add(4) // Reset for generic extraction
 
 	// Extract title in parallel
-	go func() {
-		defer wg.Done()
-		if title := generic.GenericTitleExtractor.Extract(doc.Selection, targetURL, metaCache); title != "" {
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		if title, source := generic.ExtractTitleWithSource(doc.Selection, targetURL, metaCache); title != "" {
 			// First apply basic title cleaning
 			cleanedTitle := cleaners.CleanTitle(title, targetURL, doc)
 			// Then apply split title resolution to remove breadcrumbs and site names
 			cleanedTitle = cleaners.ResolveSplitTitle(cleanedTitle, targetURL)
 			mu.Lock()
 			result.Title = cleanedTitle
+			result.setFieldSource("title", source)
 			mu.Unlock()
 		}
-	}()
+	})
 
 	// Extract author in parallel
-	go func() {
-		defer wg.Done()
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
 		authorExtractor := &generic.GenericAuthorExtractor{}
-		if author := authorExtractor.Extract(doc.Selection, metaCache); author != nil && *author != "" {
+		if author, source := authorExtractor.ExtractWithSource(doc.Selection, metaCache); author != nil && *author != "" {
 			cleanedAuthor := cleaners.CleanAuthor(*author)
 			mu.Lock()
 			result.Author = cleanedAuthor
+			result.setFieldSource("author", source)
 			mu.Unlock()
 		}
-	}()
+	})
 
 	// Extract date published in parallel
-	go func() {
-		defer wg.Done()
-		if dateStr := generic.GenericDateExtractor.Extract(doc.Selection, targetURL, metaCache); dateStr != nil && *dateStr != "" {
-			if date, err := parseDate(*dateStr); err == nil {
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		if dateStr, source := generic.GenericDateExtractor.ExtractWithSourceAt(doc.Selection, targetURL, metaCache, opts.Locale, now); dateStr != nil && *dateStr != "" {
+			if date, err := parseDate(*dateStr, opts.Locale, now); err == nil {
 				mu.Lock()
 				result.DatePublished = &date
+				result.setFieldSource("date_published", source)
 				mu.Unlock()
 			}
 		}
-	}()
+	})
 
 	// Extract initial dek (description/subtitle) in parallel
-	go func() {
-		defer wg.Done()
-		dekExtractor := &generic.GenericDekExtractor{}
+	group.Go(func() {
+		defer recoverFieldPanic(opts)
+		dekExtractor := &generic.GenericDekExtractor{AllowURLs: opts.AllowDekURLs}
 		dekOpts := map[string]interface{}{
 			"$": doc.Selection,
 		}
@@ -198,10 +391,10 @@ func (h *Hermes) extractAllFieldsWithContext(ctx context.Context, doc *goquery.D
 			result.Dek = dek
 			mu.Unlock()
 		}
-	}()
+	})
 
 	// Wait for all parallel extractions to complete
-	wg.Wait()
+	group.Wait()
 	
 	// Check context after parallel extraction
 	select {
@@ -217,6 +410,7 @@ func (h *Hermes) extractAllFieldsWithContext(ctx context.Context, doc *goquery.D
 		Content:   "", // Will be set after content extraction
 		MetaCache: make(map[string]string),
 		HTML:      "", // Could enhance with original HTML
+		Options:   opts.LeadImage,
 	}
 	if imageURL := imageExtractor.Extract(imageParams); imageURL != nil && *imageURL != "" {
 		// Use the new cleaner that properly validates URLs
@@ -237,26 +431,25 @@ func (h *Hermes) extractAllFieldsWithContext(ctx context.Context, doc *goquery.D
 		StripUnlikelyCandidates: true,
 		WeightNodes:             true,
 		CleanConditionally:      true,
+		KeepSafeStyles:          opts.KeepSafeStyles,
+		KeepLineBreaks:          opts.ContentType == "markdown",
+		LinkDensityThreshold:    opts.LinkDensityThreshold,
+		ContentHint:             opts.ContentHint,
+		MinParagraphWords:       opts.MinParagraphWords,
+		DebugScores:             opts.DebugScores,
+		KeepEmbedHosts:          opts.KeepEmbedHosts,
 	}
-	if content := contentExtractor.Extract(contentParams, contentOpts); content != "" {
-		// Apply content type conversion with security sanitization
-		switch strings.ToLower(opts.ContentType) {
-		case "text":
-			result.Content = text.NormalizeSpaces(stripHTMLTags(content))
-		case "markdown":
-			result.Content = convertToMarkdown(content)
-		default: // "html" or anything else
-			// Sanitize HTML content to prevent XSS attacks
-			result.Content = security.SanitizeHTML(content)
-		}
-		
-		// Extract excerpt if content exists
-		if result.Content != "" {
-			result.Excerpt = text.ExcerptContent(result.Content, 160)
+	// Metadata-only parses skip candidate scoring, by far the most expensive step
+	var content string
+	if !opts.MetadataOnly {
+		content = contentExtractor.Extract(contentParams, contentOpts)
+		result.DebugScores = contentExtractor.Scores
+	}
+	if content != "" {
+		if err := applyContent(result, content, targetURL, opts); err != nil {
+			return nil, err
 		}
-		
-		// Calculate word count
-		result.WordCount = calculateWordCount(result.Content)
+		result.setFieldSource("content", generic.SourceHeuristic)
 
 		// Update image extraction with content context
 		imageParams.Content = result.Content
@@ -265,7 +458,7 @@ func (h *Hermes) extractAllFieldsWithContext(ctx context.Context, doc *goquery.D
 		}
 
 		// Update dek with excerpt context
-		dekExtractor := &generic.GenericDekExtractor{}
+		dekExtractor := &generic.GenericDekExtractor{AllowURLs: opts.AllowDekURLs}
 		dekOpts := map[string]interface{}{
 			"$":       doc.Selection,
 			"excerpt": result.Excerpt,
@@ -280,13 +473,15 @@ func (h *Hermes) extractAllFieldsWithContext(ctx context.Context, doc *goquery.D
 		// Fallback title extraction
 		if title := doc.Find("title").First().Text(); title != "" {
 			result.Title = cleaners.CleanTitleSimple(strings.TrimSpace(title), targetURL)
+			result.setFieldSource("title", generic.SourceFallback)
 		} else if h1 := doc.Find("h1").First().Text(); h1 != "" {
 			result.Title = strings.TrimSpace(h1)
+			result.setFieldSource("title", generic.SourceFallback)
 		}
 	}
 
 	// Basic validation - content should not be empty for successful extraction
-	if result.Content == "" && opts.Fallback {
+	if result.Content == "" && opts.Fallback && !opts.MetadataOnly {
 		// Try progressively broader fallback selectors
 		fallbackSelectors := []string{
 			"article, .article, #article, .content, #content, .entry-content",
@@ -297,17 +492,51 @@ func (h *Hermes) extractAllFieldsWithContext(ctx context.Context, doc *goquery.D
 		
 		for _, selector := range fallbackSelectors {
 			if basicContent := doc.Find(selector).First().Text(); basicContent != "" {
+				opts.logger().Infof("no article content found for %s, using text of %q", targetURL, selector)
 				result.Content = strings.TrimSpace(basicContent)
 				result.Excerpt = text.ExcerptContent(result.Content, 160)
 				result.WordCount = calculateWordCount(result.Content)
+				result.TotalWordCount = result.WordCount
+				result.setFieldSource("content", generic.SourceFallback)
 				break
 			}
 		}
 	}
 
+	// Drop the final crumb when it just repeats the article title
+	result.Breadcrumbs = generic.RemoveSelfBreadcrumb(result.Breadcrumbs, result.Title)
+
+	// Fall back to the breadcrumb leaf when no section was declared
+	if result.Section == "" {
+		result.Section = generic.SectionFromBreadcrumbs(result.Breadcrumbs)
+	}
+	applyDefaultPageType(result)
+	applyLeadImageDimensions(result, doc, targetURL)
+	verifyFaviconFallback(ctx, result, doc, targetURL, &opts)
+	applyWarnings(result, doc, &opts)
+
 	return result, nil
 }
 
+// applyLeadImageDimensions fills the lead image's declared width and height
+// once its URL is settled
+func applyLeadImageDimensions(result *Result, doc *goquery.Document, targetURL string) {
+	imageExtractor := generic.NewGenericLeadImageExtractor()
+	result.LeadImageWidth, result.LeadImageHeight = imageExtractor.Dimensions(doc, result.Content, result.LeadImageURL, targetURL)
+}
+
+// applyDefaultPageType classifies a page nothing declared or detected as an
+// article when content was found, and unknown otherwise
+func applyDefaultPageType(result *Result) {
+	if result.PageType != "" {
+		return
+	}
+	result.PageType = generic.PageTypeUnknown
+	if result.Content != "" {
+		result.PageType = generic.PageTypeArticle
+	}
+}
+
 // tryCustomExtractor attempts to use a custom extractor for the given domain
 func (h *Hermes) tryCustomExtractor(doc *goquery.Document, targetURL string, parsedURL *url.URL, opts ParserOptions, baseResult *Result) *Result {
 	// Look for custom extractor for this domain using the proper lookup function
@@ -337,8 +566,7 @@ func (h *Hermes) tryCustomExtractor(doc *goquery.Document, targetURL string, par
 		return nil // No custom extractor found
 	}
 	
-	// Log successful custom extractor selection (optional debug)
-	_ = usedDomain // Suppress unused variable warning
+	opts.logger().Debugf("using custom extractor %s for %s", customExtractor.Domain, usedDomain)
 	
 	// Create result with custom extractor info, preserving site metadata from base result
 	result := &Result{
@@ -346,19 +574,37 @@ func (h *Hermes) tryCustomExtractor(doc *goquery.Document, targetURL string, par
 		Domain:        parsedURL.Host,
 		ExtractorUsed: "custom:" + customExtractor.Domain,
 		// Preserve site metadata
-		SiteName:    baseResult.SiteName,
-		SiteTitle:   baseResult.SiteTitle,
-		SiteImage:   baseResult.SiteImage,
-		Favicon:     baseResult.Favicon,
-		Description: baseResult.Description,
-		Language:    baseResult.Language,
+		SiteName:        baseResult.SiteName,
+		SiteTitle:       baseResult.SiteTitle,
+		SiteImage:       baseResult.SiteImage,
+		Favicon:         baseResult.Favicon,
+		Icons:           baseResult.Icons,
+		ThemeColor:      baseResult.ThemeColor,
+		Description:     baseResult.Description,
+		Language:        baseResult.Language,
+		Alternates:      baseResult.Alternates,
+		PublishTimezone: baseResult.PublishTimezone,
+		DateModified:    baseResult.DateModified,
+		PageType:        baseResult.PageType,
+		AudioURL:        baseResult.AudioURL,
+		Breadcrumbs:     baseResult.Breadcrumbs,
+		Section:         baseResult.Section,
+		Location:        baseResult.Location,
+		Geo:             baseResult.Geo,
+		CommentCount:    baseResult.CommentCount,
+		Paywalled:       baseResult.Paywalled,
+		SocialMeta:      baseResult.SocialMeta,
+		Structured:      baseResult.Structured,
+		TotalPages:      baseResult.TotalPages,
+		Authors:         baseResult.Authors,
+		Contributors:    baseResult.Contributors,
 	}
 	
 	// Extract title using custom selectors
 	if customExtractor.Title != nil && len(customExtractor.Title.Selectors) > 0 {
 		for _, selector := range customExtractor.Title.Selectors {
 			if selectorStr, ok := selector.(string); ok {
-				if titleEl := doc.Find(selectorStr).First(); titleEl.Length() > 0 {
+				if titleEl := findSelector(doc, selectorStr).First(); titleEl.Length() > 0 {
 					if title := strings.TrimSpace(titleEl.Text()); title != "" {
 						result.Title = cleaners.CleanTitle(title, targetURL, doc)
 						break
@@ -372,7 +618,7 @@ func (h *Hermes) tryCustomExtractor(doc *goquery.Document, targetURL string, par
 	if customExtractor.Author != nil && len(customExtractor.Author.Selectors) > 0 {
 		for _, selector := range customExtractor.Author.Selectors {
 			if selectorStr, ok := selector.(string); ok {
-				if authorEl := doc.Find(selectorStr).First(); authorEl.Length() > 0 {
+				if authorEl := findSelector(doc, selectorStr).First(); authorEl.Length() > 0 {
 					if author := strings.TrimSpace(authorEl.Text()); author != "" {
 						result.Author = cleaners.CleanAuthor(author)
 						break
@@ -380,7 +626,7 @@ func (h *Hermes) tryCustomExtractor(doc *goquery.Document, targetURL string, par
 				}
 			} else if selectorArray, ok := selector.([]string); ok && len(selectorArray) >= 2 {
 				// Handle array selectors like ["meta[name='author']", "content"]
-				if authorEl := doc.Find(selectorArray[0]).First(); authorEl.Length() > 0 {
+				if authorEl := findSelector(doc, selectorArray[0]).First(); authorEl.Length() > 0 {
 					if author := strings.TrimSpace(authorEl.AttrOr(selectorArray[1], "")); author != "" {
 						result.Author = cleaners.CleanAuthor(author)
 						break
@@ -391,83 +637,51 @@ func (h *Hermes) tryCustomExtractor(doc *goquery.Document, targetURL string, par
 	}
 	
 	// Extract content using custom selectors
-	if customExtractor.Content != nil && len(customExtractor.Content.Selectors) > 0 {
+	if customExtractor.Content != nil && len(customExtractor.Content.Selectors) > 0 && !opts.MetadataOnly {
 		for _, selector := range customExtractor.Content.Selectors {
-			var contentHTML string
-			
+			var matches contentMatches
 			// Handle array selectors (multi-match like [".c-entry-hero .e-image", ".c-entry-intro", ".c-entry-content"])
 			if selectorArray, ok := selector.([]interface{}); ok {
-				var combinedContent strings.Builder
 				for _, selectorItem := range selectorArray {
 					if selectorStr, ok := selectorItem.(string); ok {
-						contentElements := doc.Find(selectorStr)
-						if contentElements.Length() > 0 {
-							contentElements.Each(func(i int, el *goquery.Selection) {
-								if html, err := el.Html(); err == nil && strings.TrimSpace(html) != "" {
-									combinedContent.WriteString(html)
-									combinedContent.WriteString("\n")
-								}
-							})
-						}
+						matches.add(findSelector(doc, selectorStr))
 					}
 				}
-				contentHTML = strings.TrimSpace(combinedContent.String())
 			} else if selectorStr, ok := selector.(string); ok {
 				// Handle single string selectors - get ALL matching elements
-				contentElements := doc.Find(selectorStr)
-				if contentElements.Length() > 0 {
-					var combinedContent strings.Builder
-					contentElements.Each(func(i int, el *goquery.Selection) {
-						if html, err := el.Html(); err == nil && strings.TrimSpace(html) != "" {
-							combinedContent.WriteString(html)
-							combinedContent.WriteString("\n")
-						}
-					})
-					contentHTML = strings.TrimSpace(combinedContent.String())
-				}
+				matches.add(findSelector(doc, selectorStr))
 			}
+			contentHTML := matches.String()
 			
 			// If we found content, process it and break
 			if contentHTML != "" && strings.TrimSpace(contentHTML) != "" {
-				// Apply content type conversion with security sanitization
-				switch strings.ToLower(opts.ContentType) {
-				case "text":
-					result.Content = text.NormalizeSpaces(stripHTMLTags(contentHTML))
-				case "markdown":
-					result.Content = convertToMarkdown(contentHTML)
-				default: // "html" or anything else
-					result.Content = security.SanitizeHTML(contentHTML)
-				}
-				
-				// Extract excerpt if content exists
-				if result.Content != "" {
-					result.Excerpt = text.ExcerptContent(result.Content, 160)
+				// Unparseable content falls back to generic extraction
+				if err := applyContent(result, contentHTML, targetURL, opts); err != nil {
+					return nil
 				}
-				
-				// Calculate word count
-				result.WordCount = calculateWordCount(result.Content)
 				break
 			}
 		}
 	}
 	
 	// Extract date using custom selectors
+	now := opts.now()
 	if customExtractor.DatePublished != nil && len(customExtractor.DatePublished.Selectors) > 0 {
 		for _, selector := range customExtractor.DatePublished.Selectors {
 			// Handle array selectors like [".dateblock time[datetime]", "datetime"]
 			if selectorArray, ok := selector.([]string); ok && len(selectorArray) >= 2 {
-				if dateEl := doc.Find(selectorArray[0]).First(); dateEl.Length() > 0 {
+				if dateEl := findSelector(doc, selectorArray[0]).First(); dateEl.Length() > 0 {
 					if dateStr := strings.TrimSpace(dateEl.AttrOr(selectorArray[1], "")); dateStr != "" {
-						if date, err := parseDate(dateStr); err == nil {
+						if date, err := parseDate(dateStr, opts.Locale, now); err == nil {
 							result.DatePublished = &date
 							break
 						}
 					}
 				}
 			} else if selectorStr, ok := selector.(string); ok {
-				if dateEl := doc.Find(selectorStr).First(); dateEl.Length() > 0 {
+				if dateEl := findSelector(doc, selectorStr).First(); dateEl.Length() > 0 {
 					if dateStr := strings.TrimSpace(dateEl.Text()); dateStr != "" {
-						if date, err := parseDate(dateStr); err == nil {
+						if date, err := parseDate(dateStr, opts.Locale, now); err == nil {
 							result.DatePublished = &date
 							break
 						}
@@ -481,7 +695,7 @@ func (h *Hermes) tryCustomExtractor(doc *goquery.Document, targetURL string, par
 	if customExtractor.LeadImageURL != nil && len(customExtractor.LeadImageURL.Selectors) > 0 {
 		for _, selector := range customExtractor.LeadImageURL.Selectors {
 			if selectorStr, ok := selector.(string); ok {
-				if imageEl := doc.Find(selectorStr).First(); imageEl.Length() > 0 {
+				if imageEl := findSelector(doc, selectorStr).First(); imageEl.Length() > 0 {
 					if imageURL := strings.TrimSpace(imageEl.Text()); imageURL != "" {
 						result.LeadImageURL = cleaners.CleanLeadImageURL(imageURL, targetURL)
 						break
@@ -489,7 +703,7 @@ func (h *Hermes) tryCustomExtractor(doc *goquery.Document, targetURL string, par
 				}
 			} else if selectorArray, ok := selector.([]string); ok && len(selectorArray) >= 2 {
 				// Handle array selectors like ["meta[property='og:image']", "content"]
-				if imageEl := doc.Find(selectorArray[0]).First(); imageEl.Length() > 0 {
+				if imageEl := findSelector(doc, selectorArray[0]).First(); imageEl.Length() > 0 {
 					if imageURL := strings.TrimSpace(imageEl.AttrOr(selectorArray[1], "")); imageURL != "" {
 						result.LeadImageURL = cleaners.CleanLeadImageURL(imageURL, targetURL)
 						break
@@ -499,36 +713,51 @@ func (h *Hermes) tryCustomExtractor(doc *goquery.Document, targetURL string, par
 		}
 	}
 	
+	// Record the fields the custom selectors provided before falling back
+	for field, value := range map[string]bool{
+		"title":          result.Title != "",
+		"author":         result.Author != "",
+		"date_published": result.DatePublished != nil,
+		"content":        result.Content != "",
+	} {
+		if value {
+			result.setFieldSource(field, generic.SourceCustom)
+		}
+	}
+	
 	// Fall back to generic extractors for missing fields if fallback is enabled
 	if opts.Fallback {
 		metaCache := buildMetaCache(doc)
 		
 		// Fallback title extraction
 		if result.Title == "" {
-			if title := generic.GenericTitleExtractor.Extract(doc.Selection, targetURL, metaCache); title != "" {
+			if title, source := generic.ExtractTitleWithSource(doc.Selection, targetURL, metaCache); title != "" {
 				result.Title = cleaners.CleanTitle(title, targetURL, doc)
+				result.setFieldSource("title", source)
 			}
 		}
 		
 		// Fallback author extraction
 		if result.Author == "" {
 			authorExtractor := &generic.GenericAuthorExtractor{}
-			if author := authorExtractor.Extract(doc.Selection, metaCache); author != nil && *author != "" {
+			if author, source := authorExtractor.ExtractWithSource(doc.Selection, metaCache); author != nil && *author != "" {
 				result.Author = cleaners.CleanAuthor(*author)
+				result.setFieldSource("author", source)
 			}
 		}
 		
 		// Fallback date extraction
 		if result.DatePublished == nil {
-			if dateStr := generic.GenericDateExtractor.Extract(doc.Selection, targetURL, metaCache); dateStr != nil && *dateStr != "" {
-				if date, err := parseDate(*dateStr); err == nil {
+			if dateStr, source := generic.GenericDateExtractor.ExtractWithSourceAt(doc.Selection, targetURL, metaCache, opts.Locale, now); dateStr != nil && *dateStr != "" {
+				if date, err := parseDate(*dateStr, opts.Locale, now); err == nil {
 					result.DatePublished = &date
+					result.setFieldSource("date_published", source)
 				}
 			}
 		}
 		
 		// Fallback content extraction if no content was found
-		if result.Content == "" {
+		if result.Content == "" && !opts.MetadataOnly {
 			contentExtractor := generic.NewGenericContentExtractor()
 			contentParams := generic.ExtractorParams{
 				Doc:   doc,
@@ -540,21 +769,21 @@ func (h *Hermes) tryCustomExtractor(doc *goquery.Document, targetURL string, par
 				StripUnlikelyCandidates: true,
 				WeightNodes:             true,
 				CleanConditionally:      true,
+				KeepSafeStyles:          opts.KeepSafeStyles,
+				KeepLineBreaks:          opts.ContentType == "markdown",
+				LinkDensityThreshold:    opts.LinkDensityThreshold,
+				ContentHint:             opts.ContentHint,
+				MinParagraphWords:       opts.MinParagraphWords,
+				DebugScores:             opts.DebugScores,
+				KeepEmbedHosts:          opts.KeepEmbedHosts,
 			}
-			if content := contentExtractor.Extract(contentParams, contentOpts); content != "" {
-				switch strings.ToLower(opts.ContentType) {
-				case "text":
-					result.Content = text.NormalizeSpaces(stripHTMLTags(content))
-				case "markdown":
-					result.Content = convertToMarkdown(content)
-				default:
-					result.Content = security.SanitizeHTML(content)
-				}
-				
-				if result.Content != "" {
-					result.Excerpt = text.ExcerptContent(result.Content, 160)
-					result.WordCount = calculateWordCount(result.Content)
+			content := contentExtractor.Extract(contentParams, contentOpts)
+			result.DebugScores = contentExtractor.Scores
+			if content != "" {
+				if err := applyContent(result, content, targetURL, opts); err != nil {
+					return nil
 				}
+				result.setFieldSource("content", generic.SourceHeuristic)
 			}
 		}
 	}
@@ -585,13 +814,29 @@ func (h *Hermes) tryCustomExtractor(doc *goquery.Document, targetURL string, par
 	if favicon := faviconExtractor.Extract(doc.Selection, targetURL, metaCache); favicon != "" {
 		result.Favicon = favicon
 	}
+	result.Icons = faviconExtractor.ExtractIcons(doc.Selection, targetURL)
+	
+	// Theme color extraction
+	themeColorExtractor := &generic.GenericThemeColorExtractor{}
+	if themeColor := themeColorExtractor.Extract(doc.Selection); themeColor != "" {
+		result.ThemeColor = themeColor
+	}
 	
 	
 	return result
 }
 
 // parseDate parses a date string into a time.Time
-func parseDate(dateStr string) (time.Time, error) {
+// With a locale, ambiguous numeric dates and month names follow that locale,
+// and relative dates are resolved against now
+func parseDate(dateStr string, locale string, now time.Time) (time.Time, error) {
+	if locale != "" {
+		if t, err := text.ParseDateAt(dateStr, locale, now); err == nil {
+			return *t, nil
+		}
+		return time.Time{}, fmt.Errorf("unable to parse date: %s", dateStr)
+	}
+	
 	// Try common date formats
 	formats := []string{
 		time.RFC3339,
@@ -613,7 +858,109 @@ func parseDate(dateStr string) (time.Time, error) {
 	return time.Time{}, fmt.Errorf("unable to parse date: %s", dateStr)
 }
 
-// stripHTMLTags removes HTML tags from content for text output
+// applyContent fills the content-derived fields of result from extracted content HTML.
+// The generic and custom extraction paths both use it so the same content produces
+// identical output regardless of which extractor found it. The content is parsed once;
+// conversion runs last because it may rewrite the parsed document in place.
+func applyContent(result *Result, contentHTML string, targetURL string, opts ParserOptions) error {
+	doc, err := parseFragment(contentHTML)
+	if err != nil {
+		return err
+	}
+
+	result.Videos = extractVideos(doc, targetURL)
+	result.ImageCount, result.EmbedCount = countMedia(doc, opts.KeepEmbedHosts)
+	result.Tables = extractTables(doc)
+	result.Quotes = extractQuotes(doc)
+	applySections(result, doc, opts)
+	proseWordCount := 0
+	if opts.ProseWordCount {
+		proseWordCount = calculateProseWordCount(doc)
+	}
+
+	// Apply content type conversion with security sanitization
+	converted, err := convertContent(doc, contentHTML, opts)
+	if err != nil {
+		return err
+	}
+	result.Content = converted
+	if opts.IncludeRawContent {
+		result.RawContent = contentHTML
+	}
+
+	// Extract excerpt if content exists
+	if result.Content != "" {
+		result.Excerpt = text.ExcerptContent(result.Content, 160)
+	}
+
+	// Calculate word count
+	result.TotalWordCount = calculateWordCount(result.Content)
+	result.WordCount = result.TotalWordCount
+	if opts.ProseWordCount {
+		result.WordCount = proseWordCount
+	}
+	return nil
+}
+
+// convertContent applies the requested content type conversion with security sanitization.
+// doc is the parsed form of content and may be modified; content is only re-rendered
+// from doc when a conversion step changed it.
+func convertContent(doc *goquery.Document, content string, opts ParserOptions) (string, error) {
+	if opts.StripTrackingParams {
+		var err error
+		if content, err = renderFragment(dom.StripTrackingParams(doc)); err != nil {
+			return "", err
+		}
+	}
+
+	switch strings.ToLower(opts.ContentType) {
+	case "text", ContentTypeTextSentences:
+		plain := text.DecodeEntities(dom.TextWithBreaks(doc.Find("body")))
+		if opts.ASCIIQuotes {
+			plain = text.ASCIIQuotes(plain)
+		}
+		if strings.EqualFold(opts.ContentType, ContentTypeTextSentences) {
+			plain = text.SentencePerLine(plain)
+		}
+		return plain, nil
+	case "markdown":
+		// Repair empty list item wrappers so markdown lists indent correctly
+		dom.FlattenNestedLists(doc)
+		if opts.NestHeadings {
+			// Shift content headings one level down so they nest under the title
+			dom.DemoteHeadings(doc)
+		}
+		return convertToMarkdown(doc, opts.logger()), nil
+	case ContentTypeHTMLInlineCSS:
+		return inlineCSS(content)
+	case ContentTypeEPUBChapter:
+		return xhtmlBody(content)
+	default: // "html" or anything else
+		// Sanitize HTML content to prevent XSS attacks
+		return security.SanitizeHTMLWithEmbeds(content, opts.KeepEmbedHosts, opts.KeepSafeStyles), nil
+	}
+}
+
+// parseFragment parses an extracted HTML fragment, wrapping failures in ErrMalformedHTML
+func parseFragment(content string) (*goquery.Document, error) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse content fragment: %w", resource.ErrMalformedHTML, err)
+	}
+	return doc, nil
+}
+
+// renderFragment renders the body of a parsed content fragment back to HTML
+func renderFragment(doc *goquery.Document) (string, error) {
+	html, err := doc.Find("body").Html()
+	if err != nil {
+		return "", fmt.Errorf("%w: failed to render content fragment: %w", resource.ErrMalformedHTML, err)
+	}
+	return html, nil
+}
+
+// stripHTMLTags removes HTML tags from content for text output, collapsing whitespace
+// in prose while keeping pre and code blocks exactly as written
 func stripHTMLTags(content string) string {
 	// Create a temporary document to extract text
 	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
@@ -621,11 +968,49 @@ func stripHTMLTags(content string) string {
 		// If parsing fails, return original content
 		return content
 	}
-	return doc.Text()
+	return dom.TextWithBreaks(doc.Find("body"))
+}
+
+// extractVideos lists the videos embedded in extracted content HTML
+func extractVideos(doc *goquery.Document, targetURL string) []string {
+	videoExtractor := &generic.GenericVideoExtractor{}
+	return videoExtractor.Extract(doc.Selection, targetURL)
+}
+
+// countMedia counts the images in cleaned content HTML and the iframes
+// sanitization keeps, those from video players and embed hosts
+func countMedia(doc *goquery.Document, embedHosts []string) (images, embeds int) {
+	doc.Find("img[src]").Each(func(i int, s *goquery.Selection) {
+		if strings.TrimSpace(s.AttrOr("src", "")) != "" {
+			images++
+		}
+	})
+	doc.Find("iframe[src]").Each(func(i int, s *goquery.Selection) {
+		src, err := url.Parse(strings.TrimSpace(s.AttrOr("src", "")))
+		if err != nil || src.Host == "" || (src.Scheme != "" && src.Scheme != "http" && src.Scheme != "https") {
+			return
+		}
+		if security.IsEmbedURL(src, embedHosts) {
+			embeds++
+		}
+	})
+	return images, embeds
+}
+
+// extractTables converts the tables in extracted content HTML into grids of cell text
+func extractTables(doc *goquery.Document) [][][]string {
+	tableExtractor := &generic.GenericTableExtractor{}
+	return tableExtractor.Extract(doc.Selection)
 }
 
-// convertToMarkdown converts HTML content to Markdown using html-to-markdown library
-func convertToMarkdown(content string) string {
+// extractQuotes lists the blockquotes and pull quotes in extracted content HTML
+func extractQuotes(doc *goquery.Document) []string {
+	quoteExtractor := &generic.GenericQuoteExtractor{}
+	return quoteExtractor.Extract(doc.Selection)
+}
+
+// convertToMarkdown converts parsed HTML content to Markdown using html-to-markdown library
+func convertToMarkdown(doc *goquery.Document, logger Logger) string {
 	// Create converter with options similar to TurndownService
 	converter := md.NewConverter("", true, nil)
 	
@@ -661,17 +1046,31 @@ func convertToMarkdown(content string) string {
 					return &result
 				},
 			},
+			// Single <br> inside a paragraph is a soft line break, not a new paragraph
+			{
+				Filter: []string{"br"},
+				Replacement: func(content string, selec *goquery.Selection, opt *md.Options) *string {
+					return md.String("\n")
+				},
+			},
 		}
 	}))
 	
-	// Convert HTML to Markdown
-	markdown, err := converter.ConvertString(content)
-	if err != nil {
-		// Fallback to text extraction if conversion fails
-		return stripHTMLTags(content)
-	}
+	return markdownOrText(converter, doc, logger)
+}
+
+// markdownOrText converts doc with converter, degrading to plain text
+// when the converter panics on pathological input such as deeply nested
+// or malformed fragments
+func markdownOrText(converter *md.Converter, doc *goquery.Document, logger Logger) (markdown string) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Infof("warning: markdown conversion panicked, falling back to plain text: %v", r)
+			markdown = dom.TextWithBreaks(doc.Find("body"))
+		}
+	}()
 	
-	return markdown
+	return converter.Convert(doc.Selection)
 }
 
 // resolveImageTemplateURL resolves template placeholders in responsive image URLs
@@ -725,23 +1124,33 @@ func calculateWordCount(content string) int {
 	return len(words)
 }
 
+// Elements whose text is not part of the article's running prose
+const nonProseSelector = "figcaption, table, aside, pre"
+
+// calculateProseWordCount counts the words in parsed content outside captions,
+// tables, asides and code blocks, so reading time reflects the running text
+func calculateProseWordCount(doc *goquery.Document) int {
+	body := doc.Find("body").Clone()
+	body.Find(nonProseSelector).Remove()
+	return len(strings.Fields(dom.TextWithBreaks(body)))
+}
+
 // buildMetaCache builds a cache of all meta tag names present in the document
-// This is used to optimize meta tag extraction by only searching for names that exist
+// This is used to optimize meta tag extraction by only searching for names that exist.
+// Both name and property attributes are indexed, so OpenGraph tags such as
+// <meta property="og:title"> resolve through ExtractFromMeta.
 func buildMetaCache(doc *goquery.Document) []string {
 	var metaNames []string
 	seen := make(map[string]bool)
 
 	// Find all meta tags and collect their name and property attributes
 	doc.Find("meta").Each(func(i int, s *goquery.Selection) {
-		// Check name attribute
-		if name, exists := s.Attr("name"); exists && name != "" && !seen[name] {
-			metaNames = append(metaNames, name)
-			seen[name] = true
+		for _, attr := range []string{"name", "property"} {
+			if name, exists := s.Attr(attr); exists && name != "" && !seen[name] {
+				metaNames = append(metaNames, name)
+				seen[name] = true
+			}
 		}
-		
-		// Note: ExtractFromMeta only searches meta[name="..."] not meta[property="..."]
-		// The property attributes (like og:title) are handled differently
-		// We could enhance this to support property attributes in the future
 	})
 
 	return metaNames
@@ -0,0 +1,62 @@
+// ABOUTME: Optional check that the constructed /favicon.ico fallback exists before it is returned
+// ABOUTME: Declared icons are never requested; only the guessed URL costs a HEAD request
+
+package parser
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/BumpyClock/hermes/internal/extractors/generic"
+	"github.com/BumpyClock/hermes/internal/resource"
+	"github.com/BumpyClock/hermes/internal/validation"
+	"github.com/PuerkitoBio/goquery"
+)
+
+// faviconCheckTimeout bounds the HEAD request for the fallback favicon
+const faviconCheckTimeout = 5 * time.Second
+
+// verifyFaviconFallback clears result.Favicon when it is the constructed
+// /favicon.ico fallback and a HEAD request for it does not answer 2xx
+func verifyFaviconFallback(ctx context.Context, result *Result, doc *goquery.Document, targetURL string, opts *ParserOptions) {
+	if !opts.VerifyFavicon || result.Favicon == "" {
+		return
+	}
+	faviconExtractor := &generic.GenericFaviconExtractor{}
+	if faviconExtractor.Declared(doc.Selection, targetURL) != "" {
+		return
+	}
+
+	if !faviconExists(ctx, result.Favicon, opts) {
+		opts.logger().Debugf("dropping favicon %s, it could not be verified", result.Favicon)
+		result.Favicon = ""
+	}
+}
+
+// faviconExists reports whether a HEAD request for faviconURL answers 2xx.
+// The URL gets the same SSRF checks as the page itself.
+func faviconExists(ctx context.Context, faviconURL string, opts *ParserOptions) bool {
+	validationOpts := opts.validationOptions()
+	if err := validation.ValidateURL(ctx, faviconURL, validationOpts); err != nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(validation.ContextWithOptions(ctx, validationOpts), faviconCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, faviconURL, nil)
+	if err != nil {
+		return false
+	}
+	for key, value := range resource.MergeHeaders(opts.Headers) {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := ensureHTTPClient(opts).Client.Do(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return resp.StatusCode >= 200 && resp.StatusCode < 300
+}
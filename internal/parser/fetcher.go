@@ -0,0 +1,109 @@
+// ABOUTME: Document fetching that routes through a caller-supplied FetchFunc or the built-in HTTP pipeline
+// ABOUTME: Fetched bodies go through the same encoding detection, limits and DOM preparation as HTTP responses
+
+package parser
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/BumpyClock/hermes/internal/resource"
+	"github.com/PuerkitoBio/goquery"
+)
+
+// FetchFunc retrieves a page, returning its body, the URL after redirects and the HTTP status code
+type FetchFunc func(ctx context.Context, url string, headers map[string]string) (body io.ReadCloser, finalURL string, statusCode int, err error)
+
+// fetchDocument fetches and prepares the document for targetURL.
+// It returns the URL the document was served from, which differs from targetURL
+// only when a custom fetcher reports a redirect.
+func fetchDocument(ctx context.Context, r *resource.Resource, targetURL string, parsedURL *url.URL, opts *ParserOptions) (*goquery.Document, string, error) {
+	if opts.Fetcher == nil {
+		doc, err := r.CreateWithClient(ctx, targetURL, "", parsedURL, opts.Headers, ensureHTTPClient(opts))
+		return doc, targetURL, err
+	}
+
+	body, finalURL, statusCode, err := opts.Fetcher(ctx, targetURL, opts.Headers)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch resource: %w", err)
+	}
+	if body == nil {
+		return nil, "", fmt.Errorf("failed to fetch resource: fetcher returned no body")
+	}
+	defer body.Close()
+
+	if finalURL == "" {
+		finalURL = targetURL
+	}
+
+	if statusCode == http.StatusNotModified {
+		return nil, "", resource.ErrNotModified
+	}
+
+	maxContentLength := opts.MaxContentLength
+	if maxContentLength <= 0 {
+		maxContentLength = resource.MAX_CONTENT_LENGTH
+	}
+
+	// Read one byte past the limit so oversized bodies are detected rather than truncated
+	data, err := io.ReadAll(io.LimitReader(body, maxContentLength+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read fetched body: %w", err)
+	}
+	if int64(len(data)) > maxContentLength {
+		return nil, "", fmt.Errorf("resource fetch failed: Content for this resource was too large. Maximum content length is %d", maxContentLength)
+	}
+
+	// Fetchers do not report headers, so the body is treated as HTML with its charset sniffed
+	response := &resource.Response{
+		StatusCode: statusCode,
+		Status:     http.StatusText(statusCode),
+		Headers:    http.Header{"Content-Type": {"text/html"}},
+		Body:       data,
+	}
+	if err := resource.ValidateResponseWithLimit(response, false, maxContentLength); err != nil {
+		return nil, "", fmt.Errorf("resource fetch failed: %s", err)
+	}
+	r.Response = response
+
+	doc, err := r.GenerateDocWithContext(ctx, &resource.FetchResult{Response: response})
+	return doc, finalURL, err
+}
+
+// checkContentType rejects a successfully fetched response whose media type is
+// not in opts.AllowedContentTypes. Failed fetches, 304 Not Modified included,
+// must be handled before calling it, since their responses carry no usable type.
+func checkContentType(response *resource.Response, opts *ParserOptions) error {
+	if contentTypeAllowed(response, opts.AllowedContentTypes) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s is not an allowed content type", resource.ErrUnsupportedContentType, response.GetContentType())
+}
+
+// contentTypeAllowed reports whether response's media type is in allowed.
+// Entries match case-insensitively and may use a subtype wildcard such as
+// "text/*". An empty allowlist allows everything.
+func contentTypeAllowed(response *resource.Response, allowed []string) bool {
+	if len(allowed) == 0 || response == nil {
+		return true
+	}
+
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(response.GetContentType(), ";")[0]))
+	if mediaType == "" {
+		return false
+	}
+	for _, entry := range allowed {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == mediaType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(entry, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
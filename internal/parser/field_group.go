@@ -0,0 +1,51 @@
+// ABOUTME: Bounded runner for the per-parse field extractions in extractAllFieldsWithContext
+// ABOUTME: Caps how many extractions run at once, and runs them inline in order when the limit is 1
+
+package parser
+
+import "sync"
+
+// fieldGroup runs extraction tasks on goroutines, at most limit at a time.
+// Tasks do their own locking and panic recovery, as with bare goroutines.
+type fieldGroup struct {
+	wg     sync.WaitGroup
+	slots  chan struct{} // nil when unbounded
+	inline bool
+}
+
+// newFieldGroup creates a fieldGroup for limit concurrent tasks. A limit of
+// 1 runs each task on the caller's goroutine and 0 or less means no limit.
+func newFieldGroup(limit int) *fieldGroup {
+	g := &fieldGroup{inline: limit == 1}
+	if limit > 1 {
+		g.slots = make(chan struct{}, limit)
+	}
+	return g
+}
+
+// Go runs task, blocking until a slot is free when the group is bounded so
+// that no more than limit goroutines exist at once
+func (g *fieldGroup) Go(task func()) {
+	if g.inline {
+		task()
+		return
+	}
+	if g.slots != nil {
+		g.slots <- struct{}{}
+	}
+	g.wg.Add(1)
+	go func() {
+		defer func() {
+			if g.slots != nil {
+				<-g.slots
+			}
+			g.wg.Done()
+		}()
+		task()
+	}()
+}
+
+// Wait blocks until every task started with Go has finished
+func (g *fieldGroup) Wait() {
+	g.wg.Wait()
+}
@@ -0,0 +1,78 @@
+// ABOUTME: Tests for the bounded field extraction runner
+// ABOUTME: Checks the concurrency limit holds, limit 1 runs inline in order, and no limit runs everything
+
+package parser
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFieldGroupLimitsConcurrency(t *testing.T) {
+	for _, limit := range []int{2, 4} {
+		group := newFieldGroup(limit)
+		var running, peak, done int32
+		for i := 0; i < 20; i++ {
+			group.Go(func() {
+				now := atomic.AddInt32(&running, 1)
+				for {
+					old := atomic.LoadInt32(&peak)
+					if now <= old || atomic.CompareAndSwapInt32(&peak, old, now) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				atomic.AddInt32(&running, -1)
+				atomic.AddInt32(&done, 1)
+			})
+		}
+		group.Wait()
+
+		if done != 20 {
+			t.Errorf("limit %d: expected 20 tasks to finish, got %d", limit, done)
+		}
+		if peak > int32(limit) {
+			t.Errorf("limit %d: expected at most %d tasks at once, saw %d", limit, limit, peak)
+		}
+	}
+}
+
+func TestFieldGroupSequential(t *testing.T) {
+	group := newFieldGroup(1)
+	var order []int
+	for i := 0; i < 5; i++ {
+		i := i
+		// Appending without a lock is only safe because tasks run inline
+		group.Go(func() { order = append(order, i) })
+	}
+	group.Wait()
+
+	for i, got := range order {
+		if got != i {
+			t.Fatalf("Expected tasks in order, got %v", order)
+		}
+	}
+	if len(order) != 5 {
+		t.Errorf("Expected 5 tasks to run, got %d", len(order))
+	}
+}
+
+func TestFieldGroupUnbounded(t *testing.T) {
+	group := newFieldGroup(0)
+	var mu sync.Mutex
+	count := 0
+	for i := 0; i < 50; i++ {
+		group.Go(func() {
+			mu.Lock()
+			count++
+			mu.Unlock()
+		})
+	}
+	group.Wait()
+
+	if count != 50 {
+		t.Errorf("Expected 50 tasks to run, got %d", count)
+	}
+}
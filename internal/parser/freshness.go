@@ -0,0 +1,54 @@
+// ABOUTME: Buckets how recently an article was published or updated relative to parse time
+// ABOUTME: Uses the later of DatePublished and DateModified, compared as instants so offsets don't matter
+
+package parser
+
+import "time"
+
+// Freshness labels, from most to least recent
+const (
+	FreshnessBreaking = "breaking"
+	FreshnessToday    = "today"
+	FreshnessThisWeek = "this-week"
+	FreshnessOlder    = "older"
+	FreshnessUnknown  = "unknown"
+)
+
+// Dates this far ahead of the clock still count as just published. Publisher
+// clocks drift, and dates without an offset are read as UTC, so a page
+// published minutes ago west of Greenwich can appear to be from the future.
+const freshnessFutureSkew = 24 * time.Hour
+
+// applyFreshness sets result.Freshness from its dates and the parser clock
+func applyFreshness(result *Result, opts *ParserOptions) {
+	if result == nil {
+		return
+	}
+	result.Freshness = freshnessLabel(result.DatePublished, result.DateModified, opts.now())
+}
+
+// freshnessLabel buckets the age of the later of published and modified at now
+func freshnessLabel(published, modified *time.Time, now time.Time) string {
+	latest := published
+	if modified != nil && (latest == nil || modified.After(*latest)) {
+		latest = modified
+	}
+	if latest == nil || latest.IsZero() {
+		return FreshnessUnknown
+	}
+
+	// Sub compares instants, so differing zones on the two times don't matter
+	age := now.Sub(*latest)
+	switch {
+	case age < -freshnessFutureSkew:
+		return FreshnessUnknown
+	case age < time.Hour:
+		return FreshnessBreaking
+	case age < 24*time.Hour:
+		return FreshnessToday
+	case age < 7*24*time.Hour:
+		return FreshnessThisWeek
+	default:
+		return FreshnessOlder
+	}
+}
@@ -0,0 +1,52 @@
+// ABOUTME: Optional YAML frontmatter prepended to markdown content for static site generators
+// ABOUTME: Writes title, author, date, url and lead image as double-quoted YAML strings
+
+package parser
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// applyFrontmatter prepends a YAML frontmatter block to markdown content
+// when requested. It runs last so summaries and word counts see only the body.
+func applyFrontmatter(result *Result, opts *ParserOptions) {
+	if result == nil || !opts.MarkdownFrontmatter || opts.ContentType != "markdown" || opts.MetadataOnly {
+		return
+	}
+
+	var date string
+	if result.DatePublished != nil {
+		date = result.DatePublished.UTC().Format(time.RFC3339)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("---\n")
+	for _, field := range [...]struct{ key, value string }{
+		{"title", result.Title},
+		{"author", result.Author},
+		{"date", date},
+		{"url", result.URL},
+		{"lead_image", result.LeadImageURL},
+	} {
+		if field.value == "" {
+			continue
+		}
+		sb.WriteString(field.key)
+		sb.WriteString(": ")
+		sb.WriteString(yamlQuote(field.value))
+		sb.WriteString("\n")
+	}
+	sb.WriteString("---\n\n")
+	sb.WriteString(result.Content)
+	result.Content = sb.String()
+}
+
+// yamlQuote writes s as a double-quoted YAML scalar. JSON strings are valid
+// YAML double-quoted scalars, so colons, quotes, # and leading dashes or
+// brackets in titles cannot change the document's structure.
+func yamlQuote(s string) string {
+	quoted, _ := json.Marshal(s)
+	return string(quoted)
+}
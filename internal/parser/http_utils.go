@@ -13,8 +13,8 @@ import (
 // This function eliminates the duplication of HTTP client wrapping logic
 func createHTTPClientWrapper(httpClient *http.Client, headers map[string]string) *resource.HTTPClient {
 	if httpClient == nil {
-		// Should not happen, but defensive programming
-		httpClient = http.DefaultClient
+		// Should not happen, but defensive programming; never fall back to an unpinned client
+		httpClient = resource.CreateDefaultHTTPClient().Client
 	}
 	
 	return &resource.HTTPClient{
@@ -28,12 +28,15 @@ func createHTTPClientWrapper(httpClient *http.Client, headers map[string]string)
 func ensureHTTPClient(opts *ParserOptions) *resource.HTTPClient {
 	if opts.HTTPClient != nil {
 		// Create HTTPClient wrapper for the provided client
-		return createHTTPClientWrapper(opts.HTTPClient, opts.Headers)
+		client := createHTTPClientWrapper(opts.HTTPClient, opts.Headers)
+		client.MaxContentLength = opts.MaxContentLength
+		return client
 	}
 	
 	// Create a default HTTP client when none is provided
 	defaultClient := resource.CreateDefaultHTTPClient()
 	defaultClient.Headers = opts.Headers
+	defaultClient.MaxContentLength = opts.MaxContentLength
 	return defaultClient
 }
 
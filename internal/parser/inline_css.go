@@ -0,0 +1,51 @@
+// ABOUTME: Email-ready HTML output that applies a fixed inline stylesheet to sanitized content
+// ABOUTME: Styles come only from constants here, so no page-supplied CSS survives into the output
+
+package parser
+
+import (
+	"fmt"
+
+	"github.com/BumpyClock/hermes/internal/resource"
+	"github.com/BumpyClock/hermes/internal/utils/security"
+)
+
+// ContentTypeHTMLInlineCSS is the content type for self-contained HTML with inline styles
+const ContentTypeHTMLInlineCSS = "html-inline-css"
+
+// inlineStyles is the minimal stylesheet applied to email output, in selector order
+var inlineStyles = []struct {
+	selector string
+	style    string
+}{
+	{"p", "margin:0 0 1em 0;line-height:1.5"},
+	{"h1, h2, h3, h4, h5, h6", "margin:1.2em 0 0.5em 0;line-height:1.25"},
+	{"blockquote", "margin:1em 0;padding-left:1em;border-left:4px solid #cccccc;color:#555555"},
+	{"img", "max-width:100%;height:auto"},
+	{"ul, ol", "margin:0 0 1em 0;padding-left:1.5em"},
+	{"pre", "padding:0.75em;background:#f6f6f6;white-space:pre-wrap"},
+	{"table", "border-collapse:collapse;margin:0 0 1em 0"},
+	{"th, td", "border:1px solid #dddddd;padding:4px 8px"},
+	{"figcaption", "font-size:0.9em;color:#666666"},
+}
+
+// inlineCSS sanitizes content and gives its elements the fixed email styles.
+// Sanitizing first removes every page style attribute, scripts and event
+// handlers, so the only styles in the output are the constants above.
+func inlineCSS(content string) (string, error) {
+	doc, err := parseFragment(security.SanitizeHTML(content))
+	if err != nil {
+		return "", err
+	}
+
+	body := doc.Find("body")
+	for _, rule := range inlineStyles {
+		body.Find(rule.selector).SetAttr("style", rule.style)
+	}
+
+	html, err := body.Html()
+	if err != nil {
+		return "", fmt.Errorf("%w: failed to render content fragment: %w", resource.ErrMalformedHTML, err)
+	}
+	return html, nil
+}
@@ -0,0 +1,79 @@
+// ABOUTME: Detection of single-page-app shells whose content only appears after JavaScript runs
+// ABOUTME: Flags near-empty pages with SPA root containers or noscript "enable JavaScript" notices
+
+package parser
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ErrJavaScriptRequired is returned when the page is an empty shell rendered client-side
+var ErrJavaScriptRequired = errors.New("page requires JavaScript to render its content")
+
+// Mount points used by common client-side frameworks (React, Vue, Next.js, Nuxt, Gatsby, Angular, Svelte)
+const spaRootSelector = `#root, #app, #__next, #__nuxt, #___gatsby, #svelte, [data-reactroot], [ng-app], [ng-version], app-root`
+
+// Pages with at least this many words of visible body text are never treated as shells
+const jsShellMaxBodyWords = 50
+
+// A framework root with fewer words than this has not been rendered server-side
+const jsShellMaxRootWords = 10
+
+// Phrases in <noscript> blocks asking the reader to turn JavaScript on
+var noscriptJSNotices = []string{
+	"enable javascript",
+	"javascript is required",
+	"javascript is disabled",
+	"requires javascript",
+	"need javascript",
+	"needs javascript",
+	"turn on javascript",
+	"javascript to run",
+	"javascript enabled",
+}
+
+// isJavaScriptShell reports whether doc is a client-rendered shell with no readable content.
+// It must run before extraction, which rewrites the document.
+func isJavaScriptShell(doc *goquery.Document) bool {
+	body := doc.Find("body").First()
+	if body.Length() == 0 {
+		return false
+	}
+
+	// Content-rich pages are never shells, whatever framework rendered them
+	visible := body.Clone()
+	visible.Find("noscript, template").Remove()
+	if len(strings.Fields(visible.Text())) >= jsShellMaxBodyWords {
+		return false
+	}
+
+	emptyRoot := false
+	body.Find(spaRootSelector).EachWithBreak(func(i int, root *goquery.Selection) bool {
+		emptyRoot = len(strings.Fields(root.Text())) < jsShellMaxRootWords
+		return !emptyRoot
+	})
+	if emptyRoot {
+		return true
+	}
+
+	return hasNoscriptJSNotice(doc)
+}
+
+// hasNoscriptJSNotice checks <noscript> blocks for a request to enable JavaScript
+func hasNoscriptJSNotice(doc *goquery.Document) bool {
+	found := false
+	doc.Find("noscript").EachWithBreak(func(i int, s *goquery.Selection) bool {
+		notice := strings.ToLower(strings.Join(strings.Fields(s.Text()), " "))
+		for _, phrase := range noscriptJSNotices {
+			if strings.Contains(notice, phrase) {
+				found = true
+				break
+			}
+		}
+		return !found
+	})
+	return found
+}
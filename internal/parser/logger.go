@@ -0,0 +1,24 @@
+// ABOUTME: Logger interface for parser diagnostics and the no-op default
+// ABOUTME: Keeps the parser silent unless a caller supplies a logger through ParserOptions
+
+package parser
+
+// Logger receives diagnostic messages about extraction decisions
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+}
+
+// nopLogger discards every message
+type nopLogger struct{}
+
+func (nopLogger) Debugf(string, ...interface{}) {}
+func (nopLogger) Infof(string, ...interface{})  {}
+
+// logger returns the configured logger, or a no-op logger when none is set
+func (opts ParserOptions) logger() Logger {
+	if opts.Logger == nil {
+		return nopLogger{}
+	}
+	return opts.Logger
+}
@@ -0,0 +1,80 @@
+// ABOUTME: Tests for markdown conversion degrading to plain text when the converter fails
+// ABOUTME: Simulates a converter panic on a deeply nested fragment and checks the logged warning
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	md "github.com/JohannesKaufmann/html-to-markdown"
+	"github.com/PuerkitoBio/goquery"
+)
+
+// recordingLogger keeps every message it receives
+type recordingLogger struct {
+	messages []string
+}
+
+func (l *recordingLogger) Debugf(format string, args ...interface{}) {
+	l.messages = append(l.messages, fmt.Sprintf(format, args...))
+}
+
+func (l *recordingLogger) Infof(format string, args ...interface{}) {
+	l.messages = append(l.messages, fmt.Sprintf(format, args...))
+}
+
+func TestMarkdownOrTextRecoversFromPanic(t *testing.T) {
+	fragment := strings.Repeat("<ul><li>", 200) + "Deep item" + strings.Repeat("</li></ul>", 200) + "<p>Closing paragraph</p>"
+
+	// Stand in for a converter bug triggered by pathological nesting
+	converter := md.NewConverter("", true, nil)
+	converter.AddRules(md.Rule{
+		Filter: []string{"li"},
+		Replacement: func(content string, selec *goquery.Selection, opt *md.Options) *string {
+			if selec.ParentsFiltered("li").Length() > 100 {
+				panic("list nested too deeply")
+			}
+			return nil
+		},
+	})
+
+	logger := &recordingLogger{}
+	var got string
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Fatalf("Expected the panic to be recovered, got %v", r)
+			}
+		}()
+		got = markdownOrText(converter, mustParseFragment(t, fragment), logger)
+	}()
+
+	if !strings.Contains(got, "Deep item") || !strings.Contains(got, "Closing paragraph") || strings.Contains(got, "<") {
+		t.Errorf("Expected the plain text fallback, got %q", got)
+	}
+	if len(logger.messages) != 1 || !strings.Contains(logger.messages[0], "list nested too deeply") {
+		t.Errorf("Expected one warning naming the panic, got %q", logger.messages)
+	}
+}
+
+func TestConvertToMarkdownPathologicalFragment(t *testing.T) {
+	fragment := strings.Repeat("<ul><li><blockquote>", 300) + "Deep item" + "<p>Unclosed <em>paragraph"
+
+	logger := &recordingLogger{}
+	got := convertToMarkdown(mustParseFragment(t, fragment), logger)
+	if !strings.Contains(got, "Deep item") {
+		t.Errorf("Expected the deeply nested text to survive conversion, got %q", got)
+	}
+}
+
+// mustParseFragment parses an HTML fragment the way applyContent does
+func mustParseFragment(t *testing.T, fragment string) *goquery.Document {
+	t.Helper()
+	doc, err := parseFragment(fragment)
+	if err != nil {
+		t.Fatalf("Failed to parse fragment: %v", err)
+	}
+	return doc
+}
@@ -0,0 +1,103 @@
+// ABOUTME: Follows <meta http-equiv="refresh"> redirect pages to the page they point to
+// ABOUTME: Runs only with FollowMetaRefresh, only for thin pages, and validates every target like the original URL
+
+package parser
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/BumpyClock/hermes/internal/resource"
+	"github.com/BumpyClock/hermes/internal/validation"
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Redirect chains longer than this are abandoned on the last page reached
+const maxMetaRefreshHops = 5
+
+// Pages with more body text than this are real pages that happen to refresh,
+// like live blogs reloading themselves, rather than redirect stubs
+const metaRefreshMaxWords = 50
+
+// metaRefreshRE splits a refresh value such as "0; url='/story'" into its target
+var metaRefreshRE = regexp.MustCompile(`(?i)^\s*[\d.]*\s*[;,]\s*(?:url\s*=\s*)?(.+)$`)
+
+// followMetaRefresh replaces a thin meta refresh redirect page with the page
+// it points to, following chained redirects up to maxMetaRefreshHops. It
+// returns the resource, document and URL to extract from, unchanged when doc
+// is not a redirect page.
+func followMetaRefresh(ctx context.Context, r *resource.Resource, doc *goquery.Document, targetURL string, parsedURL *url.URL, opts *ParserOptions, validationOpts validation.ValidationOptions) (*resource.Resource, *goquery.Document, string, *url.URL, error) {
+	for hops := 0; hops < maxMetaRefreshHops; hops++ {
+		refreshURL := metaRefreshTarget(doc, parsedURL)
+		if refreshURL == "" || refreshURL == targetURL {
+			break
+		}
+		nextParsed, err := url.Parse(refreshURL)
+		if err != nil {
+			break
+		}
+
+		// The target comes from page content, so it gets the same SSRF checks as the original URL
+		if err := validation.ValidateURL(ctx, refreshURL, validationOpts); err != nil {
+			return nil, nil, "", nil, fmt.Errorf("URL validation failed: %w", err)
+		}
+
+		opts.logger().Infof("following meta refresh from %s to %s", targetURL, refreshURL)
+		next := resource.NewResource()
+		nextDoc, finalURL, err := fetchDocument(ctx, next, refreshURL, nextParsed, opts)
+		if err != nil {
+			return nil, nil, "", nil, err
+		}
+		if err := checkContentType(next.Response, opts); err != nil {
+			return nil, nil, "", nil, err
+		}
+		if finalURL != refreshURL {
+			if finalParsed, err := url.Parse(finalURL); err == nil {
+				refreshURL, nextParsed = finalURL, finalParsed
+			}
+		}
+		r, doc, targetURL, parsedURL = next, nextDoc, refreshURL, nextParsed
+	}
+	return r, doc, targetURL, parsedURL, nil
+}
+
+// metaRefreshTarget returns the absolute http(s) URL a redirect page refreshes
+// to, or "" when doc has no refresh target or has content of its own
+func metaRefreshTarget(doc *goquery.Document, base *url.URL) string {
+	var target string
+	doc.Find("meta[http-equiv]").EachWithBreak(func(i int, s *goquery.Selection) bool {
+		if !strings.EqualFold(strings.TrimSpace(s.AttrOr("http-equiv", "")), "refresh") {
+			return true
+		}
+		// Meta tags are normalized, moving content into value
+		matches := metaRefreshRE.FindStringSubmatch(s.AttrOr("value", s.AttrOr("content", "")))
+		if matches == nil {
+			return true
+		}
+		target = strings.Trim(strings.TrimSpace(matches[1]), `'"`)
+		return false
+	})
+	if target == "" {
+		return ""
+	}
+
+	body := doc.Find("body").First().Clone()
+	body.Find("script, style, noscript, template").Remove()
+	if len(strings.Fields(body.Text())) > metaRefreshMaxWords {
+		return ""
+	}
+
+	ref, err := url.Parse(target)
+	if err != nil {
+		return ""
+	}
+	resolved := base.ResolveReference(ref)
+	if resolved.Scheme != "http" && resolved.Scheme != "https" {
+		return ""
+	}
+	resolved.Fragment = ""
+	return resolved.String()
+}
@@ -6,10 +6,12 @@ package parser
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 
+	"github.com/PuerkitoBio/goquery"
 	"github.com/BumpyClock/hermes/internal/resource"
 	"github.com/BumpyClock/hermes/internal/validation"
 )
@@ -47,7 +49,9 @@ func NewParser() *Hermes {
 }
 
 // Parse extracts content from a URL
-func (h *Hermes) Parse(targetURL string, opts *ParserOptions) (*Result, error) {
+func (h *Hermes) Parse(targetURL string, opts *ParserOptions) (result *Result, err error) {
+	defer recoverParsePanic(&result, &err)
+
 	// Use provided options or defaults
 	if opts == nil {
 		opts = &h.options
@@ -58,7 +62,9 @@ func (h *Hermes) Parse(targetURL string, opts *ParserOptions) (*Result, error) {
 }
 
 // ParseWithContext extracts content from a URL with context support
-func (h *Hermes) ParseWithContext(ctx context.Context, targetURL string, opts *ParserOptions) (*Result, error) {
+func (h *Hermes) ParseWithContext(ctx context.Context, targetURL string, opts *ParserOptions) (result *Result, err error) {
+	defer recoverParsePanic(&result, &err)
+
 	// Use provided options or defaults
 	if opts == nil {
 		opts = &h.options
@@ -69,7 +75,9 @@ func (h *Hermes) ParseWithContext(ctx context.Context, targetURL string, opts *P
 }
 
 // ParseHTML extracts content from provided HTML
-func (h *Hermes) ParseHTML(html string, targetURL string, opts *ParserOptions) (*Result, error) {
+func (h *Hermes) ParseHTML(html string, targetURL string, opts *ParserOptions) (result *Result, err error) {
+	defer recoverParsePanic(&result, &err)
+
 	// Use provided options or defaults
 	if opts == nil {
 		opts = &h.options
@@ -80,7 +88,9 @@ func (h *Hermes) ParseHTML(html string, targetURL string, opts *ParserOptions) (
 }
 
 // ParseHTMLWithContext extracts content from provided HTML with context support
-func (h *Hermes) ParseHTMLWithContext(ctx context.Context, html string, targetURL string, opts *ParserOptions) (*Result, error) {
+func (h *Hermes) ParseHTMLWithContext(ctx context.Context, html string, targetURL string, opts *ParserOptions) (result *Result, err error) {
+	defer recoverParsePanic(&result, &err)
+
 	// Use provided options or defaults
 	if opts == nil {
 		opts = &h.options
@@ -90,6 +100,34 @@ func (h *Hermes) ParseHTMLWithContext(ctx context.Context, html string, targetUR
 	return h.parseHTMLWithoutOptimizationContext(ctx, html, targetURL, opts)
 }
 
+// ParseDocumentWithContext extracts content from an already parsed document.
+// The document goes through the same preparation as provided HTML; it is
+// cloned first so the caller's copy is left unchanged.
+func (h *Hermes) ParseDocumentWithContext(ctx context.Context, doc *goquery.Document, targetURL string, opts *ParserOptions) (result *Result, err error) {
+	defer recoverParsePanic(&result, &err)
+
+	// Use provided options or defaults
+	if opts == nil {
+		opts = &h.options
+	}
+
+	parsedURL, err := url.Parse(targetURL)
+	if err != nil {
+		return nil, err
+	}
+
+	clone := goquery.NewDocumentFromNode(doc.Selection.Clone().Get(0))
+	clone.Url = parsedURL
+
+	r := resource.NewResource()
+	prepared, err := r.PrepareDocument(ctx, clone)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.extractDocument(ctx, prepared, targetURL, parsedURL, opts)
+}
+
 // ReturnResult is deprecated - no longer needed without object pooling
 func (h *Hermes) ReturnResult(result *Result) {
 	// No-op - object pooling has been removed
@@ -125,27 +163,59 @@ func (h *Hermes) parseWithoutOptimizationContext(ctx context.Context, targetURL
 	}
 	
 	// Use unified URL validation
-	validationOpts := validation.DefaultValidationOptions()
-	validationOpts.AllowPrivateNetworks = opts.AllowPrivateNetworks
-	validationOpts.AllowLocalhost = opts.AllowPrivateNetworks // Localhost should be allowed when private networks are allowed
-	
+	validationOpts := opts.validationOptions()
 	if err := validation.ValidateURL(ctx, targetURL, validationOpts); err != nil {
 		return nil, fmt.Errorf("URL validation failed: %w", err)
 	}
+	// The default transport checks every connection, redirects and later pages included, against the same rules
+	ctx = validation.ContextWithOptions(ctx, validationOpts)
 	
 	// Create resource instance and fetch content with context
 	r := resource.NewResource()
 	
-	// Use centralized HTTP client creation
-	httpClient := ensureHTTPClient(opts)
+	doc, finalURL, err := fetchDocument(ctx, r, targetURL, parsedURL, opts)
+	if err != nil {
+		// PDFs are rejected by the HTML pipeline, hand them to the PDF extractor when enabled
+		if opts.PDFSupport && errors.Is(err, resource.ErrUnsupportedContentType) && isPDFResponse(r.Response) {
+			if err := checkContentType(r.Response, opts); err != nil {
+				return nil, err
+			}
+			opts.logger().Debugf("extracting %s as PDF", targetURL)
+			return h.parsePDF(targetURL, parsedURL, r.Response, opts)
+		}
+		return nil, err
+	}
+	if err := checkContentType(r.Response, opts); err != nil {
+		return nil, err
+	}
 	
-	doc, err := r.CreateWithClient(ctx, targetURL, "", parsedURL, opts.Headers, httpClient)
+	// Resolve relative links against the page a custom fetcher was redirected to
+	if finalURL != targetURL {
+		if finalParsed, err := url.Parse(finalURL); err == nil {
+			opts.logger().Debugf("%s was served from %s", targetURL, finalURL)
+			targetURL, parsedURL = finalURL, finalParsed
+		}
+	}
+	
+	// Thin redirect stubs are replaced by the page they refresh to
+	if opts.FollowMetaRefresh {
+		r, doc, targetURL, parsedURL, err = followMetaRefresh(ctx, r, doc, targetURL, parsedURL, opts, validationOpts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	
+	result, err := h.extractDocument(ctx, doc, targetURL, parsedURL, opts)
 	if err != nil {
 		return nil, err
 	}
 	
-	// Use the real extraction logic with context
-	return h.extractAllFieldsWithContext(ctx, doc, targetURL, parsedURL, *opts)
+	// Keep cache validators so callers can make conditional requests next time
+	if r.Response != nil {
+		result.ETag = r.Response.GetHeader("ETag")
+		result.LastModified = r.Response.GetHeader("Last-Modified")
+	}
+	return result, nil
 }
 
 // parseHTMLWithoutOptimization performs basic HTML parsing without optimization layers
@@ -176,8 +246,40 @@ func (h *Hermes) parseHTMLWithoutOptimizationContext(ctx context.Context, html,
 		return nil, err
 	}
 	
+	return h.extractDocument(ctx, doc, targetURL, parsedURL, opts)
+}
+
+// extractDocument runs extraction and every post-extraction step on a prepared
+// document. Parse and ParseHTML both finish through it.
+func (h *Hermes) extractDocument(ctx context.Context, doc *goquery.Document, targetURL string, parsedURL *url.URL, opts *ParserOptions) (*Result, error) {
+	// Empty single-page-app shells have nothing to extract without a browser
+	if isJavaScriptShell(doc) {
+		return nil, ErrJavaScriptRequired
+	}
+	
+	// Detect truncation markers and measure the page before extraction mutates the document
+	continueURL := findContinueReadingURL(doc, targetURL, parsedURL, opts)
+	signals := measurePage(doc)
+	
 	// Use the real extraction logic with context
-	return h.extractAllFieldsWithContext(ctx, doc, targetURL, parsedURL, *opts)
+	result, err := h.extractAllFieldsWithContext(ctx, doc, targetURL, parsedURL, *opts)
+	if err != nil {
+		return nil, err
+	}
+	
+	// Classify before expanding so a rejected page never triggers the extra fetch
+	result.IsArticle = signals.isArticle(result, opts)
+	if !result.IsArticle && opts.RejectNonArticles {
+		return nil, ErrNotArticle
+	}
+	result = h.expandTruncated(ctx, result, continueURL, opts)
+	applyTextCleanup(result, opts)
+	applySummary(result, opts)
+	applyFreshness(result, opts)
+	applyContentHash(result, opts)
+	applyFrontmatter(result, opts)
+	applyEPUBChapter(result, opts)
+	return result, nil
 }
 
 
@@ -180,6 +180,28 @@ func TestParserIntegration_ParseHTML_FallbackBehavior(t *testing.T) {
 	}
 }
 
+func TestParserIntegration_ParseHTML_ExplicitFallbackOff(t *testing.T) {
+	parser := New()
+	html := `<html><head><title>Service Status</title></head><body><main>All systems normal</main></body></html>`
+
+	// Otherwise empty options turn fallback on
+	result, err := parser.ParseHTML(html, "https://example.com/status", &ParserOptions{})
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Content != "All systems normal" {
+		t.Fatalf("Expected the heuristic to enable fallback content, got %q", result.Content)
+	}
+
+	result, err = parser.ParseHTML(html, "https://example.com/status", &ParserOptions{FallbackExplicit: true})
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Content != "" {
+		t.Errorf("Expected no fallback content when fallback is explicitly off, got %q", result.Content)
+	}
+}
+
 func TestParserIntegration_ParseHTML_ErrorHandling(t *testing.T) {
 	parser := New()
 	opts := ParserOptions{
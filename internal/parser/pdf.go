@@ -0,0 +1,73 @@
+// ABOUTME: Optional PDF handling that turns application/pdf responses into a Result with plain text content
+// ABOUTME: Runs only when PDFSupport is set; unreadable or encrypted PDFs surface as unsupported content types
+
+package parser
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+
+	"github.com/BumpyClock/hermes/internal/extractors/pdf"
+	"github.com/BumpyClock/hermes/internal/resource"
+	"github.com/BumpyClock/hermes/internal/utils/text"
+)
+
+// isPDFResponse checks the response content type, falling back to the %PDF- file header
+func isPDFResponse(response *resource.Response) bool {
+	if response == nil {
+		return false
+	}
+	contentType := strings.ToLower(response.GetContentType())
+	return strings.Contains(contentType, "application/pdf") || pdf.IsPDF(response.Body)
+}
+
+// parsePDF builds a Result from the text of a PDF response
+func (h *Hermes) parsePDF(targetURL string, parsedURL *url.URL, response *resource.Response, opts *ParserOptions) (*Result, error) {
+	doc, err := pdf.Extract(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", resource.ErrUnsupportedContentType, err)
+	}
+
+	result := &Result{
+		URL:           targetURL,
+		Domain:        parsedURL.Host,
+		Title:         doc.Title,
+		Author:        doc.Author,
+		ExtractorUsed: "pdf",
+		TotalPages:    1,
+		RenderedPages: 1,
+		IsArticle:     true,
+		CommentCount:  -1,
+		ETag:          response.GetHeader("ETag"),
+		LastModified:  response.GetHeader("Last-Modified"),
+	}
+
+	switch opts.ContentType {
+	case "text", "markdown":
+		result.Content = doc.Text()
+	case ContentTypeTextSentences:
+		result.Content = text.SentencePerLine(doc.Text())
+	default:
+		var sb strings.Builder
+		for _, paragraph := range doc.Paragraphs {
+			sb.WriteString("<p>")
+			sb.WriteString(html.EscapeString(paragraph))
+			sb.WriteString("</p>")
+		}
+		result.Content = sb.String()
+	}
+
+	result.Excerpt = text.ExcerptContent(doc.Text(), 160)
+	result.WordCount = len(strings.Fields(doc.Text()))
+	result.TotalWordCount = result.WordCount
+	applyTextCleanup(result, opts)
+	applySummary(result, opts)
+	applyFreshness(result, opts)
+	applyContentHash(result, opts)
+	applyFrontmatter(result, opts)
+	applyEPUBChapter(result, opts)
+
+	return result, nil
+}
@@ -0,0 +1,33 @@
+// ABOUTME: Panic recovery for the extraction pipeline so malformed markup never crashes the caller
+// ABOUTME: Entry points turn panics into ErrExtractionPanic errors; field goroutines log and drop only the failed field
+
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+)
+
+// ErrExtractionPanic is returned when an extractor panics. It points at a bug
+// in an extractor rather than at the page, so it is kept apart from
+// resource.ErrMalformedHTML; both are reported to callers as ErrParse.
+var ErrExtractionPanic = errors.New("extraction panicked")
+
+// recoverParsePanic converts a panic anywhere in a parse into ErrExtractionPanic.
+// Deferred by the public Parse* methods, which must use named results.
+func recoverParsePanic(result **Result, err *error) {
+	if r := recover(); r != nil {
+		*result = nil
+		*err = fmt.Errorf("%w: %v", ErrExtractionPanic, r)
+	}
+}
+
+// recoverFieldPanic keeps a panic in one field extractor goroutine from crashing the
+// process. The field is left unset and the rest of the result is still returned;
+// the panic and its stack go to the configured logger.
+func recoverFieldPanic(opts ParserOptions) {
+	if r := recover(); r != nil {
+		opts.logger().Infof("warning: field extractor panicked, leaving the field unset: %v\n%s", r, debug.Stack())
+	}
+}
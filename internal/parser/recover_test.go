@@ -0,0 +1,45 @@
+// ABOUTME: Tests for panic recovery in parse entry points and field extractor goroutines
+// ABOUTME: Checks the distinct panic error and that field panics are logged with their stack
+
+package parser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/BumpyClock/hermes/internal/resource"
+)
+
+func TestRecoverParsePanic(t *testing.T) {
+	parse := func() (result *Result, err error) {
+		defer recoverParsePanic(&result, &err)
+		panic("extractor bug")
+	}
+
+	result, err := parse()
+	if result != nil {
+		t.Errorf("Expected no result after a panic, got %+v", result)
+	}
+	if !errors.Is(err, ErrExtractionPanic) || !strings.Contains(err.Error(), "extractor bug") {
+		t.Errorf("Expected ErrExtractionPanic naming the panic, got %v", err)
+	}
+	if errors.Is(err, resource.ErrMalformedHTML) {
+		t.Errorf("Expected a panic not to be reported as malformed HTML, got %v", err)
+	}
+}
+
+func TestRecoverFieldPanicLogsStack(t *testing.T) {
+	logger := &recordingLogger{}
+	func() {
+		defer recoverFieldPanic(ParserOptions{Logger: logger})
+		panic("title extractor bug")
+	}()
+
+	if len(logger.messages) != 1 {
+		t.Fatalf("Expected one logged warning, got %q", logger.messages)
+	}
+	if !strings.Contains(logger.messages[0], "title extractor bug") || !strings.Contains(logger.messages[0], "TestRecoverFieldPanicLogsStack") {
+		t.Errorf("Expected the panic value and stack in the warning, got %q", logger.messages[0])
+	}
+}
@@ -9,4 +9,12 @@ func (r *Result) SetError(message string) {
 // IsError checks if result contains an error
 func (r *Result) IsError() bool {
 	return r.Error
-}
\ No newline at end of file
+}
+
+// setFieldSource records where the value of field came from
+func (r *Result) setFieldSource(field, source string) {
+	if r.FieldSources == nil {
+		r.FieldSources = make(map[string]string)
+	}
+	r.FieldSources[field] = source
+}
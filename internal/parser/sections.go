@@ -0,0 +1,85 @@
+// ABOUTME: Splits cleaned article content into sanitized HTML sections at h2/h3 boundaries
+// ABOUTME: Lets progressive readers render an article section by section instead of as one blob
+
+package parser
+
+import (
+	"strings"
+
+	"github.com/BumpyClock/hermes/internal/utils/security"
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+)
+
+// sectionHeadingSelector matches the headings that start a new section
+const sectionHeadingSelector = "h2, h3"
+
+// ContentSection is one heading-delimited part of the article content
+type ContentSection struct {
+	Heading string `json:"heading"` // Heading text, empty for content before the first heading
+	HTML    string `json:"html"`    // Sanitized HTML of the section, starting with its heading element
+}
+
+// applySections fills result.Sections from parsed content when sections were requested
+func applySections(result *Result, doc *goquery.Document, opts ParserOptions) {
+	if !opts.ContentSections {
+		return
+	}
+
+	// Sections are HTML whatever the output format, so sanitize the same way html output is
+	result.Sections = splitSections(doc, func(fragment string) string {
+		return security.SanitizeHTMLWithEmbeds(fragment, opts.KeepEmbedHosts, opts.KeepSafeStyles)
+	})
+}
+
+// splitSections splits parsed content at every h2 or h3, descending through
+// wrapper elements that contain headings so nested markup splits the same way
+// as flat markup. Content before the first heading becomes an untitled section.
+// Each section's HTML is passed through sanitize; sections left empty are dropped.
+func splitSections(doc *goquery.Document, sanitize func(string) string) []ContentSection {
+	var sections []ContentSection
+	var heading string
+	var body strings.Builder
+
+	flush := func() {
+		if fragment := strings.TrimSpace(sanitize(body.String())); fragment != "" {
+			sections = append(sections, ContentSection{Heading: heading, HTML: fragment})
+		}
+		body.Reset()
+	}
+
+	var walk func(*goquery.Selection)
+	walk = func(parent *goquery.Selection) {
+		parent.Contents().Each(func(i int, node *goquery.Selection) {
+			switch {
+			case node.Is(sectionHeadingSelector):
+				flush()
+				heading = headingText(node)
+				writeOuterHTML(&body, node)
+			case node.Find(sectionHeadingSelector).Length() > 0:
+				walk(node)
+			default:
+				writeOuterHTML(&body, node)
+			}
+		})
+	}
+	walk(doc.Find("body"))
+	flush()
+
+	return sections
+}
+
+// headingText returns the visible text of a heading, leaving out the elements
+// whose content sanitization drops
+func headingText(node *goquery.Selection) string {
+	visible := node.Clone()
+	visible.Find("script, style, noscript, template, iframe, object").Remove()
+	return strings.Join(strings.Fields(visible.Text()), " ")
+}
+
+// writeOuterHTML appends the HTML of node, including text nodes, to b
+func writeOuterHTML(b *strings.Builder, node *goquery.Selection) {
+	for _, n := range node.Nodes {
+		_ = html.Render(b, n)
+	}
+}
@@ -0,0 +1,43 @@
+// ABOUTME: Caches custom extractor selectors compiled by cascadia so each is parsed once per process
+// ABOUTME: Safe for concurrent parses; selectors that fail to compile are cached as matching nothing
+
+package parser
+
+import (
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/andybalholm/cascadia"
+)
+
+// compiledSelectors maps selector text to its compiled cascadia.Selector, or
+// to nil when the text is not a valid selector. A compiled selector depends
+// only on its text, so extractors declaring the same selector share an entry,
+// and the cache is bounded by the selectors registered extractors declare.
+var compiledSelectors sync.Map
+
+// compileSelector returns the cached compiled form of selector, compiling it
+// on first use. ok is false for invalid selectors.
+func compileSelector(selector string) (cascadia.Selector, bool) {
+	if cached, found := compiledSelectors.Load(selector); found {
+		compiled, _ := cached.(cascadia.Selector)
+		return compiled, compiled != nil
+	}
+
+	compiled, err := cascadia.Compile(selector)
+	if err != nil {
+		compiled = nil
+	}
+	compiledSelectors.Store(selector, compiled)
+	return compiled, compiled != nil
+}
+
+// findSelector is doc.Find with the selector compiled once and cached. Like
+// doc.Find, an invalid selector matches nothing.
+func findSelector(doc *goquery.Document, selector string) *goquery.Selection {
+	compiled, ok := compileSelector(selector)
+	if !ok {
+		return doc.FindNodes()
+	}
+	return doc.FindMatcher(compiled)
+}
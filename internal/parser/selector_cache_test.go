@@ -0,0 +1,162 @@
+// ABOUTME: Tests for the compiled selector cache used by custom extractors
+// ABOUTME: Checks results match goquery's own Find, invalid selectors, concurrent use, and benchmarks parsing one domain
+
+package parser
+
+import (
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/BumpyClock/hermes/internal/extractors/custom"
+	"github.com/PuerkitoBio/goquery"
+)
+
+const selectorCacheHTML = `<html><body>
+	<h1 class="headline">Council Passes Budget</h1>
+	<div class="byline"><a rel="author">Jane Reporter</a></div>
+	<article><p>First.</p><p>Second.</p></article>
+</body></html>`
+
+func TestFindSelectorMatchesFind(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(selectorCacheHTML))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+
+	for _, selector := range []string{"h1.headline", ".byline a[rel=author]", "article p", "h1, article p", "missing"} {
+		// Twice, so the second lookup comes from the cache
+		for i := 0; i < 2; i++ {
+			want := doc.Find(selector)
+			got := findSelector(doc, selector)
+			if got.Length() != want.Length() {
+				t.Fatalf("%q: expected %d matches, got %d", selector, want.Length(), got.Length())
+			}
+			for j := range want.Nodes {
+				if got.Nodes[j] != want.Nodes[j] {
+					t.Errorf("%q: match %d differs from doc.Find", selector, j)
+				}
+			}
+		}
+	}
+}
+
+func TestFindSelectorInvalidSelector(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(selectorCacheHTML))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if got := findSelector(doc, "h1[["); got.Length() != 0 {
+			t.Errorf("Expected an invalid selector to match nothing, got %d matches", got.Length())
+		}
+	}
+	if _, ok := compileSelector("h1[["); ok {
+		t.Error("Expected compileSelector to report an invalid selector")
+	}
+}
+
+func TestFindSelectorConcurrentUse(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(selectorCacheHTML))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := findSelector(doc, "article > p:nth-child(2)").Text(); got != "Second." {
+				t.Errorf("Expected %q, got %q", "Second.", got)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// customSelectorsFor returns the plain string selectors a custom extractor declares
+func customSelectorsFor(b *testing.B, domain string) []string {
+	extractor, found := custom.GetCustomExtractorByDomain(domain)
+	if !found {
+		b.Skip("No custom extractor for", domain)
+	}
+
+	var selectors []string
+	for _, field := range []*custom.FieldExtractor{extractor.Title, extractor.Author, extractor.DatePublished, extractor.LeadImageURL} {
+		if field == nil {
+			continue
+		}
+		for _, selector := range field.Selectors {
+			switch s := selector.(type) {
+			case string:
+				selectors = append(selectors, s)
+			case []string:
+				selectors = append(selectors, s[0])
+			}
+		}
+	}
+	return selectors
+}
+
+// loadNYTimesFixture parses the nytimes.com fixture, which has a custom extractor
+func loadNYTimesFixture(b *testing.B) *goquery.Document {
+	html, err := os.ReadFile("../fixtures/www.nytimes.com.html")
+	if err != nil {
+		b.Skip("Fixture file not available:", err)
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(html)))
+	if err != nil {
+		b.Fatal(err)
+	}
+	return doc
+}
+
+// Benchmarks running one domain's custom selectors over many parses, with
+// selectors compiled on every lookup as before and compiled once from the cache
+func BenchmarkCustomSelectorsUncompiled(b *testing.B) {
+	doc := loadNYTimesFixture(b)
+	selectors := customSelectorsFor(b, "www.nytimes.com")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, selector := range selectors {
+			doc.Find(selector).First()
+		}
+	}
+}
+
+func BenchmarkCustomSelectorsCached(b *testing.B) {
+	doc := loadNYTimesFixture(b)
+	selectors := customSelectorsFor(b, "www.nytimes.com")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, selector := range selectors {
+			findSelector(doc, selector).First()
+		}
+	}
+}
+
+// BenchmarkParseHTMLCustomExtractor parses the same domain repeatedly through
+// tryCustomExtractor, as a server handling one site would
+func BenchmarkParseHTMLCustomExtractor(b *testing.B) {
+	html, err := os.ReadFile("../fixtures/www.nytimes.com.html")
+	if err != nil {
+		b.Skip("Fixture file not available:", err)
+	}
+	p := New()
+	htmlStr := string(html)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := p.ParseHTML(htmlStr, "https://www.nytimes.com/2016/09/20/us/politics/benchmark.html", &ParserOptions{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+// ABOUTME: The SSRF rules every URL the parser fetches is checked against
+// ABOUTME: Built from ParserOptions in one place so the main page, redirects and follow-on fetches agree
+
+package parser
+
+import "github.com/BumpyClock/hermes/internal/validation"
+
+// validationOptions returns the SSRF rules for these options
+func (opts ParserOptions) validationOptions() validation.ValidationOptions {
+	return validation.SSRFOptions(opts.AllowPrivateNetworks, opts.SSRFAllowHosts, opts.SSRFDenyHosts)
+}
@@ -0,0 +1,63 @@
+// ABOUTME: Optional extractive summary of the cleaned article content
+// ABOUTME: Runs only when SummarySentences is set and falls back to the excerpt for short content
+
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/BumpyClock/hermes/internal/utils/text"
+	"github.com/PuerkitoBio/goquery"
+)
+
+var (
+	// Markdown syntax stripped before summarizing: links/images, emphasis, headings, quotes and list markers
+	markdownLinkRegex   = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+	markdownMarkerRegex = regexp.MustCompile(`(?m)^\s*(#{1,6}|>|[-*+]|\d+\.)\s+`)
+	markdownEmphasis    = strings.NewReplacer("**", "", "__", "", "`", "")
+)
+
+// applySummary fills result.Summary when a summary was requested
+func applySummary(result *Result, opts *ParserOptions) {
+	if result == nil || opts.SummarySentences <= 0 {
+		return
+	}
+
+	result.Summary = text.Summarize(summaryText(result.Content, opts.ContentType), opts.SummarySentences)
+	if result.Summary == "" {
+		result.Summary = result.Excerpt
+	}
+}
+
+// summaryText converts content in any output format to plain text with blank lines between blocks
+func summaryText(content, contentType string) string {
+	switch contentType {
+	case "text", ContentTypeTextSentences:
+		return content
+	case "markdown":
+		content = markdownLinkRegex.ReplaceAllString(content, "$1")
+		content = markdownMarkerRegex.ReplaceAllString(content, "")
+		return markdownEmphasis.Replace(content)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return ""
+	}
+
+	var blocks []string
+	doc.Find("p, li, blockquote, h1, h2, h3, h4, h5, h6, pre, td").Each(func(i int, s *goquery.Selection) {
+		// Nested blocks are read through their innermost element
+		if s.Find("p, li, blockquote, pre, td").Length() > 0 {
+			return
+		}
+		if block := strings.TrimSpace(s.Text()); block != "" {
+			blocks = append(blocks, block)
+		}
+	})
+	if len(blocks) == 0 {
+		return doc.Text()
+	}
+	return strings.Join(blocks, "\n\n")
+}
@@ -0,0 +1,42 @@
+// ABOUTME: Removes zero-width and control characters from text fields, and emoji when requested
+// ABOUTME: Applies to metadata text and to content in the text and markdown formats, never to HTML
+
+package parser
+
+import (
+	"strings"
+
+	"github.com/BumpyClock/hermes/internal/utils/text"
+)
+
+// applyTextCleanup strips invisible characters from the result's text
+// fields, and emoji as well with StripEmoji. It runs before summaries and
+// hashes so they are built from the cleaned text.
+func applyTextCleanup(result *Result, opts *ParserOptions) {
+	if result == nil {
+		return
+	}
+
+	clean := text.StripInvisible
+	if opts.StripEmoji {
+		clean = func(s string) string {
+			return text.StripEmoji(text.StripInvisible(s))
+		}
+	}
+	for _, field := range []*string{
+		&result.Title,
+		&result.Author,
+		&result.Dek,
+		&result.Excerpt,
+		&result.Description,
+		&result.SiteName,
+		&result.SiteTitle,
+	} {
+		*field = strings.TrimSpace(clean(*field))
+	}
+
+	switch strings.ToLower(opts.ContentType) {
+	case "text", ContentTypeTextSentences, "markdown":
+		result.Content = clean(result.Content)
+	}
+}
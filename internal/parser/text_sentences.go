@@ -0,0 +1,8 @@
+// ABOUTME: Plain text output with one sentence per line, for NLP pipelines that tokenize by line
+// ABOUTME: Sentence boundaries come from the same splitter the summary uses, abbreviations included
+
+package parser
+
+// ContentTypeTextSentences is the content type for plain text with each
+// sentence on its own line and blank lines between paragraphs
+const ContentTypeTextSentences = "text-sentences"
@@ -0,0 +1,128 @@
+// ABOUTME: Truncated-article expansion that follows "continue reading" links to fetch the full body
+// ABOUTME: Runs only when ExpandTruncated or FetchAllPages is set and merges the full content into the teaser result
+
+package parser
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/BumpyClock/hermes/internal/extractors/generic"
+	"github.com/BumpyClock/hermes/internal/resource"
+	"github.com/BumpyClock/hermes/internal/validation"
+	"github.com/PuerkitoBio/goquery"
+)
+
+// findContinueReadingURL detects a truncation marker before extraction mutates the document
+func findContinueReadingURL(doc *goquery.Document, targetURL string, parsedURL *url.URL, opts *ParserOptions) string {
+	if (!opts.ExpandTruncated && !opts.FetchAllPages) || opts.MetadataOnly {
+		return ""
+	}
+
+	extractor := generic.NewGenericContinueReadingExtractor()
+	return extractor.Extract(doc, targetURL, parsedURL)
+}
+
+// expandTruncated fetches the full article behind continueURL and merges it into result.
+// Any failure leaves the teaser result untouched so truncation handling never breaks a parse.
+func (h *Hermes) expandTruncated(ctx context.Context, result *Result, continueURL string, opts *ParserOptions) *Result {
+	if continueURL == "" || result == nil {
+		return result
+	}
+
+	parsedURL, err := url.Parse(continueURL)
+	if err != nil {
+		return result
+	}
+
+	// The continue URL comes from page content, so it gets the same SSRF checks as the original URL
+	validationOpts := opts.validationOptions()
+	if err := validation.ValidateURL(ctx, continueURL, validationOpts); err != nil {
+		opts.logger().Debugf("skipping continue-reading link %s: %v", continueURL, err)
+		return result
+	}
+
+	// A stalled full page is abandoned so the teaser can still be returned
+	fetchCtx := validation.ContextWithOptions(ctx, validationOpts)
+	if opts.PageTimeout > 0 {
+		var cancel context.CancelFunc
+		fetchCtx, cancel = context.WithTimeout(fetchCtx, opts.PageTimeout)
+		defer cancel()
+	}
+
+	r := resource.NewResource()
+	opts.logger().Infof("fetching full article from %s", continueURL)
+	doc, _, err := fetchDocument(fetchCtx, r, continueURL, parsedURL, opts)
+	if err != nil {
+		opts.logger().Debugf("fetching %s failed, keeping truncated content: %v", continueURL, err)
+		return result
+	}
+
+	// Never follow a second continue link from the full page
+	fullOpts := *opts
+	fullOpts.ExpandTruncated = false
+	fullOpts.FetchAllPages = false
+
+	full, err := h.extractAllFieldsWithContext(ctx, doc, continueURL, parsedURL, fullOpts)
+	if err != nil || full == nil {
+		return result
+	}
+
+	return mergeTruncatedResult(result, full)
+}
+
+// mergeTruncatedResult replaces the teaser body with the full body when the full page has more
+// content, keeping the teaser's metadata and filling in any fields it was missing. Every field
+// derived from the content is taken from full so the merged result agrees with its Content.
+func mergeTruncatedResult(teaser, full *Result) *Result {
+	if full.WordCount <= teaser.WordCount {
+		return teaser
+	}
+
+	teaser.Content = full.Content
+	teaser.RawContent = full.RawContent
+	teaser.Excerpt = full.Excerpt
+	teaser.WordCount = full.WordCount
+	teaser.TotalWordCount = full.TotalWordCount
+	teaser.ImageCount = full.ImageCount
+	teaser.EmbedCount = full.EmbedCount
+	teaser.Videos = full.Videos
+	teaser.Tables = full.Tables
+	teaser.Quotes = full.Quotes
+	teaser.Sections = full.Sections
+	teaser.DebugScores = full.DebugScores
+	takeFieldSource(teaser, full, "content")
+
+	if teaser.Title == "" {
+		teaser.Title = full.Title
+		takeFieldSource(teaser, full, "title")
+	}
+	if teaser.Author == "" {
+		teaser.Author = full.Author
+		takeFieldSource(teaser, full, "author")
+	}
+	if teaser.DatePublished == nil {
+		teaser.DatePublished = full.DatePublished
+		takeFieldSource(teaser, full, "date_published")
+	}
+	if teaser.LeadImageURL == "" {
+		teaser.LeadImageURL = full.LeadImageURL
+		teaser.LeadImageWidth = full.LeadImageWidth
+		teaser.LeadImageHeight = full.LeadImageHeight
+	}
+	if teaser.Dek == "" {
+		teaser.Dek = full.Dek
+	}
+
+	return teaser
+}
+
+// takeFieldSource records full's source for field on teaser, or drops the
+// teaser's own when full has none
+func takeFieldSource(teaser, full *Result, field string) {
+	if source, ok := full.FieldSources[field]; ok {
+		teaser.setFieldSource(field, source)
+		return
+	}
+	delete(teaser.FieldSources, field)
+}
@@ -0,0 +1,63 @@
+// ABOUTME: Tests for merging the full article into a truncated teaser result
+// ABOUTME: Checks that every content-derived field comes from the full page while teaser metadata stays
+
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/BumpyClock/hermes/internal/extractors/generic"
+)
+
+func TestMergeTruncatedResult(t *testing.T) {
+	teaser := &Result{
+		Title:      "Teaser Title",
+		Content:    "<p>Short teaser</p>",
+		RawContent: "<p>Short teaser</p>",
+		WordCount:  2,
+		Videos:     []string{"https://www.youtube.com/embed/teaser"},
+		Tables:     [][][]string{{{"teaser"}}},
+		Quotes:     []string{"Teaser quote"},
+		Sections:   []ContentSection{{Heading: "Teaser", HTML: "<p>Short teaser</p>"}},
+		FieldSources: map[string]string{
+			"title":   generic.SourceMeta,
+			"content": generic.SourceFallback,
+		},
+	}
+	full := &Result{
+		Title:      "Full Title",
+		Author:     "Jane Doe",
+		Content:    "<h2>Body</h2><p>The full article body with many more words</p>",
+		RawContent: "<h2>Body</h2><p>The full article body with many more words</p>",
+		WordCount:  9,
+		Quotes:     []string{"Full quote"},
+		Sections:   []ContentSection{{Heading: "Body", HTML: "<h2>Body</h2><p>The full article body with many more words</p>"}},
+		FieldSources: map[string]string{
+			"title":   generic.SourceSelector,
+			"author":  generic.SourceByline,
+			"content": generic.SourceHeuristic,
+		},
+	}
+
+	merged := mergeTruncatedResult(teaser, full)
+
+	if merged.Title != "Teaser Title" || merged.FieldSources["title"] != generic.SourceMeta {
+		t.Errorf("Expected the teaser title and its source to stay, got %q from %q", merged.Title, merged.FieldSources["title"])
+	}
+	if merged.Author != "Jane Doe" || merged.FieldSources["author"] != generic.SourceByline {
+		t.Errorf("Expected the missing author to come from the full page, got %q from %q", merged.Author, merged.FieldSources["author"])
+	}
+	if merged.Content != full.Content || merged.RawContent != full.RawContent || merged.WordCount != full.WordCount {
+		t.Errorf("Expected the full content, got %q", merged.Content)
+	}
+	if merged.FieldSources["content"] != generic.SourceHeuristic {
+		t.Errorf("Expected the full page's content source, got %q", merged.FieldSources["content"])
+	}
+	if merged.Videos != nil || merged.Tables != nil {
+		t.Errorf("Expected the teaser's videos and tables to be dropped, got %v and %v", merged.Videos, merged.Tables)
+	}
+	if !reflect.DeepEqual(merged.Quotes, full.Quotes) || !reflect.DeepEqual(merged.Sections, full.Sections) {
+		t.Errorf("Expected quotes and sections from the full page, got %v and %v", merged.Quotes, merged.Sections)
+	}
+}
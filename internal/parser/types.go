@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
+	"github.com/BumpyClock/hermes/internal/extractors/generic"
+	"github.com/BumpyClock/hermes/internal/utils/dom"
 )
 
 // Parser is the main interface for content extraction
@@ -17,41 +19,117 @@ type Parser interface {
 // ParserOptions configures the parser behavior
 type ParserOptions struct {
 	FetchAllPages        bool              // Fetch and merge multi-page articles
-	Fallback             bool              // Use generic extractor as fallback
-	ContentType          string            // Output format: "html", "markdown", "text"
+	Fallback             bool              // Use generic extractor as fallback; turned on for otherwise empty options unless FallbackExplicit
+	FallbackExplicit     bool              // Fallback was set deliberately, so the empty-options heuristic never overrides it
+	ContentType          string            // Output format: "html", "html-inline-css", "epub-chapter", "markdown", "text", "text-sentences"
 	Headers              map[string]string         // Custom HTTP headers
 	CustomExtractor      *CustomExtractor          // Custom extraction rules
 	Extend               map[string]ExtractorFunc  // Extended fields
 	HTTPClient           *http.Client              // HTTP client to use for requests
 	AllowPrivateNetworks bool                      // Allow SSRF to private networks (default: false)
+	ExpandTruncated      bool                      // Follow "continue reading" links to fetch the full article
+	KeepSafeStyles       bool                      // Keep allowlisted inline styles (text-align, font-style, font-weight)
+	PDFSupport           bool                      // Extract text from application/pdf responses instead of rejecting them
+	Locale               string                    // Locale for ambiguous dates and month names (e.g. "en-GB", "fr"), empty for US
+	SummarySentences     int                       // Sentences in the extractive summary, 0 disables summarization
+	Fetcher              FetchFunc                 // Custom page fetcher, nil uses the built-in HTTP client
+	MaxContentLength     int64                     // Maximum response body size after decompression, 0 uses the 5 MB default
+	PageTimeout          time.Duration             // Deadline for each follow-on page fetch, 0 leaves only the overall deadline
+	StripTrackingParams  bool                      // Remove utm_*, fbclid, gclid and similar params from content links and images
+	LinkDensityThreshold float64                   // Link density above which well-scored content blocks are cleaned, 0 uses the 0.5 default
+	IncludeRawContent    bool                      // Also return the extracted HTML before sanitization in Result.RawContent
+	ProseWordCount       bool                      // Count only prose in WordCount, skipping captions, tables, asides and code blocks
+	Logger               Logger                    // Receives debug and info messages, nil discards them
+	NestHeadings         bool                      // Demote markdown content headings one level so they nest under an h1 title
+	LeadImage            generic.ImageOptions      // Minimum size for lead images picked from content, zero values disable
+	StructuredData       bool                      // Extract Recipe and HowTo JSON-LD into Result.Structured
+	ContentHint          string                    // Selector to favor when ranking generic content candidates
+	ContentSections      bool                      // Split the cleaned content into sanitized HTML sections at h2/h3 boundaries
+	ASCIIQuotes          bool                      // Replace curly quotes with ASCII quotes in text output
+	RejectNonArticles    bool                      // Return ErrNotArticle for pages classified as home pages or listings
+	MinParagraphWords    int                       // Remove generic content paragraphs with fewer words, 0 keeps all
+	AllowedContentTypes  []string                  // Media types a fetched response may have, e.g. "text/html"; empty allows any supported type
+	Contributors         bool                      // Fill Result.Authors and Result.Contributors with role-qualified credits
+	MarkdownFrontmatter  bool                      // Prepend a YAML frontmatter block of metadata to markdown content
+	SSRFAllowHosts       []string                  // Hosts, *.domain wildcards, IPs or CIDRs reachable despite the private network block
+	SSRFDenyHosts        []string                  // Hosts, *.domain wildcards, IPs or CIDRs always refused, overriding SSRFAllowHosts
+	AllowDekURLs         bool                      // Keep deks that mention a plain-text URL instead of rejecting them
+	DebugScores          bool                      // Fill Result.DebugScores with the generic content extractor's top scored candidates
+	Clock                func() time.Time          // Current time for relative dates and Freshness, nil uses time.Now
+	MetadataOnly         bool                      // Extract metadata only, skipping content extraction and follow-on page fetches
+	FollowMetaRefresh    bool                      // Fetch and extract the target of thin <meta http-equiv="refresh"> redirect pages
+	StripEmoji           bool                      // Remove emoji from text fields and text or markdown content
+	KeepEmbedHosts       []string                  // Iframe hosts kept in content besides video players and security.DefaultEmbedHosts
+	VerifyFavicon        bool                      // HEAD the /favicon.ico fallback used when no icon is declared and drop it unless it answers 2xx
+
+	// Field extractions run at once per parse, 1 runs them in order and 0 means no limit
+	MaxExtractionConcurrency int
 }
 
 // Result contains the extracted article data
 type Result struct {
 	Title          string                 `json:"title"`
 	Content        string                 `json:"content"`
+	RawContent     string                 `json:"raw_content,omitempty"` // Extracted HTML before sanitization, unsafe to render
 	Author         string                 `json:"author"`
 	DatePublished  *time.Time            `json:"date_published"`
+	DateModified   *time.Time            `json:"date_modified,omitempty"`
+	Freshness      string                `json:"freshness"` // breaking, today, this-week, older or unknown
+	PublishTimezone string               `json:"publish_timezone,omitempty"`
+	Authors        []generic.AuthorInfo  `json:"authors,omitempty"`
+	Contributors   []generic.AuthorInfo  `json:"contributors,omitempty"`
 	LeadImageURL   string                `json:"lead_image_url"`
+	LeadImageWidth  int                  `json:"lead_image_width,omitempty"`
+	LeadImageHeight int                  `json:"lead_image_height,omitempty"`
 	Dek            string                `json:"dek"`
 	NextPageURL    string                `json:"next_page_url"`
 	URL            string                `json:"url"`
 	Domain         string                `json:"domain"`
 	Excerpt        string                `json:"excerpt"`
+	Summary        string                `json:"summary,omitempty"`
+	ContentHash    string                `json:"content_hash,omitempty"` // SHA-256 of the content's whitespace-collapsed text
 	WordCount      int                   `json:"word_count"`
+	TotalWordCount int                   `json:"total_word_count"` // Every word in the content, including captions and tables
+	CommentCount   int                   `json:"comment_count"` // -1 when the page does not expose a count
+	Paywalled      bool                  `json:"paywalled"`
+	IsArticle      bool                  `json:"is_article"` // False for pages that look like home pages or listings
+	PageType       string                `json:"page_type"`  // article, blog, product, video or unknown
 	Direction      string                `json:"direction"`
 	TotalPages     int                   `json:"total_pages"`
 	RenderedPages  int                   `json:"rendered_pages"`
 	ExtractorUsed  string                `json:"extractor_used,omitempty"`
+	FieldSources   map[string]string     `json:"field_sources,omitempty"` // Where title, author, date_published and content came from
+	Warnings       []string              `json:"warnings,omitempty"`      // Non-fatal extraction issues such as a language that does not match the content
+	Structured     map[string]interface{} `json:"structured,omitempty"`   // Recipe and HowTo data from JSON-LD
 	Extended       map[string]interface{} `json:"extended,omitempty"`
+	DebugScores    []dom.CandidateScore  `json:"debug_scores,omitempty"` // Top scored generic content candidates, set with DebugScores
 	
 	// Site metadata fields
 	SiteName       string                `json:"site_name"`
 	SiteTitle      string                `json:"site_title"`
 	SiteImage      string                `json:"site_image"`
 	Favicon        string                `json:"favicon"`
+	Icons          []generic.IconInfo    `json:"icons,omitempty"`
+	ThemeColor     string                `json:"theme_color,omitempty"` // Declared meta theme-color, the light scheme one when scoped
 	Description    string                `json:"description"`
 	Language       string                `json:"language"`
+	Alternates     map[string]string     `json:"alternates,omitempty"`
+	Breadcrumbs    []string              `json:"breadcrumbs,omitempty"`
+	Section        string                `json:"section,omitempty"`
+	Location       string                `json:"location,omitempty"` // Where the story takes place, from JSON-LD or geo meta tags
+	Geo            *generic.GeoPoint     `json:"geo,omitempty"`
+	SocialMeta     map[string]string     `json:"social_meta,omitempty"`
+	Videos         []string              `json:"videos,omitempty"`
+	ImageCount     int                   `json:"image_count"` // Images in the cleaned content
+	EmbedCount     int                   `json:"embed_count"` // Kept iframe embeds in the cleaned content
+	AudioURL       string                `json:"audio_url,omitempty"` // Audio or podcast enclosure from <audio>, JSON-LD or og:audio
+	Tables         [][][]string          `json:"tables,omitempty"`
+	Quotes         []string              `json:"quotes,omitempty"`
+	Sections       []ContentSection      `json:"sections,omitempty"`
+	
+	// HTTP cache validators from the fetched response, used for conditional fetching
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
 	
 	// Error handling fields for JS compatibility
 	Error   bool   `json:"error,omitempty"`
@@ -6,6 +6,8 @@ package parser
 import (
 	"net/url"
 	"testing"
+
+	"github.com/BumpyClock/hermes/internal/validation"
 )
 
 func TestURLParsing(t *testing.T) {
@@ -15,7 +17,7 @@ func TestURLParsing(t *testing.T) {
 	
 	if err == nil {
 		t.Logf("Scheme: '%s', Host: '%s'", parsed.Scheme, parsed.Host)
-		t.Logf("validateURL result: %v", validateURL(parsed))
+		t.Logf("validateURL result: %v", validation.ValidateURLSimple(parsed.String()))
 	}
 	
 	// Test with actual invalid formats
@@ -32,7 +34,7 @@ func TestURLParsing(t *testing.T) {
 			t.Logf("URL '%s' failed parsing: %v", testURL, err)
 		} else {
 			t.Logf("URL '%s' parsed as: scheme='%s', host='%s', valid=%v", 
-				testURL, parsed.Scheme, parsed.Host, validateURL(parsed))
+				testURL, parsed.Scheme, parsed.Host, validation.ValidateURLSimple(parsed.String()))
 		}
 	}
 }
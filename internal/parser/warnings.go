@@ -0,0 +1,71 @@
+// ABOUTME: Collects non-fatal extraction issues into Result.Warnings without failing the parse
+// ABOUTME: Flags missing fields, fallback results and declared language or direction that disagree with the content
+
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/BumpyClock/hermes/internal/extractors/generic"
+	"github.com/PuerkitoBio/goquery"
+)
+
+// applyWarnings records issues a consumer may want to know about once
+// extraction has finished. Warnings are plain sentences in a stable order.
+func applyWarnings(result *Result, doc *goquery.Document, opts *ParserOptions) {
+	var warnings []string
+
+	if result.Title == "" {
+		warnings = append(warnings, "no title found")
+	}
+	if result.Content == "" && !opts.MetadataOnly {
+		warnings = append(warnings, "no content found")
+	}
+
+	// Fields only the last-resort fallbacks could fill
+	var fallbackFields []string
+	for field, source := range result.FieldSources {
+		if source == generic.SourceFallback {
+			fallbackFields = append(fallbackFields, field)
+		}
+	}
+	sort.Strings(fallbackFields)
+	for _, field := range fallbackFields {
+		warnings = append(warnings, fmt.Sprintf("%s extracted by fallback", field))
+	}
+
+	warnings = append(warnings, scriptWarnings(result, doc, opts)...)
+	result.Warnings = warnings
+}
+
+// scriptWarnings compares the declared language and text direction with the
+// script the content is mostly written in
+func scriptWarnings(result *Result, doc *goquery.Document, opts *ParserOptions) []string {
+	script := generic.DominantScript(summaryText(result.Content, opts.ContentType))
+	if script == "" {
+		return nil
+	}
+
+	var warnings []string
+	if scripts := generic.LanguageScripts(result.Language); scripts != nil && !containsString(scripts, script) {
+		warnings = append(warnings, fmt.Sprintf("declared language %q does not match content written in %s script", result.Language, script))
+	}
+
+	declared := strings.ToLower(strings.TrimSpace(doc.Find("html").AttrOr("dir", doc.Find("body").AttrOr("dir", ""))))
+	if detected := generic.ScriptDirection(script); (declared == generic.LTR || declared == generic.RTL) && declared != detected {
+		warnings = append(warnings, fmt.Sprintf("declared direction %q does not match content written in %s script", declared, script))
+	}
+	return warnings
+}
+
+// containsString reports whether values contains value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
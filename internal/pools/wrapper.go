@@ -0,0 +1,124 @@
+// ABOUTME: Pools the throwaway wrapper documents extractors build to hold selected nodes
+// ABOUTME: Wrappers are emptied and restored to a bare element before reuse, and HTML is rendered into pooled buffers
+
+package pools
+
+import (
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Wrapper is a pooled document whose body holds a single empty element.
+// Element is that element, ready to have nodes appended. A Wrapper and every
+// selection inside it must not be used after it is released.
+type Wrapper struct {
+	Element *goquery.Selection
+
+	doc  *goquery.Document
+	body *html.Node
+	node *html.Node
+	pool *WrapperPool
+}
+
+// WrapperPool manages a pool of wrapper documents around one kind of element
+type WrapperPool struct {
+	pool sync.Pool
+	tag  atom.Atom
+}
+
+// GlobalWrapperPool hands out <div> wrappers, as used when extractors merge selections
+var GlobalWrapperPool = NewWrapperPool(atom.Div)
+
+// NewWrapperPool creates a WrapperPool whose wrappers hold a tag element
+func NewWrapperPool(tag atom.Atom) *WrapperPool {
+	wp := &WrapperPool{tag: tag}
+	wp.pool.New = func() interface{} {
+		return wp.newWrapper()
+	}
+	return wp
+}
+
+// newWrapper builds the document html > head, body > element by hand, the
+// same tree parsing "<div></div>" produces, without running the parser
+func (wp *WrapperPool) newWrapper() *Wrapper {
+	root := &html.Node{Type: html.DocumentNode}
+	htmlNode := &html.Node{Type: html.ElementNode, DataAtom: atom.Html, Data: "html"}
+	head := &html.Node{Type: html.ElementNode, DataAtom: atom.Head, Data: "head"}
+	body := &html.Node{Type: html.ElementNode, DataAtom: atom.Body, Data: "body"}
+	root.AppendChild(htmlNode)
+	htmlNode.AppendChild(head)
+	htmlNode.AppendChild(body)
+
+	w := &Wrapper{
+		doc:  goquery.NewDocumentFromNode(root),
+		body: body,
+		node: &html.Node{},
+		pool: wp,
+	}
+	w.reset()
+	return w
+}
+
+// Get retrieves an empty wrapper from the pool
+func (wp *WrapperPool) Get() *Wrapper {
+	return wp.pool.Get().(*Wrapper)
+}
+
+// Put empties a wrapper and returns it to the pool
+func (wp *WrapperPool) Put(w *Wrapper) {
+	if w == nil || w.pool != wp {
+		return
+	}
+	w.reset()
+	wp.pool.Put(w)
+}
+
+// Release returns the wrapper to the pool it came from
+func (w *Wrapper) Release() {
+	if w != nil && w.pool != nil {
+		w.pool.Put(w)
+	}
+}
+
+// reset drops everything transforms and cleaners may have left behind: the
+// element's children and attributes, a renamed tag, and nodes that replaced
+// the element in the body
+func (w *Wrapper) reset() {
+	for child := w.body.FirstChild; child != nil; child = w.body.FirstChild {
+		w.body.RemoveChild(child)
+	}
+	if w.node.Parent != nil {
+		w.node.Parent.RemoveChild(w.node)
+	}
+	for child := w.node.FirstChild; child != nil; child = w.node.FirstChild {
+		w.node.RemoveChild(child)
+	}
+	w.node.Type = html.ElementNode
+	w.node.DataAtom = w.pool.tag
+	w.node.Data = w.pool.tag.String()
+	w.node.Namespace = ""
+	w.node.Attr = nil
+	w.body.AppendChild(w.node)
+	w.Element = w.doc.Selection.FindNodes(w.node)
+}
+
+// InnerHTML renders the children of the first node in sel like
+// Selection.Html, writing into a pooled buffer instead of a fresh one
+func InnerHTML(sel *goquery.Selection) (string, error) {
+	if sel == nil || sel.Length() == 0 {
+		return "", nil
+	}
+
+	buf := GlobalBufferPool.Get()
+	defer GlobalBufferPool.Put(buf)
+
+	for child := sel.Nodes[0].FirstChild; child != nil; child = child.NextSibling {
+		if err := html.Render(buf, child); err != nil {
+			return "", err
+		}
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,142 @@
+package pools
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html/atom"
+)
+
+const wrapperTestHTML = `<html><body><article><p class="a">First paragraph</p><p class="b">Second <em>paragraph</em></p></article></body></html>`
+
+func TestWrapperPoolResetsBeforeReuse(t *testing.T) {
+	pool := NewWrapperPool(atom.Div)
+
+	w := pool.Get()
+	w.Element.AppendHtml("<p>Leftover</p>")
+	w.Element.SetAttr("class", "dirty")
+	// A transform may swap the wrapper element for another one entirely
+	w.Element.ReplaceWithHtml("<section>Replaced</section>")
+	w.Release()
+
+	w = pool.Get()
+	defer w.Release()
+
+	if got := goquery.NodeName(w.Element); got != "div" {
+		t.Errorf("Expected a div wrapper, got %q", got)
+	}
+	if _, ok := w.Element.Attr("class"); ok {
+		t.Error("Expected attributes to be cleared")
+	}
+	if html, _ := InnerHTML(w.Element); html != "" {
+		t.Errorf("Expected an empty wrapper, got %q", html)
+	}
+	if got := w.doc.Find("body").Children().Length(); got != 1 {
+		t.Errorf("Expected the body to hold only the wrapper, got %d children", got)
+	}
+	if w.Element.Parent().Length() == 0 {
+		t.Error("Expected the wrapper to have a parent for transforms that replace it")
+	}
+}
+
+func TestWrapperPoolPutIgnoresForeignWrappers(t *testing.T) {
+	spans := NewWrapperPool(atom.Span)
+	w := GlobalWrapperPool.Get()
+	spans.Put(w) // Not from spans, so ignored
+
+	w.Release()
+	if got := goquery.NodeName(spans.Get().Element); got != "span" {
+		t.Errorf("Expected a span wrapper, got %q", got)
+	}
+}
+
+func TestInnerHTMLMatchesSelectionHtml(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(wrapperTestHTML))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+
+	article := doc.Find("article")
+	want, _ := article.Html()
+	got, err := InnerHTML(article)
+	if err != nil {
+		t.Fatalf("InnerHTML failed: %v", err)
+	}
+	if got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+
+	if got, _ := InnerHTML(doc.Find("missing")); got != "" {
+		t.Errorf("Expected empty HTML for an empty selection, got %q", got)
+	}
+}
+
+func TestWrapperPoolConcurrentUse(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(wrapperTestHTML))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+	want, _ := doc.Find("article").Html()
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 100)
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				w := GlobalWrapperPool.Get()
+				doc.Find("article p").Each(func(i int, el *goquery.Selection) {
+					w.Element.AppendSelection(el.Clone())
+				})
+				got, _ := InnerHTML(w.Element)
+				w.Release()
+				if got != want {
+					errs <- got
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for got := range errs {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+// Benchmark to measure wrapping the same selection with and without the pool
+func BenchmarkWrapperWithPool(b *testing.B) {
+	doc, _ := goquery.NewDocumentFromReader(strings.NewReader(wrapperTestHTML))
+	matches := doc.Find("article p")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		w := GlobalWrapperPool.Get()
+		matches.Each(func(i int, el *goquery.Selection) {
+			w.Element.AppendSelection(el.Clone())
+		})
+		_, _ = InnerHTML(w.Element)
+		w.Release()
+	}
+}
+
+func BenchmarkWrapperWithoutPool(b *testing.B) {
+	doc, _ := goquery.NewDocumentFromReader(strings.NewReader(wrapperTestHTML))
+	matches := doc.Find("article p")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		wrapperDoc, _ := goquery.NewDocumentFromReader(strings.NewReader("<div></div>"))
+		wrapper := wrapperDoc.Find("div").First()
+		matches.Each(func(i int, el *goquery.Selection) {
+			wrapper.AppendSelection(el.Clone())
+		})
+		_, _ = wrapper.Html()
+	}
+}
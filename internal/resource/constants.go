@@ -10,9 +10,12 @@ var REQUEST_HEADERS = map[string]string{
 	"User-Agent": "Mozilla/5.0 (Windows NT 6.1) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/41.0.2228.0 Safari/537.36",
 }
 
+// Default Accept header, asks servers for the HTML variant of a resource
+const DEFAULT_ACCEPT_HEADER = "text/html,application/xhtml+xml"
+
 // Standard HTTP headers for web content fetching
 var STANDARD_HEADERS = map[string]string{
-	"Accept":                      "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+	"Accept":                      DEFAULT_ACCEPT_HEADER,
 	"Accept-Language":             "en-US,en;q=0.5",
 	"DNT":                         "1",
 	"Connection":                  "keep-alive",
@@ -50,6 +53,9 @@ const MAX_PROCESSING_TIME = 30 * time.Second
 // Maximum number of DOM elements to process
 const MAX_DOM_ELEMENTS = 50000
 
+// Maximum element nesting depth to process
+const MAX_DOM_DEPTH = 512
+
 // Regular expressions for image and link detection
 var (
 	IS_LINK_RE   = regexp.MustCompile(`https?://`)
@@ -60,6 +66,9 @@ var (
 // Tags to remove during initial DOM cleanup
 const TAGS_TO_REMOVE = "script,style,form"
 
+// Structured data scripts kept during initial DOM cleanup for metadata extractors
+const KEEP_SCRIPTS_SELECTOR = `script[type="application/ld+json"]`
+
 // Default encoding constants
 const DEFAULT_ENCODING = "utf-8"
 
@@ -0,0 +1,114 @@
+// ABOUTME: Transparent decompression of gzip, deflate and Brotli response bodies regardless of transport
+// ABOUTME: Bodies are read through a size guard so compressed payloads cannot expand past the content limit
+
+package resource
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/BumpyClock/hermes/internal/pools"
+	"github.com/andybalholm/brotli"
+)
+
+// DEFAULT_ACCEPT_ENCODING advertises every encoding DecompressBody understands
+const DEFAULT_ACCEPT_ENCODING = "gzip, deflate, br"
+
+// errContentTooLarge marks bodies that exceeded the size limit, retrying them cannot succeed
+var errContentTooLarge = errors.New("Content for this resource was too large")
+
+// DecompressBody replaces resp.Body with a reader that undoes its Content-Encoding.
+// Encodings are applied in order, so they are removed in reverse.
+func DecompressBody(resp *http.Response) error {
+	encoding := resp.Header.Get("Content-Encoding")
+	if encoding == "" || resp.Uncompressed {
+		return nil
+	}
+
+	codings := strings.Split(encoding, ",")
+	body := resp.Body
+	for i := len(codings) - 1; i >= 0; i-- {
+		reader, err := newDecompressor(strings.ToLower(strings.TrimSpace(codings[i])), body)
+		if err != nil {
+			return err
+		}
+		body = &decompressedBody{Reader: reader, closer: resp.Body}
+	}
+
+	resp.Body = body
+	// The headers described the compressed payload, not what callers now read
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// newDecompressor returns a reader decoding a single content coding
+func newDecompressor(coding string, body io.Reader) (io.Reader, error) {
+	switch coding {
+	case "", "identity":
+		return body, nil
+	case "gzip", "x-gzip":
+		reader, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gzip body: %w", err)
+		}
+		return reader, nil
+	case "deflate":
+		// HTTP deflate is zlib-wrapped, but some servers send raw deflate streams
+		buffered := bufio.NewReader(body)
+		header, err := buffered.Peek(2)
+		if err == nil && header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+			reader, err := zlib.NewReader(buffered)
+			if err != nil {
+				return nil, fmt.Errorf("invalid deflate body: %w", err)
+			}
+			return reader, nil
+		}
+		return flate.NewReader(buffered), nil
+	case "br":
+		return brotli.NewReader(body), nil
+	default:
+		return nil, fmt.Errorf("unsupported content encoding: %s", coding)
+	}
+}
+
+// decompressedBody closes the underlying network body when the decoder is done
+type decompressedBody struct {
+	io.Reader
+	closer io.Closer
+}
+
+func (b *decompressedBody) Close() error {
+	return b.closer.Close()
+}
+
+// ReadLimitedBody decompresses and reads the response body, failing once it grows past maxBytes
+func ReadLimitedBody(resp *http.Response, maxBytes int64) ([]byte, error) {
+	if resp.Body == nil {
+		return nil, nil
+	}
+	if err := DecompressBody(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	// Read one byte past the limit so oversized bodies are detected rather than truncated
+	resp.Body = &decompressedBody{Reader: io.LimitReader(resp.Body, maxBytes+1), closer: resp.Body}
+	body, err := pools.GlobalResponseBodyPool.ReadResponseBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(body)) > maxBytes {
+		return nil, fmt.Errorf("%w. Maximum content length is %d", errContentTooLarge, maxBytes)
+	}
+	return body, nil
+}
@@ -75,11 +75,12 @@ func extractSrcFromJSON(str string) string {
 
 // Clean removes unwanted elements from the DOM
 // Removes scripts, styles, forms, and comments
+// JSON-LD scripts are kept since they are inert data used by metadata extractors
 func Clean(doc *goquery.Document) *goquery.Document {
 	// Remove unwanted tags
 	tagsList := strings.Split(TAGS_TO_REMOVE, ",")
 	for _, tag := range tagsList {
-		doc.Find(strings.TrimSpace(tag)).Remove()
+		doc.Find(strings.TrimSpace(tag)).Not(KEEP_SCRIPTS_SELECTOR).Remove()
 	}
 	
 	// Remove comments - this is more complex in goquery
@@ -4,12 +4,15 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/BumpyClock/hermes/internal/validation"
 )
 
 // CreateDefaultHTTPClient creates a new HTTP client with default settings
@@ -27,6 +30,8 @@ func CreateDefaultHTTPClient() *HTTPClient {
 		Timeout: FETCH_TIMEOUT,
 		Jar:     jar,
 		Transport: &http.Transport{
+			// Connect only to addresses that pass the SSRF rules carried by the request context
+			DialContext:         validation.PinnedDialContext(&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}),
 			MaxIdleConns:        100,
 			MaxIdleConnsPerHost: 10,
 			IdleConnTimeout:     90 * time.Second,
@@ -84,8 +89,9 @@ func FetchResourceWithClient(ctx context.Context, rawURL string, parsedURL *url.
 	
 	// Create a temporary client wrapper with the merged headers for this request
 	clientWithHeaders := &HTTPClient{
-		Client:  client.Client, // Reuse the same underlying http.Client
-		Headers: allHeaders,
+		Client:           client.Client, // Reuse the same underlying http.Client
+		Headers:          allHeaders,
+		MaxContentLength: client.MaxContentLength,
 	}
 
 	// Perform request with retry using the pooled client
@@ -97,8 +103,26 @@ func FetchResourceWithClient(ctx context.Context, rawURL string, parsedURL *url.
 		}, nil
 	}
 
+	// A 304 means the caller's cached copy is still current, there is nothing to parse
+	if response.StatusCode == http.StatusNotModified {
+		return &FetchResult{
+			Response:    response,
+			NotModified: true,
+		}, nil
+	}
+
+	// Refuse binary payloads (PDFs, images, ...) before they reach the HTML parser
+	if response.StatusCode == http.StatusOK && !IsTextContent(response.GetContentType()) {
+		return &FetchResult{
+			Response:               response,
+			Error:                  true,
+			Message:                fmt.Sprintf("Content-type for this resource was %s and is not supported", response.GetContentType()),
+			UnsupportedContentType: true,
+		}, nil
+	}
+
 	// Validate response
-	if err := ValidateResponse(response, false); err != nil {
+	if err := ValidateResponseWithLimit(response, false, clientWithHeaders.maxContentLength()); err != nil {
 		return &FetchResult{
 			Error:   true,
 			Message: err.Error(),
@@ -112,6 +136,11 @@ func FetchResourceWithClient(ctx context.Context, rawURL string, parsedURL *url.
 
 // ValidateResponse validates that the response is suitable for parsing
 func ValidateResponse(response *Response, parseNon200 bool) error {
+	return ValidateResponseWithLimit(response, parseNon200, MAX_CONTENT_LENGTH)
+}
+
+// ValidateResponseWithLimit validates the response against a custom maximum content length
+func ValidateResponseWithLimit(response *Response, parseNon200 bool, maxContentLength int64) error {
 	// Check status code
 	if response.StatusCode != 200 {
 		if !parseNon200 {
@@ -130,8 +159,8 @@ func ValidateResponse(response *Response, parseNon200 bool) error {
 	// Check content length
 	if contentLengthStr != "" {
 		contentLength, err := strconv.ParseInt(contentLengthStr, 10, 64)
-		if err == nil && contentLength > MAX_CONTENT_LENGTH {
-			return fmt.Errorf("Content for this resource was too large. Maximum content length is %d", MAX_CONTENT_LENGTH)
+		if err == nil && contentLength > maxContentLength {
+			return fmt.Errorf("Content for this resource was too large. Maximum content length is %d", maxContentLength)
 		}
 	}
 
@@ -156,6 +185,8 @@ type FetchResult struct {
 	Error         bool
 	Message       string
 	AlreadyDecoded bool
+	NotModified   bool // Server answered a conditional request with 304 Not Modified
+	UnsupportedContentType bool // Server answered with a non-HTML content type
 }
 
 // IsError returns true if the fetch result contains an error
@@ -3,17 +3,28 @@ package resource
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"time"
 
-	"github.com/BumpyClock/hermes/internal/pools"
+	"github.com/BumpyClock/hermes/internal/validation"
 )
 
 // HTTPClient provides a configured HTTP client for fetching resources
 type HTTPClient struct {
-	Client  *http.Client      // Exported for external use
-	Headers map[string]string // Exported for external use
+	Client           *http.Client      // Exported for external use
+	Headers          map[string]string // Exported for external use
+	MaxContentLength int64             // Maximum decompressed body size, 0 uses MAX_CONTENT_LENGTH
+}
+
+// maxContentLength returns the configured body size limit or the default
+func (c *HTTPClient) maxContentLength() int64 {
+	if c.MaxContentLength > 0 {
+		return c.MaxContentLength
+	}
+	return MAX_CONTENT_LENGTH
 }
 
 // NewHTTPClient creates a new HTTP client with sensible defaults
@@ -28,6 +39,8 @@ func NewHTTPClient(headers map[string]string) *HTTPClient {
 		Client: &http.Client{
 			Timeout: timeout,
 			Transport: &http.Transport{
+				// Connect only to addresses that pass the SSRF rules carried by the request context
+				DialContext:        validation.PinnedDialContext(&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}),
 				MaxIdleConns:       10,
 				IdleConnTimeout:    90 * time.Second,
 				DisableCompression: false,
@@ -78,6 +91,15 @@ func (c *HTTPClient) GetWithRetry(ctx context.Context, url string, maxRetries in
 		if resp != nil && resp.StatusCode >= 400 && resp.StatusCode < 500 {
 			break
 		}
+		// The same oversized body would be served again
+		if errors.Is(err, errContentTooLarge) {
+			break
+		}
+		// A refused redirect or address would be refused again
+		var validationErr *validation.ValidationError
+		if errors.As(err, &validationErr) {
+			break
+		}
 	}
 	
 	return nil, fmt.Errorf("failed after %d attempts: %w", maxRetries+1, lastErr)
@@ -96,7 +118,11 @@ func (c *HTTPClient) doRequest(ctx context.Context, url string) (*Response, erro
 	for key, value := range allHeaders {
 		req.Header.Set(key, value)
 	}
-	// Note: Accept-Encoding is handled automatically by Go's HTTP client when DisableCompression=false
+	// Ask for compression explicitly so gzip, deflate and br are all decoded by us,
+	// whatever transport the caller configured
+	if req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", DEFAULT_ACCEPT_ENCODING)
+	}
 	
 	resp, err := c.Client.Do(req)
 	if err != nil {
@@ -106,7 +132,7 @@ func (c *HTTPClient) doRequest(ctx context.Context, url string) (*Response, erro
 	// Check for HTTP errors
 	if resp.StatusCode >= 400 {
 		// Read error response body using pooled buffer for better error reporting
-		body, err := pools.GlobalResponseBodyPool.ReadResponseBody(resp)
+		body, err := ReadLimitedBody(resp, c.maxContentLength())
 		if err != nil {
 			return nil, fmt.Errorf("HTTP %d: %s (failed to read error response)", resp.StatusCode, resp.Status)
 		}
@@ -118,8 +144,8 @@ func (c *HTTPClient) doRequest(ctx context.Context, url string) (*Response, erro
 		}, fmt.Errorf("HTTP %d: %s", resp.StatusCode, resp.Status)
 	}
 	
-	// Read response body using pooled buffer for efficiency
-	body, err := pools.GlobalResponseBodyPool.ReadResponseBody(resp)
+	// Decompress and read the response body using pooled buffer for efficiency
+	body, err := ReadLimitedBody(resp, c.maxContentLength())
 	if err != nil {
 		return nil, fmt.Errorf("reading response body: %w", err)
 	}
@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -9,10 +10,23 @@ import (
 	"io"
 
 	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
 )
 
+// ErrNotModified is returned when a conditional request is answered with 304 Not Modified
+var ErrNotModified = errors.New("resource not modified")
+
+// ErrUnsupportedContentType is returned when the response is not HTML or text (e.g. a PDF or image)
+var ErrUnsupportedContentType = errors.New("unsupported content type")
+
+// ErrMalformedHTML is returned when a document cannot be parsed into a usable DOM
+var ErrMalformedHTML = errors.New("malformed HTML")
+
 // Resource provides functionality for fetching and preparing HTML documents
-type Resource struct{}
+type Resource struct {
+	// Response is the HTTP response from the most recent fetch, nil when HTML was provided
+	Response *Response
+}
 
 // Create creates a Resource by fetching from URL or using provided HTML
 // This is the main entry point that orchestrates fetch -> decode -> DOM preparation
@@ -54,6 +68,15 @@ func (r *Resource) CreateWithClient(ctx context.Context, rawURL string, prepared
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch resource: %w", err)
 		}
+		r.Response = result.Response
+	}
+
+	if result.NotModified {
+		return nil, ErrNotModified
+	}
+
+	if result.UnsupportedContentType {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedContentType, result.Response.GetContentType())
 	}
 
 	if result.IsError() {
@@ -85,7 +108,7 @@ func (r *Resource) GenerateDocWithContext(ctx context.Context, result *FetchResu
 
 	// Check if content appears to be HTML/text
 	if !IsTextContent(contentType) {
-		return nil, fmt.Errorf("content does not appear to be text, got: %s", contentType)
+		return nil, fmt.Errorf("%w: content does not appear to be text, got: %s", ErrUnsupportedContentType, contentType)
 	}
 
 	// Validate resource limits before processing
@@ -99,9 +122,17 @@ func (r *Resource) GenerateDocWithContext(ctx context.Context, result *FetchResu
 		return nil, fmt.Errorf("failed to encode document: %w", err)
 	}
 
+	return r.PrepareDocument(ctx, doc)
+}
+
+// PrepareDocument validates an already parsed document and applies the DOM
+// preparation pipeline (meta tag normalization, lazy image conversion and
+// cleaning) that fetched and provided HTML goes through. The document is
+// modified in place.
+func (r *Resource) PrepareDocument(ctx context.Context, doc *goquery.Document) (*goquery.Document, error) {
 	// Check if document parsed correctly
 	if doc.Find("*").Length() == 0 {
-		return nil, fmt.Errorf("no children found, likely a bad parse")
+		return nil, fmt.Errorf("%w: no children found, likely a bad parse", ErrMalformedHTML)
 	}
 
 	// Validate DOM complexity
@@ -133,7 +164,9 @@ func (r *Resource) ValidateResourceLimits(body []byte) error {
 	return nil
 }
 
-// ValidateDOMComplexity checks if the DOM has too many elements
+// ValidateDOMComplexity checks if the DOM has too many elements or nests them too deeply.
+// Extraction time grows quadratically with depth, and real pages stay far below
+// the limit, so runaway nesting is treated as malformed HTML.
 func (r *Resource) ValidateDOMComplexity(doc *goquery.Document) error {
 	elementCount := doc.Find("*").Length()
 
@@ -141,9 +174,48 @@ func (r *Resource) ValidateDOMComplexity(doc *goquery.Document) error {
 		return fmt.Errorf("DOM has %d elements, exceeds maximum %d", elementCount, MAX_DOM_ELEMENTS)
 	}
 
+	if depthExceeds(doc.Get(0), MAX_DOM_DEPTH) {
+		return fmt.Errorf("%w: elements nested more than %d deep", ErrMalformedHTML, MAX_DOM_DEPTH)
+	}
+
 	return nil
 }
 
+// depthExceeds reports whether any node sits more than limit levels below root.
+// It walks the tree iteratively so pathological nesting cannot exhaust the stack.
+func depthExceeds(root *html.Node, limit int) bool {
+	if root == nil {
+		return false
+	}
+
+	depth := 0
+	node := root
+	for {
+		if node.FirstChild != nil {
+			node = node.FirstChild
+			depth++
+			if depth > limit {
+				return true
+			}
+			continue
+		}
+		for node.NextSibling == nil {
+			if node == root {
+				return false
+			}
+			node = node.Parent
+			depth--
+			if node == root || node == nil {
+				return false
+			}
+		}
+		if node == root {
+			return false
+		}
+		node = node.NextSibling
+	}
+}
+
 // EncodeDoc handles character encoding detection and document creation
 func (r *Resource) EncodeDoc(content []byte, contentType string, alreadyDecoded bool) (*goquery.Document, error) {
 	var htmlContent string
@@ -162,7 +234,7 @@ func (r *Resource) EncodeDoc(content []byte, contentType string, alreadyDecoded
 	// Create initial document directly (no fake pooling)
 	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlContent))
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse HTML: %w", err)
+		return nil, fmt.Errorf("%w: failed to parse HTML: %w", ErrMalformedHTML, err)
 	}
 
 	// After first parse, check for encoding mismatch in meta tags
@@ -246,7 +318,7 @@ func (r *Resource) GenerateDocStreaming(result *FetchResult) (*goquery.Document,
 
 	// Check if content appears to be HTML/text
 	if !IsTextContent(contentType) {
-		return nil, fmt.Errorf("content does not appear to be text, got: %s", contentType)
+		return nil, fmt.Errorf("%w: content does not appear to be text, got: %s", ErrUnsupportedContentType, contentType)
 	}
 
 	// For streaming, we still need to validate limits but can be more lenient
@@ -289,7 +361,7 @@ func (r *Resource) GenerateDocStreaming(result *FetchResult) (*goquery.Document,
 		if documentSize < 5*1024*1024 { // 5MB fallback limit
 			return r.GenerateDoc(result)
 		}
-		return nil, fmt.Errorf("streaming parse failed: %w", err)
+		return nil, fmt.Errorf("%w: streaming parse failed: %w", ErrMalformedHTML, err)
 	}
 
 	if doc == nil {
@@ -298,7 +370,10 @@ func (r *Resource) GenerateDocStreaming(result *FetchResult) (*goquery.Document,
 
 	// Apply basic DOM validation 
 	if doc.Find("*").Length() == 0 {
-		return nil, fmt.Errorf("no children found in streamed document, likely a bad parse")
+		return nil, fmt.Errorf("%w: no children found in streamed document, likely a bad parse", ErrMalformedHTML)
+	}
+	if err := r.ValidateDOMComplexity(doc); err != nil {
+		return nil, fmt.Errorf("DOM too complex: %w", err)
 	}
 
 	return doc, nil
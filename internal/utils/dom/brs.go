@@ -171,4 +171,99 @@ func paragraphize(node *goquery.Selection, br bool) {
 	// JavaScript: $node.replaceWith(p); $node.remove();
 	// Remove the BR since the paragraph now replaces it
 	node.Remove()
-}
\ No newline at end of file
+}
+
+// SplitBrParagraphs splits paragraphs on runs of two or more <br> into separate <p> elements.
+// Some CMSes emit a whole article as one <p> with <br><br> as paragraph breaks, which
+// otherwise ends up as a single giant paragraph. Paragraphs inside <pre> are left alone.
+func SplitBrParagraphs(doc *goquery.Document) *goquery.Document {
+	var paragraphs []*goquery.Selection
+	doc.Find("p").Each(func(index int, p *goquery.Selection) {
+		if p.Closest("pre").Length() == 0 {
+			paragraphs = append(paragraphs, p)
+		}
+	})
+
+	for _, p := range paragraphs {
+		contents := p.Contents()
+		var groups [][]*goquery.Selection
+		var group []*goquery.Selection
+
+		for i := 0; i < contents.Length(); i++ {
+			node := contents.Eq(i)
+			if goquery.NodeName(node) == "br" {
+				// Count the run of BRs, allowing whitespace-only text between them
+				brCount := 1
+				j := i + 1
+				for ; j < contents.Length(); j++ {
+					sibling := contents.Eq(j)
+					if goquery.NodeName(sibling) == "br" {
+						brCount++
+					} else if goquery.NodeName(sibling) != "#text" || strings.TrimSpace(sibling.Text()) != "" {
+						break
+					}
+				}
+
+				if brCount >= 2 {
+					for k := i; k < j; k++ {
+						contents.Eq(k).Remove()
+					}
+					groups = append(groups, group)
+					group = nil
+					i = j - 1
+					continue
+				}
+			}
+			group = append(group, node)
+		}
+
+		// No paragraph break found, nothing to split
+		if len(groups) == 0 {
+			continue
+		}
+		groups = append(groups, group)
+
+		for _, g := range groups {
+			if !hasParagraphContent(g) {
+				continue
+			}
+			newP := p.Clone()
+			newP.Empty()
+			for _, node := range g {
+				newP.AppendSelection(node)
+			}
+			p.BeforeSelection(newP)
+		}
+		p.Remove()
+	}
+
+	return doc
+}
+
+// hasParagraphContent checks whether nodes hold text or any element other than <br>
+func hasParagraphContent(nodes []*goquery.Selection) bool {
+	for _, node := range nodes {
+		name := goquery.NodeName(node)
+		if name == "#text" {
+			if strings.TrimSpace(node.Text()) != "" {
+				return true
+			}
+		} else if name != "br" && !strings.HasPrefix(name, "#") {
+			return true
+		}
+	}
+	return false
+}
+
+// CollapseBrs replaces single <br> tags inside paragraphs with a space.
+// Line breaks inside <pre> are significant and are never touched.
+func CollapseBrs(doc *goquery.Document) *goquery.Document {
+	doc.Find("p br").Each(func(index int, br *goquery.Selection) {
+		if br.Closest("pre").Length() > 0 {
+			return
+		}
+		br.ReplaceWithHtml(" ")
+	})
+
+	return doc
+}
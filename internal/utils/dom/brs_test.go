@@ -335,4 +335,68 @@ func BenchmarkBrsToPs_Large(b *testing.B) {
 		doc, _ := goquery.NewDocumentFromReader(strings.NewReader(html))
 		dom.BrsToPs(doc)
 	}
-}
\ No newline at end of file
+}
+
+func TestSplitBrParagraphs(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected string
+	}{
+		{
+			name:     "splits on double br",
+			html:     `<div><p>First paragraph.<br><br>Second paragraph.<br> <br>Third paragraph.</p></div>`,
+			expected: `<div><p>First paragraph.</p><p>Second paragraph.</p><p>Third paragraph.</p></div>`,
+		},
+		{
+			name:     "keeps single br and inline elements",
+			html:     `<div><p>Intro with <em>emphasis</em><br>and a soft wrap.<br><br><a href="/more">Link</a> text.</p></div>`,
+			expected: `<div><p>Intro with <em>emphasis</em><br/>and a soft wrap.</p><p><a href="/more">Link</a> text.</p></div>`,
+		},
+		{
+			name:     "drops empty groups from leading and trailing breaks",
+			html:     `<div><p><br><br>Only paragraph.<br><br><br></p></div>`,
+			expected: `<div><p>Only paragraph.</p></div>`,
+		},
+		{
+			name:     "preserves attributes on split paragraphs",
+			html:     `<div><p class="body">One.<br><br>Two.</p></div>`,
+			expected: `<div><p class="body">One.</p><p class="body">Two.</p></div>`,
+		},
+		{
+			name:     "leaves paragraphs without double br alone",
+			html:     `<div><p>Line one<br>line two</p></div>`,
+			expected: `<div><p>Line one<br/>line two</p></div>`,
+		},
+		{
+			name:     "does not touch br inside pre",
+			html:     `<div><pre><p>code line<br><br>next line</p></pre></div>`,
+			expected: `<div><pre><p>code line<br/><br/>next line</p></pre></div>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			require.NoError(t, err)
+
+			result := dom.SplitBrParagraphs(doc)
+
+			html, err := result.Find("body").Html()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, html)
+		})
+	}
+}
+
+func TestCollapseBrs(t *testing.T) {
+	html := `<div><p>Line one<br>line two</p><pre>keep<br>this</pre></div>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	require.NoError(t, err)
+
+	result := dom.CollapseBrs(doc)
+
+	assert.Equal(t, "Line one line two", result.Find("p").Text())
+	assert.Equal(t, 1, result.Find("pre br").Length(), "br inside pre should be kept")
+}
@@ -1,10 +1,13 @@
 package dom
 
 import (
+	"net/url"
 	"strconv"
 	"strings"
 
 	"github.com/PuerkitoBio/goquery"
+
+	"github.com/BumpyClock/hermes/internal/utils/security"
 )
 
 // CleanAttributes removes unwanted attributes from elements and keeps only whitelisted ones
@@ -33,6 +36,54 @@ func CleanAttributes(doc *goquery.Document) *goquery.Document {
 	return doc
 }
 
+// CleanAttributesWithSafeStyles behaves like CleanAttributes but, instead of removing
+// style attributes entirely, keeps the declarations listed in SAFE_STYLE_PROPERTIES
+func CleanAttributesWithSafeStyles(doc *goquery.Document) *goquery.Document {
+	// Filter styles before the regular pass removes them, then restore what is safe
+	var styled []*goquery.Selection
+	var safeStyles []string
+	doc.Find("[style]").Each(func(index int, element *goquery.Selection) {
+		style, _ := element.Attr("style")
+		if safe := FilterSafeStyle(style); safe != "" {
+			styled = append(styled, element)
+			safeStyles = append(safeStyles, safe)
+		}
+	})
+
+	doc = CleanAttributes(doc)
+
+	for i, element := range styled {
+		element.SetAttr("style", safeStyles[i])
+	}
+
+	return doc
+}
+
+// FilterSafeStyle returns only the declarations of an inline style that are listed in
+// SAFE_STYLE_PROPERTIES with an allowed value, normalized as "prop: value" pairs
+func FilterSafeStyle(style string) string {
+	var kept []string
+
+	for _, declaration := range strings.Split(style, ";") {
+		parts := strings.SplitN(declaration, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		property := strings.ToLower(strings.TrimSpace(parts[0]))
+		value := strings.ToLower(strings.TrimSpace(parts[1]))
+
+		allowed, ok := SAFE_STYLE_PROPERTIES[property]
+		if !ok || !allowed.MatchString(value) {
+			continue
+		}
+
+		kept = append(kept, property+": "+value)
+	}
+
+	return strings.Join(kept, "; ")
+}
+
 // CleanHeaders removes headers that don't meet certain criteria
 // This exactly matches the JavaScript implementation with 3 removal conditions:
 // 1. Headers appearing before all <p> tags (likely title/subtitle)
@@ -83,9 +134,10 @@ func CleanHeadersWithoutTitle(doc *goquery.Document) *goquery.Document {
 	return CleanHeaders(doc, "")
 }
 
-// removeUnlessContent implements the JavaScript removeUnlessContent logic exactly
+// removeUnlessContent implements the JavaScript removeUnlessContent logic exactly,
+// with the high-weight link density cutoff taken from linkDensityThreshold
 // JavaScript: function removeUnlessContent($node, $, weight)
-func removeUnlessContent(node *goquery.Selection, weight int) bool {
+func removeUnlessContent(node *goquery.Selection, weight int, linkDensityThreshold float64) bool {
 	// Explicitly save entry-content-asset tags, which are
 	// noted as valuable in the Publisher guidelines.
 	// JavaScript: if ($node.hasClass('entry-content-asset')) return;
@@ -131,7 +183,7 @@ func removeUnlessContent(node *goquery.Selection, weight int) bool {
 		
 		// Too high of a link density, despite the score being high.
 		// JavaScript: if (weight >= 25 && density > 0.5)
-		if weight >= 25 && density > 0.5 {
+		if weight >= 25 && density > linkDensityThreshold {
 			// Don't remove the node if it's a list and the
 			// previous sibling starts with a colon though. That
 			// means it's probably content.
@@ -173,6 +225,17 @@ func removeUnlessContent(node *goquery.Selection, weight int) bool {
 // This exactly matches the JavaScript cleanTags implementation
 // JavaScript: export default function cleanTags($article, $)
 func CleanTags(doc *goquery.Document) *goquery.Document {
+	return CleanTagsWithLinkDensity(doc, DefaultLinkDensityThreshold)
+}
+
+// CleanTagsWithLinkDensity is CleanTags with a custom link density cutoff for
+// high-scoring elements. Raising it keeps link-heavy lists and paragraphs that
+// documentation sites use for real content. Values <= 0 use the default.
+func CleanTagsWithLinkDensity(doc *goquery.Document, linkDensityThreshold float64) *goquery.Document {
+	if linkDensityThreshold <= 0 {
+		linkDensityThreshold = DefaultLinkDensityThreshold
+	}
+
 	// JavaScript: $(CLEAN_CONDITIONALLY_TAGS, $article).each((index, node) => {
 	doc.Find(CLEAN_CONDITIONALLY_TAGS_LIST).Each(func(index int, node *goquery.Selection) {
 		// JavaScript: const $node = $(node);
@@ -199,7 +262,7 @@ func CleanTags(doc *goquery.Document) *goquery.Document {
 		} else {
 			// Determine if node seems like content
 			// JavaScript: removeUnlessContent($node, $, weight)
-			removeUnlessContent(node, weight)
+			removeUnlessContent(node, weight, linkDensityThreshold)
 		}
 	})
 	
@@ -229,6 +292,40 @@ func RemoveEmpty(doc *goquery.Document) *goquery.Document {
 	return doc
 }
 
+// RemoveShortParagraphs removes paragraphs with fewer than minWords words,
+// such as orphaned captions and UI labels. Paragraphs holding media or a
+// link to another page are kept however short. A minWords of 0 or less
+// leaves the document unchanged.
+func RemoveShortParagraphs(doc *goquery.Document, minWords int) *goquery.Document {
+	if minWords <= 0 {
+		return doc
+	}
+	
+	doc.Find("p").Each(func(index int, paragraph *goquery.Selection) {
+		if len(strings.Fields(paragraph.Text())) >= minWords {
+			return
+		}
+		if paragraph.Find("img, picture, video, audio, iframe, object, embed").Length() > 0 || hasContentLink(paragraph) {
+			return
+		}
+		paragraph.Remove()
+	})
+	
+	return doc
+}
+
+// hasContentLink reports whether s contains a link that leads somewhere,
+// as opposed to in-page anchors and javascript: handlers
+func hasContentLink(s *goquery.Selection) bool {
+	found := false
+	s.Find("a[href]").EachWithBreak(func(index int, link *goquery.Selection) bool {
+		href := strings.TrimSpace(link.AttrOr("href", ""))
+		found = href != "" && !strings.HasPrefix(href, "#") && !strings.HasPrefix(strings.ToLower(href), "javascript:")
+		return !found
+	})
+	return found
+}
+
 // StripJunkTags removes unwanted elements like scripts, styles, etc.
 func StripJunkTags(doc *goquery.Document) *goquery.Document {
 	for _, tag := range STRIP_OUTPUT_TAGS {
@@ -239,10 +336,26 @@ func StripJunkTags(doc *goquery.Document) *goquery.Document {
 
 // MarkToKeep marks important elements that should be preserved during cleaning
 func MarkToKeep(doc *goquery.Document) *goquery.Document {
+	return MarkToKeepEmbeds(doc, nil)
+}
+
+// MarkToKeepEmbeds marks elements like MarkToKeep, and also iframes served
+// from security.DefaultEmbedHosts or embedHosts, or their subdomains
+func MarkToKeepEmbeds(doc *goquery.Document, embedHosts []string) *goquery.Document {
 	// Mark elements that match keep selectors
 	for _, selector := range KEEP_SELECTORS {
 		doc.Find(selector).AddClass(KEEP_CLASS)
 	}
+
+	doc.Find("iframe[src]").Each(func(index int, iframe *goquery.Selection) {
+		src, err := url.Parse(strings.TrimSpace(iframe.AttrOr("src", "")))
+		if err != nil || (src.Scheme != "" && src.Scheme != "http" && src.Scheme != "https") {
+			return
+		}
+		if security.IsEmbedHost(src.Hostname(), embedHosts) {
+			iframe.AddClass(KEEP_CLASS)
+		}
+	})
 	return doc
 }
 
@@ -71,6 +71,55 @@ func TestCleanAttributes(t *testing.T) {
 	}
 }
 
+func TestCleanAttributesWithSafeStyles(t *testing.T) {
+	html := `<div>
+		<p id="centered" style="text-align:center; color: red">Centered</p>
+		<p id="hostile" style="background:url(javascript:alert(1))">Hostile</p>
+		<p id="expression" style="width: expression(alert(1)); font-weight: bold">Expression</p>
+		<p id="bad-value" style="text-align: url(javascript:alert(1))">Bad value</p>
+	</div>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	require.NoError(t, err)
+
+	result := dom.CleanAttributesWithSafeStyles(doc)
+
+	style, exists := result.Find("#centered").Attr("style")
+	assert.True(t, exists, "text-align should survive")
+	assert.Equal(t, "text-align: center", style)
+
+	_, exists = result.Find("#hostile").Attr("style")
+	assert.False(t, exists, "background:url() should be removed")
+
+	style, _ = result.Find("#expression").Attr("style")
+	assert.Equal(t, "font-weight: bold", style)
+
+	_, exists = result.Find("#bad-value").Attr("style")
+	assert.False(t, exists, "non-keyword values should be removed")
+}
+
+func TestFilterSafeStyle(t *testing.T) {
+	tests := []struct {
+		style    string
+		expected string
+	}{
+		{"text-align:center", "text-align: center"},
+		{"TEXT-ALIGN: Right;", "text-align: right"},
+		{"font-style: italic; font-weight: 700", "font-style: italic; font-weight: 700"},
+		{"background:url(javascript:alert(1))", ""},
+		{"width: expression(alert(1))", ""},
+		{"font-weight: 750", ""},
+		{"color: red", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.style, func(t *testing.T) {
+			assert.Equal(t, tt.expected, dom.FilterSafeStyle(tt.style))
+		})
+	}
+}
+
 func TestCleanHeaders(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -352,6 +401,100 @@ func TestCleanTagsColonException(t *testing.T) {
 	assert.Contains(t, bodyText, "Lorem ipsum", "Main content should be kept")
 }
 
+// TestCleanTagsWithLinkDensity tests the configurable link density cutoff for well-scored elements
+func TestCleanTagsWithLinkDensity(t *testing.T) {
+	// About 60% of each item's text is link text
+	html := `<html><body>
+		<div score="40">
+			<p>Lorem ipsum dolor sit amet, consectetuer adipiscing elit. Aenean commodo ligula eget dolor. Aenean massa. Cum sociis natoque penatibus et magnis dis parturient montes, nascetur ridiculus mus.</p>
+			<ul score="30">
+				<li><a href="#">Configuration reference</a> for the server</li>
+				<li><a href="#">Deployment checklist</a> before release</li>
+				<li><a href="#">Troubleshooting guide</a> for upgrades</li>
+			</ul>
+			<p>Related reading: </p>
+			<ul score="30">
+				<li><a href="#">Architecture overview</a></li>
+				<li><a href="#">Release history</a></li>
+			</ul>
+		</div>
+	</body></html>`
+
+	tests := []struct {
+		name      string
+		threshold float64
+		kept      bool
+	}{
+		{name: "default threshold", threshold: 0.5, kept: false},
+		{name: "zero uses default", threshold: 0, kept: false},
+		{name: "relaxed threshold", threshold: 0.8, kept: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+			require.NoError(t, err)
+
+			bodyText := dom.CleanTagsWithLinkDensity(doc, tt.threshold).Find("body").Text()
+
+			if tt.kept {
+				assert.Contains(t, bodyText, "Configuration reference", "Link-heavy list should be kept")
+			} else {
+				assert.NotContains(t, bodyText, "Configuration reference", "Link-heavy list should be removed")
+			}
+			// The colon exception applies at every threshold
+			assert.Contains(t, bodyText, "Architecture overview", "List after colon should be kept")
+			assert.Contains(t, bodyText, "Lorem ipsum", "Main content should be kept")
+		})
+	}
+}
+
+func TestRemoveShortParagraphs(t *testing.T) {
+	html := `<html><body><div>
+		<p>The ferry returned to service on Monday after a six week refit.</p>
+		<p>Share this</p>
+		<p>Photo credit</p>
+		<p><img src="/ferry.jpg"></p>
+		<p><a href="/report.pdf">Full report</a></p>
+		<p><a href="#top">Back up</a></p>
+	</div></body></html>`
+
+	tests := []struct {
+		name     string
+		minWords int
+		removed  []string
+		kept     []string
+	}{
+		{
+			name:     "zero keeps everything",
+			minWords: 0,
+			kept:     []string{"ferry returned", "Share this", "Photo credit", "Full report", "Back up"},
+		},
+		{
+			name:     "short orphans removed",
+			minWords: 3,
+			removed:  []string{"Share this", "Photo credit", "Back up"},
+			kept:     []string{"ferry returned", "Full report"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+			require.NoError(t, err)
+
+			body := dom.RemoveShortParagraphs(doc, tt.minWords).Find("body")
+			for _, text := range tt.removed {
+				assert.NotContains(t, body.Text(), text)
+			}
+			for _, text := range tt.kept {
+				assert.Contains(t, body.Text(), text)
+			}
+			assert.Equal(t, 1, body.Find("img").Length(), "Image paragraph should be kept")
+		})
+	}
+}
+
 // TestCleanTagsEntryContentAsset tests the entry-content-asset protection
 func TestCleanTagsEntryContentAsset(t *testing.T) {
 	// Based on JavaScript test: "keeps anything with a class of entry-content-asset"
@@ -548,6 +691,31 @@ func TestMarkToKeep(t *testing.T) {
 	assert.False(t, otherFrame.HasClass(dom.KEEP_CLASS), "Other iframe should not be marked to keep")
 }
 
+func TestMarkToKeepEmbeds(t *testing.T) {
+	html := `<html><body>
+		<iframe src="https://platform.twitter.com/embed/Tweet.html?id=123">Tweet</iframe>
+		<iframe src="//w.soundcloud.com/player/?url=track">SoundCloud</iframe>
+		<iframe src="https://embed.podcasts.apple.com/us/podcast/id1">Podcast</iframe>
+		<iframe src="https://tracker.adnetwork.example/pixel">Tracker</iframe>
+		<iframe src="https://twitter.com.evil.example/embed">Lookalike</iframe>
+	</body></html>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	require.NoError(t, err)
+
+	result := dom.MarkToKeepEmbeds(doc, []string{"podcasts.apple.com"})
+
+	kept := map[string]bool{}
+	result.Find("iframe").Each(func(i int, iframe *goquery.Selection) {
+		kept[iframe.Text()] = iframe.HasClass(dom.KEEP_CLASS)
+	})
+	assert.True(t, kept["Tweet"], "Twitter embed should be kept by default")
+	assert.True(t, kept["SoundCloud"], "Protocol-relative SoundCloud embed should be kept by default")
+	assert.True(t, kept["Podcast"], "Subdomain of a configured host should be kept")
+	assert.False(t, kept["Tracker"], "Tracking iframe should not be kept")
+	assert.False(t, kept["Lookalike"], "Host merely containing a known host should not be kept")
+}
+
 func TestCleanImages(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -1,6 +1,10 @@
 package dom
 
-import "regexp"
+import (
+	"regexp"
+
+	"github.com/BumpyClock/hermes/internal/utils/security"
+)
 
 // Spacer images to be removed
 var SPACER_RE = regexp.MustCompile(`(?i)transparent|spacer|blank`)
@@ -18,6 +22,19 @@ var KEEP_SELECTORS = []string{
 	`iframe[src^="https://www.redditmedia.com"]`,
 }
 
+// Link density above which cleanTags removes an element even when it scores
+// well (weight >= 25), unless it is a list introduced by a colon
+const DefaultLinkDensityThreshold = 0.5
+
+// Score added to elements matching a caller's content hint, and the text
+// length a hinted element needs before it is boosted. The boost is enough to
+// settle a close ranking but not to lift a poorly scored element over clearly
+// better content.
+const (
+	ContentHintBoost     = 50
+	ContentHintMinLength = 250
+)
+
 // A list of tags to strip from the output if we encounter them.
 var STRIP_OUTPUT_TAGS = []string{
 	"title",
@@ -60,6 +77,11 @@ var WHITELIST_ATTRS = []string{
 
 var WHITELIST_ATTRS_RE = regexp.MustCompile(`(?i)^(src|srcset|sizes|type|href|class|id|alt|xlink:href|width|height)$`)
 
+// SAFE_STYLE_PROPERTIES lists the inline CSS properties that may be kept as readability
+// hints, each with the only values allowed. It is the sanitizer's list, so cleaning and
+// sanitizing always agree on which styles survive.
+var SAFE_STYLE_PROPERTIES = security.SafeStyleProperties
+
 // removeEmpty
 var REMOVE_EMPTY_TAGS = []string{"p"}
 
@@ -429,6 +451,10 @@ var CANDIDATES_BLACKLIST = regexp.MustCompile(`(?i)(` + candidatesBlacklist + `)
 var candidatesWhitelist = "and|article|body|blogindex|column|content|entry-content-asset|format|hfeed|hentry|hatom|main|page|posts|shadow"
 var CANDIDATES_WHITELIST = regexp.MustCompile(`(?i)(` + candidatesWhitelist + `)`)
 
+// Class or id tokens that name a reader comment section. These are removed even
+// when another token is whitelisted, e.g. class="article-comments content".
+var COMMENT_CONTAINER_RE = regexp.MustCompile(`(?i)^(((article|post|entry|story|blog|page|reader|user|fb|wp)[-_])?comments?([-_]?(list|section|area|thread|wrapper|container|widget|respond|form))?|disqus(_thread)?|dsq[-_].+)$`)
+
 var UNLIKELY_RE = regexp.MustCompile(`(?i)!(` + candidatesWhitelist + `)|(` + candidatesBlacklist + `)`)
 
 var PARAGRAPH_SCORE_TAGS = regexp.MustCompile(`(?i)^(p|li|span|pre)$`)
@@ -0,0 +1,58 @@
+// ABOUTME: Extracts a publish date encoded in a URL path such as /2024/01/15/slug or /2024-01-15-slug
+// ABOUTME: Only matches whole path segments and rejects impossible calendar dates
+
+package dom
+
+import (
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// URL path date patterns, anchored to path segment boundaries so that longer
+// number sequences (IDs, timestamps) are never read as dates
+var (
+	// /2024/01/15/
+	urlPathDateRE = regexp.MustCompile(`/((?:19|20)\d{2})/(\d{2})/(\d{2})(?:/|$)`)
+	// /2024-01-15-slug
+	urlSlugDateRE = regexp.MustCompile(`/((?:19|20)\d{2})-(\d{2})-(\d{2})(?:[-/.]|$)`)
+	// /2024/jan/15/
+	urlMonthNameDateRE = regexp.MustCompile(`(?i)/((?:19|20)\d{2})/(jan|feb|mar|apr|may|jun|jul|aug|sep|oct|nov|dec)/(\d{2})(?:/|$)`)
+)
+
+// ExtractDateFromURL returns the publish date encoded in a URL path as YYYY-MM-DD.
+// It recognizes /YYYY/MM/DD/, /YYYY-MM-DD- and /YYYY/mon/DD/ path patterns; the
+// query string and fragment are ignored. Sequences that are not a real calendar
+// date (e.g. /2024/13/45/) are rejected.
+func ExtractDateFromURL(rawURL string) (string, bool) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", false
+	}
+	path := parsed.Path
+
+	for _, re := range []*regexp.Regexp{urlPathDateRE, urlSlugDateRE} {
+		if m := re.FindStringSubmatch(path); m != nil {
+			if date, ok := validURLDate(m[1] + "-" + m[2] + "-" + m[3]); ok {
+				return date, true
+			}
+		}
+	}
+
+	if m := urlMonthNameDateRE.FindStringSubmatch(path); m != nil {
+		// Month names parse case-insensitively
+		if t, err := time.Parse("2006-Jan-02", m[1]+"-"+m[2]+"-"+m[3]); err == nil {
+			return t.Format("2006-01-02"), true
+		}
+	}
+
+	return "", false
+}
+
+// validURLDate checks that a YYYY-MM-DD string names an existing calendar day
+func validURLDate(date string) (string, bool) {
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return "", false
+	}
+	return date, true
+}
@@ -0,0 +1,39 @@
+package dom_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/BumpyClock/hermes/internal/utils/dom"
+)
+
+func TestExtractDateFromURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		expected string
+		found    bool
+	}{
+		{name: "year/month/day path", url: "https://example.com/2024/01/15/council-budget", expected: "2024-01-15", found: true},
+		{name: "year/month/day under a section", url: "https://example.com/news/2023/12/01/story.html", expected: "2023-12-01", found: true},
+		{name: "year/month/day at end of path", url: "https://example.com/archive/2024/02/29", expected: "2024-02-29", found: true},
+		{name: "dated slug", url: "https://example.com/blog/2024-01-15-council-budget", expected: "2024-01-15", found: true},
+		{name: "month name path", url: "https://example.com/2023/Dec/01/story", expected: "2023-12-01", found: true},
+		{name: "no date", url: "https://example.com/news/council-budget", found: false},
+		{name: "spurious number sequence", url: "https://example.com/p/12024-01-156/product", found: false},
+		{name: "long numeric id", url: "https://example.com/article/20240115123456/slug", found: false},
+		{name: "impossible calendar date", url: "https://example.com/2024/13/45/slug", found: false},
+		{name: "non-leap day", url: "https://example.com/2023/02/29/slug", found: false},
+		{name: "date only in query string", url: "https://example.com/search?from=2024-01-15-x", found: false},
+		{name: "date only in fragment", url: "https://example.com/page#/2024/01/15/", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, found := dom.ExtractDateFromURL(tt.url)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.expected, date)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+// ABOUTME: Shifts content headings down one level so they nest under an h1 article title
+// ABOUTME: Keeps relative heading structure while leaving the h1 level to the title alone
+
+package dom
+
+import (
+	"strconv"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html/atom"
+)
+
+// DemoteHeadings moves every h1-h5 in doc down one level (h1 becomes h2, h2
+// becomes h3 and so on) so the content nests under a title rendered as h1.
+// h6 headings stay h6 since there is no deeper level.
+func DemoteHeadings(doc *goquery.Document) *goquery.Document {
+	doc.Find("h1, h2, h3, h4, h5").Each(func(i int, heading *goquery.Selection) {
+		node := heading.Get(0)
+		level, err := strconv.Atoi(node.Data[1:])
+		if err != nil {
+			return
+		}
+		node.Data = "h" + strconv.Itoa(level+1)
+		node.DataAtom = atom.Lookup([]byte(node.Data))
+	})
+	return doc
+}
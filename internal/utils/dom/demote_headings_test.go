@@ -0,0 +1,27 @@
+package dom_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/BumpyClock/hermes/internal/utils/dom"
+)
+
+func TestDemoteHeadings(t *testing.T) {
+	html := `<div><h1 id="a">One</h1><h2>Two</h2><h3>Three</h3><h5>Five</h5><h6>Six</h6></div>`
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	require.NoError(t, err)
+
+	dom.DemoteHeadings(doc)
+
+	assert.Equal(t, 0, doc.Find("h1").Length())
+	assert.Equal(t, "One", doc.Find("h2").Text())
+	assert.Equal(t, "a", doc.Find("h2").AttrOr("id", ""))
+	assert.Equal(t, "Two", doc.Find("h3").Text())
+	assert.Equal(t, "Three", doc.Find("h4").Text())
+	assert.Equal(t, "FiveSix", doc.Find("h6").Text())
+}
@@ -52,42 +52,50 @@ func ExtractFromMeta(doc *goquery.Document, metaNames []string, cachedNames []st
 
 	// Process each found name in order
 	for _, name := range foundNames {
-		// JavaScript hardcodes type="name" and checks "value" attribute
-		// However, standard HTML meta tags use "content", so we check both
-		metaType := "name"
+		// JavaScript hardcodes type="name"; OpenGraph tags use property instead,
+		// so property is checked when no name tag has a usable value
+		for _, metaType := range []string{"name", "property"} {
+			if metaValue, ok := extractMetaValue(doc, metaType, name); ok {
+				// Meta values that contain HTML should be stripped, as they
+				// weren't subject to cleaning previously
+				if cleanTags {
+					metaValue = StripTags(metaValue, doc)
+				}
 
-		// Find meta tags with the specified name
-		selector := fmt.Sprintf("meta[%s=\"%s\"]", metaType, name)
-		nodes := doc.Find(selector)
-
-		// Get all non-empty values from both 'value' and 'content' attributes
-		var values []string
-		nodes.Each(func(index int, node *goquery.Selection) {
-			// Check 'value' attribute first (matches JavaScript behavior)
-			if val, exists := node.Attr("value"); exists && val != "" {
-				values = append(values, val)
-			} else if content, exists := node.Attr("content"); exists && content != "" {
-				// Fallback to standard 'content' attribute
-				values = append(values, content)
-			}
-		})
-
-		// If we have exactly one value, return it
-		// If we have more than one value, we have a conflict and can't trust any
-		// If we have zero values, the meta tags had no values
-		if len(values) == 1 {
-			metaValue := values[0]
-			
-			// Meta values that contain HTML should be stripped, as they
-			// weren't subject to cleaning previously
-			if cleanTags {
-				metaValue = StripTags(metaValue, doc)
+				return &metaValue
 			}
-			
-			return &metaValue
 		}
 	}
 
 	// If nothing is found, return nil
 	return nil
-}
\ No newline at end of file
+}
+
+// extractMetaValue returns the value of the meta tags whose metaType attribute
+// equals name, reporting false when there is no value or the tags conflict
+func extractMetaValue(doc *goquery.Document, metaType string, name string) (string, bool) {
+	// Find meta tags with the specified name
+	selector := fmt.Sprintf("meta[%s=\"%s\"]", metaType, name)
+	nodes := doc.Find(selector)
+
+	// Get all non-empty values from both 'value' and 'content' attributes
+	// Standard HTML meta tags use "content", so we check both
+	var values []string
+	nodes.Each(func(index int, node *goquery.Selection) {
+		// Check 'value' attribute first (matches JavaScript behavior)
+		if val, exists := node.Attr("value"); exists && val != "" {
+			values = append(values, val)
+		} else if content, exists := node.Attr("content"); exists && content != "" {
+			// Fallback to standard 'content' attribute
+			values = append(values, content)
+		}
+	})
+
+	// If we have exactly one value, return it
+	// If we have more than one value, we have a conflict and can't trust any
+	// If we have zero values, the meta tags had no values
+	if len(values) == 1 {
+		return values[0], true
+	}
+	return "", false
+}
@@ -108,6 +108,20 @@ func TestExtractFromMeta(t *testing.T) {
 		assert.Nil(t, result)
 	})
 
+	t.Run("extracts OpenGraph tags by property", func(t *testing.T) {
+		html := `
+		<html>
+			<meta property="og:title" content="Budget Vote" />
+		</html>
+		`
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+		require.NoError(t, err)
+
+		result := ExtractFromMeta(doc, []string{"og:title"}, []string{"og:title"}, true)
+		require.NotNil(t, result)
+		assert.Equal(t, "Budget Vote", *result)
+	})
+
 	t.Run("ignores duplicate meta names with empty values", func(t *testing.T) {
 		html := `
 		<html>
@@ -231,8 +245,8 @@ func TestExtractFromMeta(t *testing.T) {
 
 	// Additional tests for comprehensive coverage of meta tag patterns
 	t.Run("works with OpenGraph-style meta tags", func(t *testing.T) {
-		// Note: JavaScript implementation only looks for name="*", not property="*"
-		// So OpenGraph tags won't be found with this function as it stands
+		// name="*" is preferred, matching the JavaScript implementation;
+		// property="*" is only used when no name tag has a value
 		html := `
 		<html>
 			<meta property="og:title" value="OpenGraph Title" />
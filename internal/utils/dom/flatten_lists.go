@@ -0,0 +1,145 @@
+// ABOUTME: Normalizes malformed nested lists so markdown conversion indents them correctly
+// ABOUTME: Drops empty list items and moves wrapper-only items' lists to where they belong
+
+package dom
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// FlattenNestedLists repairs the list markup editors and CMS exports often
+// produce, where nesting is expressed with empty wrapper items. Every <li>
+// ends up with its own content as direct children:
+//
+//   - div wrappers directly inside an item are unwrapped
+//   - items with no content at all are removed
+//   - an item holding only a nested list, or a list placed directly inside
+//     another list, becomes a sublist of the previous item, or gives its
+//     items to the parent list when there is no previous item
+//
+// Items with their own text keep their sublists, so genuine multi-level
+// nesting is preserved.
+func FlattenNestedLists(doc *goquery.Document) *goquery.Document {
+	lists := doc.Find("ul, ol").Nodes
+	// Innermost lists first, so wrappers are judged on already repaired content
+	for i := len(lists) - 1; i >= 0; i-- {
+		flattenList(lists[i])
+	}
+	return doc
+}
+
+// flattenList repairs the direct children of one list element
+func flattenList(list *html.Node) {
+	for child := list.FirstChild; child != nil; {
+		next := child.NextSibling
+		switch {
+		case isListNode(child):
+			nestUnderPreviousItem(list, child, []*html.Node{child})
+		case child.Type == html.ElementNode && child.DataAtom == atom.Li:
+			unwrapDivs(child)
+			hasContent, sublists := listItemContent(child)
+			switch {
+			case hasContent:
+			case len(sublists) == 0:
+				list.RemoveChild(child)
+			default:
+				nestUnderPreviousItem(list, child, sublists)
+			}
+		}
+		child = next
+	}
+}
+
+// nestUnderPreviousItem moves sublists into the item before placeholder, or
+// when there is none, puts the sublists' items in the list in its place.
+// placeholder, an empty item or a misplaced list, is then removed.
+func nestUnderPreviousItem(list, placeholder *html.Node, sublists []*html.Node) {
+	if previous := previousListItem(placeholder); previous != nil {
+		for _, sublist := range sublists {
+			sublist.Parent.RemoveChild(sublist)
+			previous.AppendChild(sublist)
+		}
+		if placeholder.Parent == list {
+			list.RemoveChild(placeholder)
+		}
+		return
+	}
+
+	for _, sublist := range sublists {
+		for item := sublist.FirstChild; item != nil; {
+			next := item.NextSibling
+			sublist.RemoveChild(item)
+			list.InsertBefore(item, placeholder)
+			item = next
+		}
+	}
+	list.RemoveChild(placeholder)
+}
+
+// previousListItem returns the closest <li> element before node among its siblings
+func previousListItem(node *html.Node) *html.Node {
+	for sibling := node.PrevSibling; sibling != nil; sibling = sibling.PrevSibling {
+		if sibling.Type != html.ElementNode {
+			continue
+		}
+		if sibling.DataAtom == atom.Li {
+			return sibling
+		}
+		return nil
+	}
+	return nil
+}
+
+// unwrapDivs replaces div children of item with their own children
+func unwrapDivs(item *html.Node) {
+	for child := item.FirstChild; child != nil; {
+		if child.Type != html.ElementNode || child.DataAtom != atom.Div {
+			child = child.NextSibling
+			continue
+		}
+		// Continue from the first unwrapped node, which may be a div itself
+		next := child.FirstChild
+		if next == nil {
+			next = child.NextSibling
+		}
+		for grandchild := child.FirstChild; grandchild != nil; grandchild = child.FirstChild {
+			child.RemoveChild(grandchild)
+			item.InsertBefore(grandchild, child)
+		}
+		item.RemoveChild(child)
+		child = next
+	}
+}
+
+// listItemContent reports whether item has content of its own, meaning
+// anything besides whitespace, line breaks and nested lists, and returns
+// those nested lists
+func listItemContent(item *html.Node) (bool, []*html.Node) {
+	var sublists []*html.Node
+	for child := item.FirstChild; child != nil; child = child.NextSibling {
+		switch child.Type {
+		case html.TextNode:
+			if strings.TrimSpace(child.Data) != "" {
+				return true, nil
+			}
+		case html.ElementNode:
+			switch {
+			case isListNode(child):
+				sublists = append(sublists, child)
+			case child.DataAtom == atom.Br:
+			default:
+				return true, nil
+			}
+		}
+	}
+	return false, sublists
+}
+
+// isListNode reports whether node is a <ul> or <ol> element
+func isListNode(node *html.Node) bool {
+	return node.Type == html.ElementNode && (node.DataAtom == atom.Ul || node.DataAtom == atom.Ol)
+}
@@ -0,0 +1,62 @@
+package dom_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/BumpyClock/hermes/internal/utils/dom"
+)
+
+func TestFlattenNestedLists(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected string
+	}{
+		{
+			name:     "wrapper items collapse",
+			html:     `<ul><li><ul><li><ul><li>Flour</li><li>Salt</li></ul></li></ul></li></ul>`,
+			expected: `<ul><li>Flour</li><li>Salt</li></ul>`,
+		},
+		{
+			name:     "wrapper item nests under previous item",
+			html:     `<ol><li>Mix</li><li><ul><li>Flour</li></ul></li><li>Bake</li></ol>`,
+			expected: `<ol><li>Mix<ul><li>Flour</li></ul></li><li>Bake</li></ol>`,
+		},
+		{
+			name:     "list directly inside list",
+			html:     `<ul><li>Tools</li><ul><li>Saw</li></ul><li>Wood</li></ul>`,
+			expected: `<ul><li>Tools<ul><li>Saw</li></ul></li><li>Wood</li></ul>`,
+		},
+		{
+			name:     "empty items removed",
+			html:     `<ul><li> </li><li>Saw</li><li><br></li></ul>`,
+			expected: `<ul><li>Saw</li></ul>`,
+		},
+		{
+			name:     "div wrappers unwrapped",
+			html:     `<ul><li><div><div>Saw</div></div></li></ul>`,
+			expected: `<ul><li>Saw</li></ul>`,
+		},
+		{
+			name:     "genuine nesting kept",
+			html:     `<ul><li>Tools<ul><li>Saw<ul><li>Blade</li></ul></li></ul></li><li><img src="plane.jpg"></li></ul>`,
+			expected: `<ul><li>Tools<ul><li>Saw<ul><li>Blade</li></ul></li></ul></li><li><img src="plane.jpg"/></li></ul>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			require.NoError(t, err)
+
+			html, err := dom.FlattenNestedLists(doc).Find("body").Html()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, html)
+		})
+	}
+}
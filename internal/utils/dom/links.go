@@ -224,6 +224,12 @@ func GetBaseDomain(rawURL string) string {
 	return domain
 }
 
+// Query parameters added for analytics that don't change what a URL points to
+var trackingParams = []string{
+	"utm_source", "utm_medium", "utm_campaign", "utm_term", "utm_content",
+	"fbclid", "gclid", "ref", "source", "campaign",
+}
+
 // SanitizeURL cleans up a URL by removing tracking parameters and normalizing
 func SanitizeURL(rawURL string) string {
 	parsedURL, err := url.Parse(rawURL)
@@ -232,11 +238,6 @@ func SanitizeURL(rawURL string) string {
 	}
 
 	// Remove common tracking parameters
-	trackingParams := []string{
-		"utm_source", "utm_medium", "utm_campaign", "utm_term", "utm_content",
-		"fbclid", "gclid", "ref", "source", "campaign",
-	}
-
 	query := parsedURL.Query()
 	for _, param := range trackingParams {
 		query.Del(param)
@@ -244,4 +245,33 @@ func SanitizeURL(rawURL string) string {
 
 	parsedURL.RawQuery = query.Encode()
 	return parsedURL.String()
-}
\ No newline at end of file
+}
+
+// HasTrackingParams reports whether a URL carries any parameter SanitizeURL removes
+func HasTrackingParams(rawURL string) bool {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil || parsedURL.RawQuery == "" {
+		return false
+	}
+
+	query := parsedURL.Query()
+	for _, param := range trackingParams {
+		if query.Has(param) {
+			return true
+		}
+	}
+	return false
+}
+
+// StripTrackingParams runs SanitizeURL over every a[href] and img[src] in the document.
+// URLs without tracking parameters are left exactly as written.
+func StripTrackingParams(doc *goquery.Document) *goquery.Document {
+	for _, target := range []struct{ selector, attr string }{{"a[href]", "href"}, {"img[src]", "src"}} {
+		doc.Find(target.selector).Each(func(index int, element *goquery.Selection) {
+			if value := element.AttrOr(target.attr, ""); HasTrackingParams(value) {
+				element.SetAttr(target.attr, SanitizeURL(value))
+			}
+		})
+	}
+	return doc
+}
@@ -273,6 +273,25 @@ func TestSanitizeURL(t *testing.T) {
 	}
 }
 
+func TestStripTrackingParams(t *testing.T) {
+	html := `<html><body>
+		<a id="tracked" href="https://example.com/story?utm_source=feed&amp;id=7">Story</a>
+		<a id="plain" href="https://example.com/search?q=go&amp;b=2">Search</a>
+		<a id="anchor" href="#notes">Notes</a>
+		<img src="https://cdn.example.com/photo.jpg?fbclid=abc">
+	</body></html>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	require.NoError(t, err)
+
+	dom.StripTrackingParams(doc)
+
+	assert.Equal(t, "https://example.com/story?id=7", doc.Find("#tracked").AttrOr("href", ""))
+	assert.Equal(t, "https://example.com/search?q=go&b=2", doc.Find("#plain").AttrOr("href", ""), "URLs without tracking params keep their original query order")
+	assert.Equal(t, "#notes", doc.Find("#anchor").AttrOr("href", ""))
+	assert.Equal(t, "https://cdn.example.com/photo.jpg", doc.Find("img").AttrOr("src", ""))
+}
+
 func TestMakeLinksAbsolute_Srcset(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -0,0 +1,63 @@
+// ABOUTME: Lists the scored content candidates FindTopCandidate chooses from, highest score first
+// ABOUTME: Diagnostic only; describes each candidate by a CSS-like path from the document root
+
+package dom
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// CandidateScore is one scored element considered for the top candidate
+type CandidateScore struct {
+	Path  string // CSS-like path, e.g. "html > body > div#main > article.post"
+	Score int
+}
+
+// RankCandidates returns up to limit scored elements in the order
+// FindTopCandidate would prefer them: highest score first, ties in document
+// order. Call it after scoring and before the winner is merged with its
+// siblings, while the document still has its original shape.
+func RankCandidates(doc *goquery.Document, limit int) []CandidateScore {
+	var ranked []CandidateScore
+	doc.Find("[score], [data-content-score]").Each(func(index int, element *goquery.Selection) {
+		if NON_TOP_CANDIDATE_TAGS_RE.MatchString(strings.ToLower(goquery.NodeName(element))) {
+			return
+		}
+		ranked = append(ranked, CandidateScore{Path: NodePath(element), Score: getScore(element)})
+	})
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Score > ranked[j].Score
+	})
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+// NodePath describes the first element of selection by its ancestors' tag
+// names, ids and classes, e.g. "html > body > div#main > article.post.featured"
+func NodePath(selection *goquery.Selection) string {
+	var parts []string
+	for el := selection.First(); el.Length() > 0; el = el.Parent() {
+		part := goquery.NodeName(el)
+		if part == "" || part == "#document" {
+			break
+		}
+		if id, ok := el.Attr("id"); ok && strings.TrimSpace(id) != "" {
+			part += "#" + strings.TrimSpace(id)
+		}
+		for _, class := range strings.Fields(el.AttrOr("class", "")) {
+			part += "." + class
+		}
+		parts = append(parts, part)
+	}
+
+	for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+		parts[i], parts[j] = parts[j], parts[i]
+	}
+	return strings.Join(parts, " > ")
+}
@@ -0,0 +1,32 @@
+package dom_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/BumpyClock/hermes/internal/utils/dom"
+)
+
+func TestRankCandidates(t *testing.T) {
+	html := `<html><body>
+		<div id="main" class="wrap page" score="40">
+			<article class="post" score="120"><p score="30">Body</p><img score="500" src="a.jpg"></article>
+			<aside score="40"></aside>
+		</div>
+	</body></html>`
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	require.NoError(t, err)
+
+	ranked := dom.RankCandidates(doc, 3)
+	assert.Equal(t, []dom.CandidateScore{
+		{Path: "html > body > div#main.wrap.page > article.post", Score: 120},
+		{Path: "html > body > div#main.wrap.page", Score: 40}, // ties keep document order
+		{Path: "html > body > div#main.wrap.page > aside", Score: 40},
+	}, ranked, "images are never candidates and the list stops at the limit")
+
+	assert.Len(t, dom.RankCandidates(doc, 0), 4, "a zero limit returns every candidate")
+}
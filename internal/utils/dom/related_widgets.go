@@ -0,0 +1,90 @@
+// ABOUTME: Removes "related posts", "recommended" and "trending" teaser widgets from content
+// ABOUTME: Matches widgets by class, id or heading and only removes blocks that are mostly links
+
+package dom
+
+import (
+	"regexp"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Class and id fragments naming a recommendation widget
+var RELATED_WIDGET_ATTR_RE = regexp.MustCompile(`(?i)related|recommend|you-?may-?(?:also-?)?like|more-?from|trending|read-?next`)
+
+// Widget headings, e.g. "You may also like" or "More from Science"
+var RELATED_WIDGET_HEADING_RE = regexp.MustCompile(`(?i)^(?:related(?:\s+(?:posts|articles|stories|coverage|reading))?|recommended(?:\s+for\s+you)?|you\s+(?:may|might)\s+also\s+like|more\s+from\b.*|trending(?:\s+now)?|read\s+next)\s*:?$`)
+
+// Containers that can hold a widget
+const relatedWidgetContainers = "div, section, aside, ul, ol, nav"
+
+// Headings that can title a widget
+const relatedWidgetHeadings = "h2, h3, h4, h5, h6"
+
+// A widget must have at least this share of its non-heading text inside links
+const relatedWidgetLinkShare = 0.5
+
+// RemoveRelatedWidgets removes recommendation blocks such as "Related posts"
+// and "You may also like". A block qualifies when its class, id or heading
+// names it a widget and most of its text is links, so paragraphs that merely
+// link to related coverage inline are left alone. A widget heading standing
+// just before a link list is removed together with the list.
+func RemoveRelatedWidgets(doc *goquery.Document) *goquery.Document {
+	doc.Find(relatedWidgetContainers).Each(func(index int, node *goquery.Selection) {
+		if node.Closest("html").Length() == 0 {
+			return // Already removed with an enclosing widget
+		}
+		if node.HasClass(KEEP_CLASS) || node.Find("."+KEEP_CLASS).Length() > 0 {
+			return
+		}
+
+		heading := node.Find(relatedWidgetHeadings).First()
+		named := RELATED_WIDGET_ATTR_RE.MatchString(node.AttrOr("class", "") + " " + node.AttrOr("id", ""))
+		if !named && (heading.Length() == 0 || !isRelatedWidgetHeading(heading)) {
+			return
+		}
+		if mostlyLinks(node, heading) {
+			node.Remove()
+		}
+	})
+
+	// Unwrapped widgets: a heading followed directly by a list of teaser links
+	doc.Find(relatedWidgetHeadings).Each(func(index int, heading *goquery.Selection) {
+		if !isRelatedWidgetHeading(heading) {
+			return
+		}
+		list := heading.Next()
+		if list.Length() == 0 || !list.Is(relatedWidgetContainers) || !mostlyLinks(list, nil) {
+			return
+		}
+		list.Remove()
+		heading.Remove()
+	})
+
+	return doc
+}
+
+// isRelatedWidgetHeading reports whether heading text titles a recommendation widget
+func isRelatedWidgetHeading(heading *goquery.Selection) bool {
+	return RELATED_WIDGET_HEADING_RE.MatchString(normalizeSpaces(heading.Text()))
+}
+
+// mostlyLinks reports whether links hold most of node's text, not counting the heading
+func mostlyLinks(node *goquery.Selection, heading *goquery.Selection) bool {
+	textLength := len(normalizeSpaces(node.Text()))
+	if heading != nil && heading.Length() > 0 {
+		textLength -= len(normalizeSpaces(heading.Text()))
+	}
+	if textLength <= 0 {
+		return false
+	}
+
+	linkLength := 0
+	node.Find("a").Each(func(index int, link *goquery.Selection) {
+		if heading != nil && heading.Length() > 0 && link.Closest(relatedWidgetHeadings).IsSelection(heading) {
+			return // Linked headings don't count towards the teaser links
+		}
+		linkLength += len(normalizeSpaces(link.Text()))
+	})
+	return float64(linkLength)/float64(textLength) >= relatedWidgetLinkShare
+}
@@ -0,0 +1,71 @@
+package dom_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/BumpyClock/hermes/internal/utils/dom"
+)
+
+const relatedWidgetProse = `<p>The lighthouse keeper's cottage has been restored using lime mortar and timber salvaged from the original roof.</p>
+<p>Visitors can now climb the tower on weekends, and <a href="/history">the full history of the light</a> is told in the old oil store.</p>`
+
+func TestRemoveRelatedWidgets(t *testing.T) {
+	tests := []struct {
+		name    string
+		widget  string
+		removed string
+	}{
+		{
+			name:    "heading inside container",
+			widget:  `<div class="box"><h3>You may also like</h3><ul><li><a href="/a">Ten coastal walks for autumn</a></li><li><a href="/b">The last lightship crews</a></li></ul></div>`,
+			removed: "Ten coastal walks",
+		},
+		{
+			name:    "named by class",
+			widget:  `<aside class="related-posts"><a href="/c">Restoring a Victorian pier</a> <a href="/d">Harbour walls under threat</a></aside>`,
+			removed: "Victorian pier",
+		},
+		{
+			name:    "bare heading before list",
+			widget:  `<h2>More from Heritage</h2><ul><li><a href="/e">A guide to tide mills</a></li><li><a href="/f">Saving the salt works</a></li></ul>`,
+			removed: "tide mills",
+		},
+		{
+			name:    "trending by id",
+			widget:  `<section id="trending"><ol><li><a href="/g">Storm warning for the weekend</a></li></ol></section>`,
+			removed: "Storm warning",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<div class="article">` + relatedWidgetProse + tt.widget + `</div>`))
+			require.NoError(t, err)
+
+			dom.RemoveRelatedWidgets(doc)
+
+			text := doc.Text()
+			assert.NotContains(t, text, tt.removed)
+			assert.NotContains(t, strings.ToLower(text), "you may also like")
+			assert.Contains(t, text, "lime mortar")
+			assert.Equal(t, 2, doc.Find("div.article > p").Length())
+			assert.Equal(t, 1, doc.Find(`a[href="/history"]`).Length())
+		})
+	}
+}
+
+func TestRemoveRelatedWidgetsKeepsProse(t *testing.T) {
+	html := `<div class="related-coverage">` + relatedWidgetProse + `</div><h3>Related</h3><p>Our earlier report on the restoration appeared in the spring, and the appeal has since raised its target.</p>`
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	require.NoError(t, err)
+
+	dom.RemoveRelatedWidgets(doc)
+
+	assert.Equal(t, 3, doc.Find("p").Length())
+	assert.Equal(t, 1, doc.Find("h3").Length())
+}
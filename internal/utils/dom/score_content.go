@@ -86,4 +86,20 @@ func ScoreContent(doc *goquery.Document, weightNodes bool) {
 	scorePs(doc, weightNodes)
 	// JavaScript: scorePs($, weightNodes);
 	scorePs(doc, weightNodes)
-}
\ No newline at end of file
+}
+
+// BoostContentHint nudges scoring toward elements matching selector by adding
+// ContentHintBoost to each match holding at least ContentHintMinLength
+// characters of text. Call it after ScoreContent; matches keep their earned
+// score, so low quality regions can still lose to better candidates.
+func BoostContentHint(doc *goquery.Document, selector string) {
+	if strings.TrimSpace(selector) == "" {
+		return
+	}
+	doc.Find(selector).Each(func(index int, element *goquery.Selection) {
+		if textLength(element) < ContentHintMinLength {
+			return
+		}
+		addScore(element, ContentHintBoost)
+	})
+}
@@ -268,4 +268,33 @@ func TestScoreContentWeightNodesParameter(t *testing.T) {
 	if score1 <= score2 {
 		t.Errorf("Expected weighted scoring to produce higher scores. Weighted: %d, Unweighted: %d", score1, score2)
 	}
-}
\ No newline at end of file
+}
+
+func TestBoostContentHint(t *testing.T) {
+	long := strings.Repeat("The ferry returned to service after a long refit, with commuters queueing early. ", 4)
+	html := `<div class="report"><p>` + long + `</p></div><div class="teaser"><p>Short teaser text.</p></div>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+
+	ScoreContent(doc, true)
+	reportBefore := getScore(doc.Find(".report"))
+	teaserBefore := getScore(doc.Find(".teaser"))
+
+	BoostContentHint(doc, ".report, .teaser")
+
+	if got := getScore(doc.Find(".report")); got != reportBefore+ContentHintBoost {
+		t.Errorf("Expected hinted report score %d, got %d", reportBefore+ContentHintBoost, got)
+	}
+	if got := getScore(doc.Find(".teaser")); got != teaserBefore {
+		t.Errorf("Expected short hinted element to keep score %d, got %d", teaserBefore, got)
+	}
+
+	// An empty hint changes nothing
+	BoostContentHint(doc, "")
+	if got := getScore(doc.Find(".report")); got != reportBefore+ContentHintBoost {
+		t.Errorf("Expected empty hint to leave score at %d, got %d", reportBefore+ContentHintBoost, got)
+	}
+}
@@ -43,6 +43,12 @@ func StripUnlikelyCandidates(doc *goquery.Document) *goquery.Document {
 			return
 		}
 
+		// Comment sections are never article content, whatever else they are labelled
+		if isCommentContainer(classAndId) {
+			node.Remove()
+			return
+		}
+
 		// Check against whitelist first - if it matches, keep it
 		if CANDIDATES_WHITELIST.MatchString(classAndId) {
 			return
@@ -55,4 +61,39 @@ func StripUnlikelyCandidates(doc *goquery.Document) *goquery.Document {
 	})
 
 	return doc
-}
\ No newline at end of file
+}
+
+// StripCommentSections removes reader comment containers such as #comments,
+// .comments-area and Disqus threads. Unlike StripUnlikelyCandidates it runs on
+// every extraction pass, so comments cannot leak in through lax fallbacks.
+func StripCommentSections(doc *goquery.Document) *goquery.Document {
+	doc.Find("[class], [id]").Not("a, html, body").Each(func(index int, node *goquery.Selection) {
+		if isCommentContainer(node.AttrOr("class", "") + " " + node.AttrOr("id", "")) {
+			node.Remove()
+		}
+	})
+
+	return doc
+}
+
+// isCommentContainer reports whether any class or id token names a comment section
+func isCommentContainer(classAndId string) bool {
+	for _, token := range strings.Fields(classAndId) {
+		if COMMENT_CONTAINER_RE.MatchString(token) {
+			return true
+		}
+	}
+	return false
+}
+
+// InCommentSection reports whether selection or one of its ancestors is a
+// reader comment container, using the same class and id tokens as
+// StripCommentSections
+func InCommentSection(selection *goquery.Selection) bool {
+	for node := selection.First(); node.Length() > 0; node = node.Parent() {
+		if isCommentContainer(node.AttrOr("class", "") + " " + node.AttrOr("id", "")) {
+			return true
+		}
+	}
+	return false
+}
@@ -56,6 +56,17 @@ func TestStripUnlikelyCandidates(t *testing.T) {
 			expectedRemain: []string{".sidebar.content"},
 			expectedGone:   []string{".sidebar:not(.content)"},
 		},
+		{
+			name: "comment sections override whitelist",
+			html: `<html><body>
+				<div class="article-content">Article text</div>
+				<section class="article-comments content">Reader comments</section>
+				<div id="comments" class="page-section">More comments</div>
+				<div id="disqus_thread" class="main">Disqus</div>
+			</body></html>`,
+			expectedRemain: []string{".article-content"},
+			expectedGone:   []string{".article-comments", "#comments", "#disqus_thread"},
+		},
 		{
 			name: "handles elements without class or id",
 			html: `<html><body>
@@ -168,4 +179,32 @@ func BenchmarkStripUnlikelyCandidates(b *testing.B) {
 		freshDoc, _ := goquery.NewDocumentFromReader(strings.NewReader(html))
 		dom.StripUnlikelyCandidates(freshDoc)
 	}
-}
\ No newline at end of file
+}
+
+func TestStripCommentSections(t *testing.T) {
+	html := `<html><body class="single comments-open">
+		<article class="post-content">
+			<p>Article text that talks about a comment made by the minister.</p>
+			<span class="comment-count">12 comments</span>
+		</article>
+		<div id="respond" class="comment-respond">Leave a reply</div>
+		<ol class="commentlist"><li class="comment">First!</li></ol>
+		<div class="wp-comments-area">Thread</div>
+		<div class="dsq-widget">Disqus widget</div>
+		<a href="#comments" class="comments-link">Jump to comments</a>
+	</body></html>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	require.NoError(t, err)
+
+	result := dom.StripCommentSections(doc)
+
+	assert.Equal(t, 1, result.Find("body").Length())
+	assert.Equal(t, 1, result.Find(".post-content").Length())
+	assert.Equal(t, 1, result.Find(".comment-count").Length(), "count labels are not comment sections")
+	assert.Equal(t, 1, result.Find("a.comments-link").Length(), "links are never stripped")
+	assert.Equal(t, 0, result.Find(".comment-respond").Length())
+	assert.Equal(t, 0, result.Find(".commentlist").Length())
+	assert.Equal(t, 0, result.Find(".wp-comments-area").Length())
+	assert.Equal(t, 0, result.Find(".dsq-widget").Length())
+}
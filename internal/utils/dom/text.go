@@ -0,0 +1,152 @@
+// ABOUTME: Plain-text rendering of a DOM subtree that keeps block-level structure as line breaks
+// ABOUTME: Paragraph-level blocks are separated by blank lines, list items and table rows by single newlines
+
+package dom
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Block-level tags that only start a new line rather than a new paragraph
+var lineBreakTags = map[string]bool{
+	"br": true,
+	"li": true,
+	"dt": true,
+	"dd": true,
+	"tr": true,
+}
+
+// Tags whose text is written verbatim, keeping code indentation and alignment
+var preformattedTags = map[string]bool{
+	"pre":      true,
+	"code":     true,
+	"textarea": true,
+}
+
+// Tags whose text never belongs in the output
+var skipTextTags = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"noscript": true,
+	"template": true,
+}
+
+// TextWithBreaks returns the text of a selection with block structure preserved.
+// Paragraph-level elements (p, div, h1-h6, ...) are separated by a blank line,
+// list items, table rows and br by a single newline. Whitespace within a line
+// is collapsed to single spaces, except inside pre, code and textarea, whose
+// text is kept exactly.
+func TextWithBreaks(s *goquery.Selection) string {
+	w := &breakWriter{}
+	s.Each(func(i int, node *goquery.Selection) {
+		w.walk(node)
+	})
+	return w.sb.String()
+}
+
+// breakWriter accumulates text, deferring line breaks until the next visible text
+// so nested blocks produce a single separator instead of stacked blank lines
+type breakWriter struct {
+	sb      strings.Builder
+	pending int  // Newlines owed before the next text
+	space   bool // A collapsed space is owed before the next text
+	raw     int  // Depth of preformatted elements around the current node
+}
+
+// walk writes the text of a node, marking line breaks around block elements
+func (w *breakWriter) walk(s *goquery.Selection) {
+	if len(s.Nodes) == 0 {
+		return
+	}
+
+	tagName := goquery.NodeName(s)
+	switch {
+	case tagName == "#text":
+		if w.raw > 0 {
+			w.writeRaw(s.Text())
+		} else {
+			w.writeText(s.Text())
+		}
+		return
+	case tagName == "#comment" || skipTextTags[tagName]:
+		return
+	}
+
+	breaks := 0
+	if lineBreakTags[tagName] {
+		breaks = 1
+	} else if BLOCK_LEVEL_TAGS_RE.MatchString(tagName) {
+		breaks = 2
+	}
+
+	preformatted := preformattedTags[tagName]
+	if preformatted {
+		w.raw++
+	}
+
+	w.breakLine(breaks)
+	s.Contents().Each(func(i int, child *goquery.Selection) {
+		w.walk(child)
+	})
+	w.breakLine(breaks)
+
+	if preformatted {
+		w.raw--
+	}
+}
+
+// breakLine requests at least n newlines before the next text
+func (w *breakWriter) breakLine(n int) {
+	if n > w.pending {
+		w.pending = n
+	}
+}
+
+// writeText writes a text node, collapsing whitespace runs to single spaces
+func (w *breakWriter) writeText(text string) {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		if text != "" {
+			w.space = true
+		}
+		return
+	}
+
+	startsWithSpace := strings.TrimLeft(text, " \t\n\r\f") != text
+	if w.sb.Len() > 0 {
+		if w.pending > 0 {
+			w.sb.WriteString(strings.Repeat("\n", w.pending))
+		} else if w.space || startsWithSpace {
+			w.sb.WriteByte(' ')
+		}
+	}
+	w.sb.WriteString(strings.Join(words, " "))
+
+	w.pending = 0
+	w.space = strings.TrimRight(text, " \t\n\r\f") != text
+}
+
+// writeRaw writes preformatted text unchanged. Trailing newlines are held back as
+// pending breaks so a code block does not stack extra blank lines after it.
+func (w *breakWriter) writeRaw(text string) {
+	body := strings.TrimRight(text, "\n")
+	trailing := len(text) - len(body)
+	if body == "" {
+		w.pending += trailing
+		return
+	}
+
+	if w.pending > 0 {
+		if w.sb.Len() > 0 {
+			w.sb.WriteString(strings.Repeat("\n", w.pending))
+		}
+	} else if w.space && w.sb.Len() > 0 {
+		w.sb.WriteByte(' ')
+	}
+	w.sb.WriteString(body)
+
+	w.pending = trailing
+	w.space = false
+}
@@ -0,0 +1,80 @@
+package dom_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/BumpyClock/hermes/internal/utils/dom"
+)
+
+func TestTextWithBreaks(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected string
+	}{
+		{
+			name: "separates paragraphs with blank lines",
+			html: `<div>
+				<p>First   paragraph
+				wraps here.</p>
+				<p>Second <em>paragraph</em>.</p>
+				<h2>Heading</h2>
+				<p>Third paragraph.</p>
+			</div>`,
+			expected: "First paragraph wraps here.\n\nSecond paragraph.\n\nHeading\n\nThird paragraph.",
+		},
+		{
+			name:     "puts list items on their own lines",
+			html:     `<p>Ingredients:</p><ul><li>Flour</li><li>Sugar</li></ul><p>Mix well.</p>`,
+			expected: "Ingredients:\n\nFlour\nSugar\n\nMix well.",
+		},
+		{
+			name:     "nested blocks produce a single separator",
+			html:     `<div><div><p>Inner one</p></div></div><div><p>Inner two</p></div>`,
+			expected: "Inner one\n\nInner two",
+		},
+		{
+			name:     "keeps spaces between inline elements",
+			html:     `<p><a href="/a">Link</a> <strong>bold</strong>text and<br>a new line</p>`,
+			expected: "Link boldtext and\na new line",
+		},
+		{
+			name: "keeps code blocks verbatim",
+			html: `<p>Define   the
+				function:</p><pre><code>def greet(name):
+    if name:
+        return "Hello, " + name
+</code></pre><p>Then   call it.</p>`,
+			expected: "Define the function:\n\ndef greet(name):\n    if name:\n        return \"Hello, \" + name\n\nThen call it.",
+		},
+		{
+			name:     "keeps spacing in inline code",
+			html:     `<p>Set  <code>x  =  1</code>  before   looping.</p>`,
+			expected: "Set x  =  1 before looping.",
+		},
+		{
+			name:     "keeps whitespace between highlighted tokens",
+			html:     "<pre><span>for</span> i <span>in</span> items:\n    <span>print</span>(i)</pre>",
+			expected: "for i in items:\n    print(i)",
+		},
+		{
+			name:     "skips scripts and styles",
+			html:     `<p>Visible</p><script>var hidden = true;</script><style>p { color: red; }</style>`,
+			expected: "Visible",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, dom.TextWithBreaks(doc.Find("body")))
+		})
+	}
+}
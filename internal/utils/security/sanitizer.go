@@ -4,28 +4,52 @@
 package security
 
 import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
 	"github.com/microcosm-cc/bluemonday"
 )
 
+// DefaultEmbedHosts are the embed providers, besides YouTube and Vimeo video
+// players, whose iframes are kept in article content. A listed host also
+// covers its subdomains.
+var DefaultEmbedHosts = []string{
+	"www.redditmedia.com",
+	"platform.twitter.com",
+	"www.instagram.com",
+	"open.spotify.com",
+	"codepen.io",
+	"w.soundcloud.com",
+}
+
 var (
 	// StrictSanitizer allows only basic text formatting tags
 	StrictSanitizer = bluemonday.StrictPolicy()
 	
 	// ArticleSanitizer allows common article formatting but removes dangerous elements
-	ArticleSanitizer = createArticlePolicy()
+	ArticleSanitizer = createArticlePolicy(nil)
 	
 	// UGCSanitizer for user-generated content with moderate restrictions
 	UGCSanitizer = bluemonday.UGCPolicy()
+	
+	// ArticleStyleSanitizer is ArticleSanitizer plus a small allowlist of safe inline styles
+	ArticleStyleSanitizer = createArticleStylePolicy(nil)
 )
 
-// createArticlePolicy creates a policy suitable for article content
-func createArticlePolicy() *bluemonday.Policy {
+// createArticlePolicy creates a policy suitable for article content, keeping
+// iframes from video players, DefaultEmbedHosts and embedHosts
+func createArticlePolicy(embedHosts []string) *bluemonday.Policy {
 	p := bluemonday.NewPolicy()
 	
 	// Allow common article formatting
 	p.AllowElements("p", "br", "strong", "b", "em", "i", "u", "h1", "h2", "h3", "h4", "h5", "h6")
 	p.AllowElements("ul", "ol", "li", "blockquote", "pre", "code")
 	p.AllowElements("img", "a", "span", "div")
+	p.AllowElements("table", "caption", "thead", "tbody", "tfoot", "tr", "th", "td")
+	p.AllowAttrs("colspan", "rowspan").Matching(bluemonday.Integer).OnElements("th", "td")
 	
 	// Allow links with href
 	p.AllowAttrs("href").OnElements("a")
@@ -37,12 +61,78 @@ func createArticlePolicy() *bluemonday.Policy {
 	// Allow basic styling classes (but sanitize the actual CSS)
 	p.AllowAttrs("class").OnElements("div", "span", "p", "img", "a")
 	
+	// Allow embedded video players and other known embeds. RequireNoReferrerOnLinks
+	// routes every URL through the scheme allowlist, so only embed URLs pass it.
+	isEmbed := func(u *url.URL) bool {
+		return IsEmbedURL(u, embedHosts)
+	}
+	p.AllowElements("iframe")
+	p.AllowAttrs("src", "width", "height", "title", "allowfullscreen").OnElements("iframe")
+	p.AllowURLSchemeWithCustomPolicy("https", isEmbed)
+	p.AllowURLSchemeWithCustomPolicy("http", isEmbed)
+	
 	// Allow id for anchor links
 	p.AllowAttrs("id").OnElements("h1", "h2", "h3", "h4", "h5", "h6", "div", "span")
 	
 	return p
 }
 
+// isVideoEmbedURL reports whether u is a YouTube or Vimeo player URL
+func isVideoEmbedURL(u *url.URL) bool {
+	switch strings.ToLower(u.Hostname()) {
+	case "www.youtube.com", "youtube.com", "www.youtube-nocookie.com", "youtube-nocookie.com":
+		return strings.HasPrefix(u.Path, "/embed/")
+	case "player.vimeo.com":
+		return strings.HasPrefix(u.Path, "/video/")
+	}
+	return false
+}
+
+// IsEmbedURL reports whether the article sanitizer keeps an iframe loading
+// u: a video player or a page on an embed host from IsEmbedHost
+func IsEmbedURL(u *url.URL, extraHosts []string) bool {
+	return isVideoEmbedURL(u) || IsEmbedHost(u.Hostname(), extraHosts)
+}
+
+// IsEmbedHost reports whether host is one of DefaultEmbedHosts or
+// extraHosts, or a subdomain of one
+func IsEmbedHost(host string, extraHosts []string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "" {
+		return false
+	}
+	for _, lists := range [][]string{DefaultEmbedHosts, extraHosts} {
+		for _, allowed := range lists {
+			allowed = strings.ToLower(strings.TrimSpace(allowed))
+			if allowed != "" && (host == allowed || strings.HasSuffix(host, "."+allowed)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SafeStyleProperties lists the inline CSS properties kept with KeepSafeStyles,
+// each with the only values allowed. Values are matched against a fixed keyword
+// list, so constructs like url() or expression() can never get through.
+var SafeStyleProperties = map[string]*regexp.Regexp{
+	"text-align":  regexp.MustCompile(`^(left|right|center|justify|start|end)$`),
+	"font-style":  regexp.MustCompile(`^(normal|italic|oblique)$`),
+	"font-weight": regexp.MustCompile(`^(normal|bold|bolder|lighter|[1-9]00)$`),
+}
+
+// createArticleStylePolicy extends the article policy with the inline styles in
+// SafeStyleProperties, so url(), expression() and friends are dropped.
+func createArticleStylePolicy(embedHosts []string) *bluemonday.Policy {
+	p := createArticlePolicy(embedHosts)
+	
+	for property, allowed := range SafeStyleProperties {
+		p.AllowStyles(property).Matching(allowed).Globally()
+	}
+	
+	return p
+}
+
 // SanitizeHTML sanitizes HTML content for safe display
 func SanitizeHTML(html string) string {
 	return ArticleSanitizer.Sanitize(html)
@@ -56,4 +146,43 @@ func SanitizeHTMLStrict(html string) string {
 // SanitizeUserContent sanitizes user-generated content
 func SanitizeUserContent(html string) string {
 	return UGCSanitizer.Sanitize(html)
-}
\ No newline at end of file
+}
+
+// SanitizeHTMLWithStyles sanitizes HTML content like SanitizeHTML but keeps safe inline styles
+func SanitizeHTMLWithStyles(html string) string {
+	return ArticleStyleSanitizer.Sanitize(html)
+}
+
+// embedPolicies caches article policies built for extra embed hosts, keyed
+// by whether styles are kept and the sorted host list
+var embedPolicies sync.Map
+
+// SanitizeHTMLWithEmbeds sanitizes like SanitizeHTML, or SanitizeHTMLWithStyles
+// when keepStyles is set, additionally keeping iframes from embedHosts
+func SanitizeHTMLWithEmbeds(html string, embedHosts []string, keepStyles bool) string {
+	if len(embedHosts) == 0 {
+		if keepStyles {
+			return SanitizeHTMLWithStyles(html)
+		}
+		return SanitizeHTML(html)
+	}
+
+	hosts := append([]string(nil), embedHosts...)
+	sort.Strings(hosts)
+	key := strings.Join(hosts, ",")
+	if keepStyles {
+		key = "styles:" + key
+	}
+	if cached, ok := embedPolicies.Load(key); ok {
+		return cached.(*bluemonday.Policy).Sanitize(html)
+	}
+
+	var policy *bluemonday.Policy
+	if keepStyles {
+		policy = createArticleStylePolicy(hosts)
+	} else {
+		policy = createArticlePolicy(hosts)
+	}
+	cached, _ := embedPolicies.LoadOrStore(key, policy)
+	return cached.(*bluemonday.Policy).Sanitize(html)
+}
@@ -11,6 +11,12 @@ import (
 
 // ParseDate attempts to parse a date string using various methods
 func ParseDate(dateStr string) (*time.Time, error) {
+	return parseDateAt(dateStr, time.Now())
+}
+
+// parseDateAt is ParseDate with relative dates ("yesterday", "3 days ago")
+// and year-less dates resolved against now
+func parseDateAt(dateStr string, now time.Time) (*time.Time, error) {
 	if dateStr == "" {
 		return nil, fmt.Errorf("empty date string")
 	}
@@ -23,7 +29,7 @@ func ParseDate(dateStr string) (*time.Time, error) {
 
 	// Try go-dateparser first (most flexible)
 	cfg := &dateparser.Configuration{
-		CurrentTime:   time.Now(),
+		CurrentTime:   now,
 		StrictParsing: false,
 	}
 
@@ -95,6 +101,135 @@ func cleanDateString(dateStr string) string {
 	return strings.TrimSpace(dateStr)
 }
 
+// Unambiguous layouts tried before locale-aware parsing so a day-first
+// date order never reinterprets ISO dates
+var isoDateFormats = []string{
+	time.RFC3339,
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000Z",
+	"2006-01-02T15:04:05Z",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"2006/01/02",
+}
+
+// Regions that write numeric dates month first (e.g. 02/03/2024 is February 3rd)
+var monthFirstRegions = map[string]bool{
+	"us": true, // United States
+	"ph": true, // Philippines
+	"fm": true, // Micronesia
+	"mh": true, // Marshall Islands
+	"pw": true, // Palau
+	"ca": true, // Canada, English usage
+}
+
+// ParseDateWithLocale parses a date string using locale conventions.
+// The locale (e.g. "en-GB", "fr", "de_DE") decides day-month vs month-day ordering
+// for ambiguous numeric dates and which language month names are read in.
+// An empty locale keeps the default US behavior of ParseDate.
+func ParseDateWithLocale(dateStr, locale string) (*time.Time, error) {
+	return ParseDateAt(dateStr, locale, time.Now())
+}
+
+// ParseDateAt parses like ParseDateWithLocale, resolving relative dates
+// ("yesterday", "3 days ago") and dates without a year against now
+func ParseDateAt(dateStr, locale string, now time.Time) (*time.Time, error) {
+	if locale == "" {
+		return parseDateAt(dateStr, now)
+	}
+	if dateStr == "" {
+		return nil, fmt.Errorf("empty date string")
+	}
+
+	// Keep accented characters so localized month names ("février", "März") survive
+	dateStr = cleanLocalizedDateString(dateStr)
+	if dateStr == "" {
+		return nil, fmt.Errorf("date string became empty after cleaning")
+	}
+
+	for _, format := range isoDateFormats {
+		if t, err := time.Parse(format, dateStr); err == nil {
+			return &t, nil
+		}
+	}
+
+	language, dayFirst := parseLocale(locale)
+	dateOrder := dateparser.MDY
+	if dayFirst {
+		dateOrder = dateparser.DMY
+	}
+
+	// English month names are common on non-English sites, so always allow them
+	languages := []string{"en"}
+	if language != "" && language != "en" {
+		languages = []string{language, "en"}
+	}
+
+	cfg := &dateparser.Configuration{
+		CurrentTime:   now,
+		StrictParsing: false,
+		Languages:     languages,
+		DateOrder:     dateOrder,
+	}
+	if parsedTime, err := dateparser.Parse(cfg, dateStr); err == nil {
+		return &parsedTime.Time, nil
+	}
+
+	// Unknown languages make dateparser fail outright, retry with English only
+	cfg.Languages = []string{"en"}
+	if parsedTime, err := dateparser.Parse(cfg, dateStr); err == nil {
+		return &parsedTime.Time, nil
+	}
+
+	return nil, fmt.Errorf("unable to parse date: %s", dateStr)
+}
+
+// parseLocale splits a locale into its language and whether numeric dates are day first
+func parseLocale(locale string) (string, bool) {
+	parts := strings.FieldsFunc(strings.ToLower(locale), func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+	if len(parts) == 0 {
+		return "", false
+	}
+
+	language := parts[0]
+	region := ""
+	if len(parts) > 1 {
+		region = parts[len(parts)-1]
+	}
+
+	// French Canadians write day first
+	if region == "ca" && language == "fr" {
+		return language, true
+	}
+	if region != "" {
+		return language, !monthFirstRegions[region]
+	}
+
+	// A bare "en" keeps the US default, every other language is day first
+	return language, language != "en"
+}
+
+// cleanLocalizedDateString cleans a date string like cleanDateString but keeps non-ASCII letters
+func cleanLocalizedDateString(dateStr string) string {
+	dateStr = strings.TrimSpace(dateStr)
+
+	prefixes := []string{"Published:", "Updated:", "Date:", "Posted:", "By "}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(dateStr, prefix) {
+			dateStr = strings.TrimSpace(dateStr[len(prefix):])
+		}
+	}
+
+	htmlTagRegex := regexp.MustCompile(`<[^>]*>`)
+	dateStr = htmlTagRegex.ReplaceAllString(dateStr, "")
+
+	// Collapsing whitespace also normalizes non-breaking spaces
+	return strings.Join(strings.Fields(dateStr), " ")
+}
+
 // ParseDateFromMeta parses dates from meta tag content
 func ParseDateFromMeta(content string) (*time.Time, error) {
 	// Meta tags often have ISO format
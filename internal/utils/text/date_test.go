@@ -41,6 +41,53 @@ func TestParseDate(t *testing.T) {
 	}
 }
 
+func TestParseDateWithLocale(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		locale string
+		month  time.Month
+		day    int
+	}{
+		{"Default US ordering", "02/03/2024", "", time.February, 3},
+		{"US locale", "02/03/2024", "en-US", time.February, 3},
+		{"British locale", "02/03/2024", "en-GB", time.March, 2},
+		{"French language", "02/03/2024", "fr", time.March, 2},
+		{"Underscore separator", "02/03/2024", "de_DE", time.March, 2},
+		{"French month name", "3 janvier 2024", "fr", time.January, 3},
+		{"English month name with EU locale", "March 2, 2024", "en-GB", time.March, 2},
+		{"ISO date unaffected by day-first locale", "2024-03-02", "en-GB", time.March, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := text.ParseDateWithLocale(tt.input, tt.locale)
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			
+			assert.Equal(t, 2024, result.Year())
+			assert.Equal(t, tt.month, result.Month())
+			assert.Equal(t, tt.day, result.Day())
+		})
+	}
+	
+	_, err := text.ParseDateWithLocale("", "en-GB")
+	assert.Error(t, err)
+}
+
+func TestParseDateAt(t *testing.T) {
+	now := time.Date(2021, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	for _, locale := range []string{"", "en-GB"} {
+		result, err := text.ParseDateAt("yesterday", locale, now)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.Equal(t, 2021, result.Year())
+		assert.Equal(t, time.June, result.Month())
+		assert.Equal(t, 14, result.Day())
+	}
+}
+
 func TestParseDateFromMeta(t *testing.T) {
 	tests := []struct {
 		name  string
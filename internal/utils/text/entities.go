@@ -0,0 +1,39 @@
+// ABOUTME: Decodes leftover HTML entities and folds typographic quotes in plain text output
+// ABOUTME: Handles double-escaped source markup that survives HTML parsing as literal entities
+
+package text
+
+import (
+	"html"
+	"strings"
+)
+
+// asciiQuotes maps typographic quotes and primes to their ASCII equivalents
+var asciiQuotes = strings.NewReplacer(
+	"\u2018", "'", // ‘ left single quote
+	"\u2019", "'", // ’ right single quote and apostrophe
+	"\u201A", "'", // ‚ low single quote
+	"\u201B", "'", // ‛ reversed single quote
+	"\u2032", "'", // ′ prime
+	"\u201C", `"`, // “ left double quote
+	"\u201D", `"`, // ” right double quote
+	"\u201E", `"`, // „ low double quote
+	"\u201F", `"`, // ‟ reversed double quote
+	"\u2033", `"`, // ″ double prime
+)
+
+// DecodeEntities decodes HTML entities left in plain text. Parsing HTML
+// already decodes single entities, so this catches double-escaped markup
+// such as "&amp;#8217;", which would otherwise reach the text as "&#8217;".
+// Ampersands that do not start an entity are left alone.
+func DecodeEntities(s string) string {
+	if !strings.Contains(s, "&") {
+		return s
+	}
+	return html.UnescapeString(s)
+}
+
+// ASCIIQuotes replaces curly single and double quotes with ' and "
+func ASCIIQuotes(s string) string {
+	return asciiQuotes.Replace(s)
+}
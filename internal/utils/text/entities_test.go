@@ -0,0 +1,33 @@
+// ABOUTME: Tests for entity decoding and ASCII quote folding in plain text
+// ABOUTME: Covers double-escaped entities, bare ampersands and curly quotes
+
+package text
+
+import "testing"
+
+func TestDecodeEntities(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Salt &amp; pepper", "Salt & pepper"},
+		{"grandmother&#8217;s rule", "grandmother’s rule"},
+		{"basics &#8212; optional", "basics — optional"},
+		{"AT&T & friends", "AT&T & friends"},
+		{"no entities", "no entities"},
+	}
+
+	for _, tt := range tests {
+		if got := DecodeEntities(tt.input); got != tt.expected {
+			t.Errorf("DecodeEntities(%q) = %q, expected %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestASCIIQuotes(t *testing.T) {
+	input := "“It’s ‘fine’,” she said — twice."
+	expected := `"It's 'fine'," she said ` + "— twice."
+	if got := ASCIIQuotes(input); got != expected {
+		t.Errorf("ASCIIQuotes(%q) = %q, expected %q", input, got, expected)
+	}
+}
@@ -0,0 +1,90 @@
+// ABOUTME: Removes zero-width and control characters, and optionally emoji, from plain text output
+// ABOUTME: Leaves tabs and line breaks alone and closes the gaps removed emoji leave between words
+
+package text
+
+import (
+	"strings"
+	"unicode"
+)
+
+// isInvisible reports whether r is a zero-width or control character that
+// carries nothing readable: C0 and C1 controls other than tab and line
+// breaks, zero-width spaces and joiners, word joiners, soft hyphens and byte
+// order marks
+func isInvisible(r rune) bool {
+	switch r {
+	case '\t', '\n', '\r':
+		return false
+	case '\u00AD', '\u180E', '\u200B', '\u200C', '\u200D', '\u2060', '\uFEFF':
+		return true
+	}
+	return unicode.IsControl(r)
+}
+
+// isEmoji reports whether r is an emoji or part of an emoji sequence:
+// pictographs, flags, skin tone modifiers, variation selectors and keycaps.
+// Symbols with a plain text form, such as © and ™, are not included.
+func isEmoji(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // Mahjong through Symbols and Pictographs Extended-A, incl. flags and skin tones
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // Miscellaneous Symbols and Dingbats
+		return true
+	case r >= 0x2B00 && r <= 0x2BFF: // Arrows and stars such as ⭐
+		return true
+	case r >= 0xE0020 && r <= 0xE007F: // Tag characters in subdivision flags
+		return true
+	case r == 0xFE0E || r == 0xFE0F || r == 0x20E3: // Variation selectors and the keycap combiner
+		return true
+	}
+	return false
+}
+
+// StripInvisible removes zero-width and control characters from s, which
+// otherwise corrupt tokenizers, search indexes and string comparisons
+func StripInvisible(s string) string {
+	if strings.IndexFunc(s, isInvisible) < 0 {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		if isInvisible(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// StripEmoji removes emoji from s. A space left doubled, or leading a line,
+// by a removed emoji is dropped too, so "🎉 Launch day 🎉" becomes
+// "Launch day".
+func StripEmoji(s string) string {
+	if strings.IndexFunc(s, isEmoji) < 0 {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	dropped := false
+	for _, r := range s {
+		if isEmoji(r) {
+			dropped = true
+			continue
+		}
+		if r == ' ' && dropped {
+			out := b.String()
+			if out == "" || strings.HasSuffix(out, " ") || strings.HasSuffix(out, "\n") {
+				continue
+			}
+		}
+		if r == '\n' && dropped {
+			// Trailing space before the line break was left by an emoji
+			trimmed := strings.TrimRight(b.String(), " ")
+			b.Reset()
+			b.WriteString(trimmed)
+		}
+		dropped = false
+		b.WriteRune(r)
+	}
+	return strings.TrimRight(b.String(), " ")
+}
@@ -0,0 +1,48 @@
+// ABOUTME: Tests for removing invisible characters and emoji from plain text
+// ABOUTME: Covers zero-width spaces and joiners, control characters, emoji sequences and spacing
+
+package text
+
+import "testing"
+
+func TestStripInvisible(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Zero\u200Bwidth\u200Cspaces\u200D", "Zerowidthspaces"},
+		{"\uFEFFByte order mark", "Byte order mark"},
+		{"Soft\u00ADhyphen and word\u2060joiner", "Softhyphen and wordjoiner"},
+		{"Bell\x07 and escape\x1b and C1\u0085", "Bell and escape and C1"},
+		{"Tabs\tand\nlines\r\nkept", "Tabs\tand\nlines\r\nkept"},
+		{"Emoji 🎉 kept", "Emoji 🎉 kept"},
+	}
+
+	for _, tt := range tests {
+		if got := StripInvisible(tt.input); got != tt.expected {
+			t.Errorf("StripInvisible(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestStripEmoji(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"🎉 Launch day 🎉", "Launch day"},
+		{"Launch 🚀 day", "Launch day"},
+		{"Flags 🇫🇷🇩🇪 and skin tones 👍🏽 go", "Flags and skin tones go"},
+		{"Weather ☀️ today", "Weather today"},
+		{"Keycap 1️⃣ two", "Keycap 1 two"},
+		{"Line one 🎉\n🎉 line two", "Line one\nline two"},
+		{"Copyright © and ™ stay", "Copyright © and ™ stay"},
+		{"No emoji", "No emoji"},
+	}
+
+	for _, tt := range tests {
+		if got := StripEmoji(tt.input); got != tt.expected {
+			t.Errorf("StripEmoji(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
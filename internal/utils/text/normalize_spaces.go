@@ -12,14 +12,15 @@ import (
 // This is part of the JavaScript regex /\s{2,}(?![^<>]*<\/(pre|code|textarea)>)/g
 var MULTIPLE_SPACES_RE = regexp.MustCompile(`\s{2,}`)
 
-// PRE_TAG_RE finds pre tags and their content (only closed tags)
-var PRE_TAG_RE = regexp.MustCompile(`(?i)<pre[^>]*>.*?</pre>`)
+// PRE_TAG_RE finds pre tags and their content (only closed tags), including
+// blocks spanning several lines so code indentation survives normalization
+var PRE_TAG_RE = regexp.MustCompile(`(?is)<pre[^>]*>.*?</pre>`)
 
 // CODE_TAG_RE finds code tags and their content (only closed tags)
-var CODE_TAG_RE = regexp.MustCompile(`(?i)<code[^>]*>.*?</code>`)
+var CODE_TAG_RE = regexp.MustCompile(`(?is)<code[^>]*>.*?</code>`)
 
 // TEXTAREA_TAG_RE finds textarea tags and their content (only closed tags)
-var TEXTAREA_TAG_RE = regexp.MustCompile(`(?i)<textarea[^>]*>.*?</textarea>`)
+var TEXTAREA_TAG_RE = regexp.MustCompile(`(?is)<textarea[^>]*>.*?</textarea>`)
 
 // NormalizeSpaces normalizes consecutive whitespace characters to single spaces
 // while preserving spacing within pre, code, and textarea HTML tags.
@@ -52,6 +52,11 @@ func TestNormalizeSpaces(t *testing.T) {
 			input:    "<div><p>Text   1</p><pre>  pre  </pre><p>Text   2</p><code>  code  </code><p>Text   3</p><textarea>  textarea  </textarea></div>",
 			expected: "<div><p>Text 1</p><pre>  pre  </pre><p>Text 2</p><code>  code  </code><p>Text 3</p><textarea>  textarea  </textarea></div>",
 		},
+		{
+			name:     "preserves indentation in multi-line code blocks",
+			input:    "<p>Example   code:</p>\n<pre><code>def greet(name):\n    if name:\n        return name\n</code></pre>\n<p>Done   here.</p>",
+			expected: "<p>Example code:</p>\n<pre><code>def greet(name):\n    if name:\n        return name\n</code></pre>\n<p>Done here.</p>",
+		},
 		{
 			name:     "handles self-closing and unclosed tags",
 			input:    "<div><p>Text   with    spaces</p><pre>  Keep  spaces  ",
@@ -0,0 +1,199 @@
+// ABOUTME: Lightweight extractive summarizer scoring sentences by term frequency and position
+// ABOUTME: Deterministic and offline, returns the top sentences in their original order
+
+package text
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var (
+	// Sentence boundary: terminal punctuation, optional closing quote/bracket, then whitespace
+	sentenceEndRegex = regexp.MustCompile(`[.!?]+["'”’)\]]*\s+`)
+	// Paragraph boundary: blank lines
+	paragraphBreakRegex = regexp.MustCompile(`\n\s*\n`)
+)
+
+// Sentences shorter than this many words are never picked for a summary
+const minSummarySentenceWords = 5
+
+// Common abbreviations that end with a period but do not end a sentence
+var sentenceAbbreviations = map[string]bool{
+	"mr": true, "mrs": true, "ms": true, "dr": true, "prof": true, "sr": true, "jr": true,
+	"st": true, "vs": true, "etc": true, "inc": true, "ltd": true, "co": true, "corp": true,
+	"e.g": true, "i.e": true, "a.m": true, "p.m": true, "u.s": true, "u.k": true, "no": true,
+	"jan": true, "feb": true, "mar": true, "apr": true, "jun": true, "jul": true, "aug": true,
+	"sep": true, "sept": true, "oct": true, "nov": true, "dec": true,
+}
+
+// English function words that carry no topical signal
+var summaryStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "are": true, "but": true, "not": true, "you": true,
+	"all": true, "any": true, "can": true, "had": true, "her": true, "was": true, "one": true,
+	"our": true, "out": true, "has": true, "his": true, "how": true, "its": true, "may": true,
+	"new": true, "now": true, "who": true, "did": true, "get": true, "him": true, "she": true,
+	"too": true, "use": true, "that": true, "with": true, "have": true, "this": true, "will": true,
+	"your": true, "from": true, "they": true, "been": true, "more": true, "were": true,
+	"what": true, "when": true, "than": true, "them": true, "then": true, "some": true,
+	"into": true, "also": true, "very": true, "just": true, "over": true, "such": true,
+	"only": true, "there": true, "their": true, "which": true, "would": true, "about": true,
+	"could": true, "other": true, "these": true, "those": true, "after": true, "while": true,
+	"where": true, "being": true, "said": true, "says": true, "each": true, "most": true,
+}
+
+// SplitSentences splits plain text into sentences, treating blank lines as hard boundaries
+func SplitSentences(content string) []string {
+	var sentences []string
+
+	for _, paragraph := range paragraphBreakRegex.Split(content, -1) {
+		paragraph = strings.Join(strings.Fields(paragraph), " ")
+		if paragraph == "" {
+			continue
+		}
+
+		// Append a space so the final sentence matches the boundary pattern too
+		paragraph += " "
+		start := 0
+		for _, loc := range sentenceEndRegex.FindAllStringIndex(paragraph, -1) {
+			candidate := strings.TrimSpace(paragraph[start:loc[1]])
+			if isAbbreviation(candidate) && loc[1] < len(paragraph) {
+				continue
+			}
+			if candidate != "" {
+				sentences = append(sentences, candidate)
+			}
+			start = loc[1]
+		}
+		if rest := strings.TrimSpace(paragraph[start:]); rest != "" {
+			sentences = append(sentences, rest)
+		}
+	}
+
+	return sentences
+}
+
+// SentencePerLine puts each sentence of plain text on its own line, keeping
+// blank lines between paragraphs. Single line breaks inside a paragraph,
+// such as between list items, also end a sentence.
+func SentencePerLine(content string) string {
+	var paragraphs []string
+	for _, paragraph := range paragraphBreakRegex.Split(content, -1) {
+		var sentences []string
+		for _, line := range strings.Split(paragraph, "\n") {
+			sentences = append(sentences, SplitSentences(line)...)
+		}
+		if len(sentences) > 0 {
+			paragraphs = append(paragraphs, strings.Join(sentences, "\n"))
+		}
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// isAbbreviation reports whether a candidate sentence ends in a known abbreviation or initial
+func isAbbreviation(candidate string) bool {
+	if !strings.HasSuffix(candidate, ".") {
+		return false
+	}
+	fields := strings.Fields(candidate)
+	last := strings.ToLower(strings.TrimSuffix(fields[len(fields)-1], "."))
+	last = strings.TrimLeft(last, "(\"'“‘")
+
+	// Single letters are initials such as "J. Smith"
+	if len([]rune(last)) == 1 {
+		return true
+	}
+	return sentenceAbbreviations[last]
+}
+
+// summaryTerms lowercases a sentence into its content words
+func summaryTerms(sentence string) []string {
+	words := strings.FieldsFunc(strings.ToLower(sentence), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := words[:0]
+	for _, word := range words {
+		if len([]rune(word)) < 3 || summaryStopWords[word] {
+			continue
+		}
+		terms = append(terms, word)
+	}
+	return terms
+}
+
+// Summarize returns an extractive summary of up to count sentences.
+// Sentences are scored by the document frequency of their content words and
+// get a boost for appearing early, then returned in reading order.
+// Returns "" when the content has no more sentences than requested.
+func Summarize(content string, count int) string {
+	if count <= 0 {
+		return ""
+	}
+
+	sentences := SplitSentences(content)
+	if len(sentences) <= count {
+		return ""
+	}
+
+	// Term frequency over the whole document
+	sentenceTerms := make([][]string, len(sentences))
+	frequency := make(map[string]int)
+	maxFrequency := 0
+	for i, sentence := range sentences {
+		sentenceTerms[i] = summaryTerms(sentence)
+		for _, term := range sentenceTerms[i] {
+			frequency[term]++
+			if frequency[term] > maxFrequency {
+				maxFrequency = frequency[term]
+			}
+		}
+	}
+	if maxFrequency == 0 {
+		return ""
+	}
+
+	type scoredSentence struct {
+		index int
+		score float64
+	}
+
+	var candidates []scoredSentence
+	for i, terms := range sentenceTerms {
+		if len(strings.Fields(sentences[i])) < minSummarySentenceWords || len(terms) == 0 {
+			continue
+		}
+
+		score := 0.0
+		for _, term := range terms {
+			score += float64(frequency[term]) / float64(maxFrequency)
+		}
+		score /= float64(len(terms))
+
+		// Lead sentences tend to carry the story, decay linearly to no boost at the end
+		score *= 1 + 0.5*(1-float64(i)/float64(len(sentences)))
+
+		candidates = append(candidates, scoredSentence{index: i, score: score})
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+
+	sort.SliceStable(candidates, func(a, b int) bool {
+		return candidates[a].score > candidates[b].score
+	})
+	if len(candidates) > count {
+		candidates = candidates[:count]
+	}
+	sort.Slice(candidates, func(a, b int) bool {
+		return candidates[a].index < candidates[b].index
+	})
+
+	picked := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		picked[i] = sentences[candidate.index]
+	}
+	return strings.Join(picked, " ")
+}
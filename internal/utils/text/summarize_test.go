@@ -0,0 +1,79 @@
+package text_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/BumpyClock/hermes/internal/utils/text"
+)
+
+const summaryFixture = `The city council approved a new solar energy plan on Tuesday after months of debate.
+
+Under the plan, solar panels will be installed on every public school and library in the city by 2027. Officials expect the solar energy program to cut electricity costs for public buildings by a third.
+
+The weather on Tuesday was mild and sunny. Several residents attended the meeting in person.
+
+Critics argued the solar plan was too expensive, but supporters said the energy savings would repay the cost within ten years. The council will review progress on the solar energy rollout every six months.`
+
+func TestSplitSentences(t *testing.T) {
+	input := "Dr. Smith arrived at 9 a.m. on Monday. She met J. Doe! Was it planned?\n\nA new paragraph starts here"
+
+	expected := []string{
+		"Dr. Smith arrived at 9 a.m. on Monday.",
+		"She met J. Doe!",
+		"Was it planned?",
+		"A new paragraph starts here",
+	}
+
+	assert.Equal(t, expected, text.SplitSentences(input))
+}
+
+func TestSentencePerLine(t *testing.T) {
+	input := "Dr. Smith arrived at 9 a.m. on Monday. She met J. Doe! Was it planned?\n\nFirst item\nSecond item. It has two sentences.\n\n\nA final paragraph"
+
+	expected := "Dr. Smith arrived at 9 a.m. on Monday.\nShe met J. Doe!\nWas it planned?\n\n" +
+		"First item\nSecond item.\nIt has two sentences.\n\n" +
+		"A final paragraph"
+
+	assert.Equal(t, expected, text.SentencePerLine(input))
+	assert.Equal(t, "", text.SentencePerLine("  \n\n "))
+}
+
+func TestSummarize(t *testing.T) {
+	t.Run("picks highest-signal sentences in order", func(t *testing.T) {
+		summary := text.Summarize(summaryFixture, 3)
+
+		assert.True(t, strings.HasPrefix(summary, "The city council approved a new solar energy plan"), "Summary: %s", summary)
+		assert.Contains(t, summary, "Officials expect the solar energy program")
+		assert.NotContains(t, summary, "The weather on Tuesday was mild")
+		assert.NotContains(t, summary, "Several residents attended")
+	})
+
+	t.Run("respects sentence count", func(t *testing.T) {
+		for _, count := range []int{1, 3} {
+			summary := text.Summarize(summaryFixture, count)
+			assert.Len(t, text.SplitSentences(summary), count)
+		}
+	})
+
+	t.Run("is deterministic", func(t *testing.T) {
+		first := text.Summarize(summaryFixture, 3)
+		for i := 0; i < 5; i++ {
+			assert.Equal(t, first, text.Summarize(summaryFixture, 3))
+		}
+	})
+
+	t.Run("returns empty for short content", func(t *testing.T) {
+		assert.Equal(t, "", text.Summarize("Only one sentence in this article.", 2))
+		assert.Equal(t, "", text.Summarize(summaryFixture, 0))
+	})
+
+	t.Run("keeps sentences intact", func(t *testing.T) {
+		summary := text.Summarize(summaryFixture, 3)
+		for _, sentence := range text.SplitSentences(summary) {
+			assert.True(t, strings.Contains(summaryFixture, sentence), "Sentence should come from the source: %s", sentence)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+// ABOUTME: Rune-aware truncation for previews printed by the CLI tools and examples
+// ABOUTME: Never cuts a multi-byte UTF-8 character in half and keeps the ellipsis inside the limit
+
+package text
+
+import "unicode/utf8"
+
+// truncateEllipsis marks text cut by Truncate
+const truncateEllipsis = "..."
+
+// Truncate shortens s to at most maxRunes runes, replacing the tail with
+// "..." when it had to cut. Limits of three runes or fewer leave no room for
+// the ellipsis and return the first maxRunes runes as they are.
+func Truncate(s string, maxRunes int) string {
+	if maxRunes <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+	if maxRunes <= len(truncateEllipsis) {
+		return s[:runeOffset(s, maxRunes)]
+	}
+	return s[:runeOffset(s, maxRunes-len(truncateEllipsis))] + truncateEllipsis
+}
+
+// runeOffset returns the byte offset at which the nth rune of s starts, or len(s)
+func runeOffset(s string, n int) int {
+	for offset := range s {
+		if n == 0 {
+			return offset
+		}
+		n--
+	}
+	return len(s)
+}
@@ -0,0 +1,75 @@
+// ABOUTME: Tests for Truncate covering multi-byte UTF-8 input such as emoji and CJK text
+// ABOUTME: Verifies cuts land on rune boundaries and the ellipsis stays within the limit
+package text
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		maxRunes int
+		expected string
+	}{
+		{
+			name:     "leaves short strings alone",
+			input:    "Hello",
+			maxRunes: 10,
+			expected: "Hello",
+		},
+		{
+			name:     "keeps a string exactly at the limit",
+			input:    "日本語のニュース",
+			maxRunes: 8,
+			expected: "日本語のニュース",
+		},
+		{
+			name:     "cuts ASCII with an ellipsis",
+			input:    "The quick brown fox",
+			maxRunes: 10,
+			expected: "The qui...",
+		},
+		{
+			name:     "cuts CJK text on a rune boundary",
+			input:    "東京で新しい図書館が開館しました",
+			maxRunes: 7,
+			expected: "東京で新...",
+		},
+		{
+			name:     "cuts next to an emoji without splitting it",
+			input:    "Launch 🚀🚀 went well",
+			maxRunes: 11,
+			expected: "Launch 🚀...",
+		},
+		{
+			name:     "short limits return leading runes without an ellipsis",
+			input:    "🎉🎉🎉🎉",
+			maxRunes: 2,
+			expected: "🎉🎉",
+		},
+		{
+			name:     "zero limit returns nothing",
+			input:    "anything",
+			maxRunes: 0,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Truncate(tt.input, tt.maxRunes)
+			if result != tt.expected {
+				t.Errorf("Truncate(%q, %d) = %q, want %q", tt.input, tt.maxRunes, result, tt.expected)
+			}
+			if !utf8.ValidString(result) {
+				t.Errorf("Truncate(%q, %d) produced invalid UTF-8 %q", tt.input, tt.maxRunes, result)
+			}
+			if count := utf8.RuneCountInString(result); count > tt.maxRunes {
+				t.Errorf("Truncate(%q, %d) returned %d runes", tt.input, tt.maxRunes, count)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// ABOUTME: Dialer that connects only to addresses which passed SSRF validation
+// ABOUTME: Resolves each host once per connection and pins the dial to the validated IPs, defeating DNS rebinding
+
+package validation
+
+import (
+	"context"
+	"net"
+)
+
+// optionsKey is the context key under which ContextWithOptions stores validation options
+type optionsKey struct{}
+
+// ContextWithOptions returns a context carrying opts for PinnedDialContext.
+// Requests made with it are checked against the same rules as ValidateURL.
+func ContextWithOptions(ctx context.Context, opts ValidationOptions) context.Context {
+	return context.WithValue(ctx, optionsKey{}, opts)
+}
+
+// optionsFromContext returns the options stored by ContextWithOptions, or the
+// secure defaults when the context carries none
+func optionsFromContext(ctx context.Context) ValidationOptions {
+	if opts, ok := ctx.Value(optionsKey{}).(ValidationOptions); ok {
+		return opts
+	}
+	return DefaultValidationOptions()
+}
+
+// PinnedDialContext returns a DialContext for http.Transport that resolves the
+// target host, validates every address with the options from the request
+// context, and then dials only those addresses. Validating a URL and fetching
+// it are otherwise two separate lookups, and a host answering with a public
+// address first and a private one second (DNS rebinding) would slip through.
+// Each redirect hop opens its own connection, so every hop is validated too.
+func PinnedDialContext(dialer *net.Dialer) func(ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+
+		addrs, err := resolveAllowed(ctx, host, address, optionsFromContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		// Try each validated address in turn, never the hostname itself
+		var lastErr error
+		for _, addr := range addrs {
+			conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(addr.IP.String(), port))
+			if err == nil {
+				return conn, nil
+			}
+			lastErr = err
+		}
+		return nil, lastErr
+	}
+}
@@ -0,0 +1,142 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// sequenceResolver answers lookups for each host from a list, one entry per
+// call, repeating the last entry once the list runs out
+type sequenceResolver struct {
+	mu      sync.Mutex
+	answers map[string][]string
+	calls   map[string]int
+}
+
+func (r *sequenceResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	answers, ok := r.answers[host]
+	if !ok {
+		return nil, fmt.Errorf("no such host %s", host)
+	}
+	if r.calls == nil {
+		r.calls = make(map[string]int)
+	}
+	i := r.calls[host]
+	if i >= len(answers) {
+		i = len(answers) - 1
+	}
+	r.calls[host]++
+	return []net.IPAddr{{IP: net.ParseIP(answers[i])}}, nil
+}
+
+// pinnedClient returns an HTTP client whose transport dials through PinnedDialContext
+func pinnedClient() *http.Client {
+	return &http.Client{Transport: &http.Transport{
+		DialContext:       PinnedDialContext(&net.Dialer{Timeout: 5 * time.Second}),
+		DisableKeepAlives: true,
+	}}
+}
+
+func get(ctx context.Context, client *http.Client, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(req)
+}
+
+func TestPinnedDialContextBlocksRebinding(t *testing.T) {
+	hits := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer ts.Close()
+	_, port, _ := net.SplitHostPort(ts.Listener.Addr().String())
+	target := "http://rebind.test:" + port + "/"
+
+	// Public during validation, loopback when the fetch resolves again
+	opts := DefaultValidationOptions()
+	opts.Resolver = &sequenceResolver{answers: map[string][]string{
+		"rebind.test": {"93.184.216.34", "127.0.0.1"},
+	}}
+
+	if err := ValidateURL(context.Background(), target, opts); err != nil {
+		t.Fatalf("Expected the first lookup to pass validation, got %v", err)
+	}
+
+	ctx := ContextWithOptions(context.Background(), opts)
+	resp, err := get(ctx, pinnedClient(), target)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("Expected the rebound address to be refused")
+	}
+	if !strings.Contains(err.Error(), "private network access not allowed") {
+		t.Errorf("Expected a private network error, got %v", err)
+	}
+	if hits != 0 {
+		t.Errorf("Expected no request to reach the server, got %d", hits)
+	}
+}
+
+func TestPinnedDialContextDialsValidatedAddress(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	defer ts.Close()
+	_, port, _ := net.SplitHostPort(ts.Listener.Addr().String())
+
+	// The name only exists in the fake resolver, so the connection must use the validated IP
+	opts := DefaultValidationOptions()
+	opts.AllowHosts = []string{"wiki.internal"}
+	opts.Resolver = &sequenceResolver{answers: map[string][]string{
+		"wiki.internal": {"127.0.0.1"},
+	}}
+
+	ctx := ContextWithOptions(context.Background(), opts)
+	resp, err := get(ctx, pinnedClient(), "http://wiki.internal:"+port+"/")
+	if err != nil {
+		t.Fatalf("Expected allowlisted host to connect, got %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestPinnedDialContextValidatesRedirects(t *testing.T) {
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected the redirect target never to be reached")
+	}))
+	defer internal.Close()
+	_, internalPort, _ := net.SplitHostPort(internal.Listener.Addr().String())
+
+	public := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://metadata.test:"+internalPort+"/", http.StatusFound)
+	}))
+	defer public.Close()
+	_, publicPort, _ := net.SplitHostPort(public.Listener.Addr().String())
+
+	// The first hop is allowlisted; the second resolves to loopback and is not
+	opts := DefaultValidationOptions()
+	opts.AllowHosts = []string{"news.test"}
+	opts.Resolver = &sequenceResolver{answers: map[string][]string{
+		"news.test":     {"127.0.0.1"},
+		"metadata.test": {"127.0.0.1"},
+	}}
+
+	ctx := ContextWithOptions(context.Background(), opts)
+	resp, err := get(ctx, pinnedClient(), "http://news.test:"+publicPort+"/")
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("Expected the redirect to a private address to be refused")
+	}
+	if !strings.Contains(err.Error(), "private network access not allowed") {
+		t.Errorf("Expected a private network error, got %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+// ABOUTME: SSRF allow and deny lists layered over the private-network block
+// ABOUTME: Entries match hostnames, *.domain wildcards, IP addresses or CIDR ranges of resolved addresses
+
+package validation
+
+import (
+	"net"
+	"strings"
+)
+
+// hostList is a parsed set of host rules
+type hostList struct {
+	names    []string     // exact hostnames, lowercased
+	suffixes []string     // ".example.com" for "*.example.com" entries
+	networks []*net.IPNet // IPs become single-address networks
+}
+
+// parseHostList parses allow or deny entries such as "cms.internal",
+// "*.corp.example", "10.0.0.5" or "10.20.0.0/16". Blank entries are ignored.
+func parseHostList(entries []string) hostList {
+	var list hostList
+	for _, entry := range entries {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		switch {
+		case entry == "":
+			continue
+		case strings.Contains(entry, "/"):
+			if _, network, err := net.ParseCIDR(entry); err == nil {
+				list.networks = append(list.networks, network)
+			}
+		case net.ParseIP(strings.Trim(entry, "[]")) != nil:
+			ip := net.ParseIP(strings.Trim(entry, "[]"))
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			list.networks = append(list.networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+		case strings.HasPrefix(entry, "*."):
+			list.suffixes = append(list.suffixes, entry[1:])
+		default:
+			list.names = append(list.names, strings.TrimSuffix(entry, "."))
+		}
+	}
+	return list
+}
+
+// matchesName reports whether hostname is listed by name or wildcard
+func (list hostList) matchesName(hostname string) bool {
+	hostname = strings.TrimSuffix(strings.ToLower(hostname), ".")
+	for _, name := range list.names {
+		if hostname == name {
+			return true
+		}
+	}
+	for _, suffix := range list.suffixes {
+		if strings.HasSuffix(hostname, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesIP reports whether ip falls in a listed address or range
+func (list hostList) matchesIP(ip net.IP) bool {
+	for _, network := range list.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+// ABOUTME: Redirect policy that validates every hop before the request for it is sent
+// ABOUTME: Complements PinnedDialContext for clients whose transport the library does not control
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// maxRedirects matches net/http's default redirect limit
+const maxRedirects = 10
+
+// CheckRedirect returns an http.Client CheckRedirect function that validates
+// each redirect target against the options carried by the request context
+// (see ContextWithOptions), refusing the hop before anything is sent to it.
+// next, when not nil, is consulted afterwards; otherwise redirects stop after
+// ten hops as net/http does by default.
+func CheckRedirect(next func(req *http.Request, via []*http.Request) error) func(req *http.Request, via []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		ctx := req.Context()
+		if err := ValidateURL(ctx, req.URL.String(), optionsFromContext(ctx)); err != nil {
+			return fmt.Errorf("redirect refused: %w", err)
+		}
+		if next != nil {
+			return next(req, via)
+		}
+		if len(via) >= maxRedirects {
+			return errors.New("stopped after 10 redirects")
+		}
+		return nil
+	}
+}
+
+// GuardRedirects returns a shallow copy of client whose redirects are
+// validated with CheckRedirect, keeping the client's own redirect policy
+func GuardRedirects(client *http.Client) *http.Client {
+	guarded := *client
+	guarded.CheckRedirect = CheckRedirect(client.CheckRedirect)
+	return &guarded
+}
@@ -19,6 +19,14 @@ type ValidationOptions struct {
 	RequireHTTPS        bool
 	MaxHostnameLength   int
 	Timeout             time.Duration
+	AllowHosts          []string // Hosts, *.domain wildcards, IPs or CIDRs reachable even when private
+	DenyHosts           []string // Hosts, *.domain wildcards, IPs or CIDRs always refused, overriding AllowHosts
+	Resolver            Resolver // Looks up host addresses, nil uses net.DefaultResolver
+}
+
+// Resolver looks up the IP addresses of a host. *net.Resolver implements it.
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
 }
 
 // DefaultValidationOptions returns secure defaults for URL validation
@@ -32,6 +40,17 @@ func DefaultValidationOptions() ValidationOptions {
 	}
 }
 
+// SSRFOptions returns the default options with the library's SSRF settings
+// applied. Allowing private networks also allows localhost.
+func SSRFOptions(allowPrivateNetworks bool, allowHosts, denyHosts []string) ValidationOptions {
+	opts := DefaultValidationOptions()
+	opts.AllowPrivateNetworks = allowPrivateNetworks
+	opts.AllowLocalhost = allowPrivateNetworks
+	opts.AllowHosts = allowHosts
+	opts.DenyHosts = denyHosts
+	return opts
+}
+
 // ValidationError represents a URL validation error with specific type information
 type ValidationError struct {
 	Type    string
@@ -143,38 +162,68 @@ func validateNetworkAccess(ctx context.Context, u *url.URL, opts ValidationOptio
 		return &ValidationError{Type: "host", Message: "cannot extract hostname", URL: u.String()}
 	}
 
+	_, err := resolveAllowed(ctx, hostname, u.String(), opts)
+	return err
+}
+
+// resolveAllowed resolves hostname and returns its addresses once every one
+// of them passes the localhost, private network and host list checks. rawURL
+// is only used in error messages.
+func resolveAllowed(ctx context.Context, hostname, rawURL string, opts ValidationOptions) ([]net.IPAddr, error) {
+	allow := parseHostList(opts.AllowHosts)
+	deny := parseHostList(opts.DenyHosts)
+
+	// Denied names are refused before anything else, whatever they resolve to
+	if deny.matchesName(hostname) {
+		return nil, &ValidationError{Type: "denied_host", Message: "host blocked by SSRF denylist", URL: rawURL}
+	}
+
+	// Allowlisted names may reach private addresses; allowlisted ranges are checked per address below
+	allowedName := allow.matchesName(hostname)
+	if literal := net.ParseIP(hostname); literal != nil && allow.matchesIP(literal) {
+		allowedName = true
+	}
+
 	// Check localhost restrictions
-	if !opts.AllowLocalhost && isLocalhost(hostname) {
-		return &ValidationError{Type: "localhost", Message: "localhost access not allowed", URL: u.String()}
+	if !opts.AllowLocalhost && !allowedName && isLocalhost(hostname) {
+		return nil, &ValidationError{Type: "localhost", Message: "localhost access not allowed", URL: rawURL}
 	}
 
 	// DNS resolution with context timeout
-	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
-	defer cancel()
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
 
-	resolver := &net.Resolver{}
+	var resolver Resolver = net.DefaultResolver
+	if opts.Resolver != nil {
+		resolver = opts.Resolver
+	}
 	addrs, err := resolver.LookupIPAddr(ctx, hostname)
 	if err != nil {
 		if ctx.Err() == context.DeadlineExceeded {
-			return &ValidationError{Type: "dns_timeout", Message: "DNS resolution timed out", URL: u.String()}
+			return nil, &ValidationError{Type: "dns_timeout", Message: "DNS resolution timed out", URL: rawURL}
 		}
-		return &ValidationError{Type: "dns", Message: fmt.Sprintf("DNS resolution failed: %v", err), URL: u.String()}
+		return nil, &ValidationError{Type: "dns", Message: fmt.Sprintf("DNS resolution failed: %v", err), URL: rawURL}
 	}
 
 	if len(addrs) == 0 {
-		return &ValidationError{Type: "dns", Message: "no IP addresses found", URL: u.String()}
+		return nil, &ValidationError{Type: "dns", Message: "no IP addresses found", URL: rawURL}
 	}
 
-	// Check for private networks if not allowed
-	if !opts.AllowPrivateNetworks {
-		for _, addr := range addrs {
-			if isPrivateIP(addr.IP) {
-				return &ValidationError{Type: "private_network", Message: "private network access not allowed", URL: u.String()}
-			}
+	// Lists are checked against the resolved addresses, so a public name
+	// pointing at a denied or private address is still refused
+	for _, addr := range addrs {
+		if deny.matchesIP(addr.IP) {
+			return nil, &ValidationError{Type: "denied_host", Message: "address blocked by SSRF denylist", URL: rawURL}
+		}
+		if !opts.AllowPrivateNetworks && isPrivateIP(addr.IP) && !allowedName && !allow.matchesIP(addr.IP) {
+			return nil, &ValidationError{Type: "private_network", Message: "private network access not allowed", URL: rawURL}
 		}
 	}
 
-	return nil
+	return addrs, nil
 }
 
 // isLocalhost checks if a hostname refers to localhost
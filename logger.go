@@ -0,0 +1,10 @@
+package hermes
+
+// Logger receives diagnostic messages about how a page was parsed, such as
+// which extractor was chosen and when fallbacks were used. Adapt it to route
+// messages into a structured logging system. Implementations must be safe
+// for concurrent use by multiple goroutines.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+}
@@ -0,0 +1,99 @@
+package hermes_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/BumpyClock/hermes"
+)
+
+const loggerArticleHTML = `<html><head><title>Logged Article</title></head><body>
+  <article>
+    <p>This article is parsed by the generic extractor because no custom extractor covers its host.</p>
+    <p>The parser reports that decision to any logger configured on the client and stays quiet otherwise.</p>
+  </article>
+</body></html>`
+
+// recordingLogger collects formatted messages by level
+type recordingLogger struct {
+	mu    sync.Mutex
+	debug []string
+	info  []string
+}
+
+func (l *recordingLogger) Debugf(format string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.debug = append(l.debug, fmt.Sprintf(format, args...))
+}
+
+func (l *recordingLogger) Infof(format string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.info = append(l.info, fmt.Sprintf(format, args...))
+}
+
+// captureStdout returns everything written to os.Stdout while fn runs
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe failed: %v", err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	done := make(chan string)
+	go func() {
+		out, _ := io.ReadAll(r)
+		done <- string(out)
+	}()
+
+	fn()
+	w.Close()
+	return <-done
+}
+
+func TestDefaultClientWritesNothingToStdout(t *testing.T) {
+	client := hermes.New(hermes.WithAllowPrivateNetworks(true))
+	output := captureStdout(t, func() {
+		if _, err := client.ParseHTML(context.Background(), loggerArticleHTML, "http://127.0.0.1/article"); err != nil {
+			t.Errorf("ParseHTML failed: %v", err)
+		}
+	})
+	if output != "" {
+		t.Errorf("Expected no stdout output by default, got %q", output)
+	}
+}
+
+func TestWithLogger(t *testing.T) {
+	logger := &recordingLogger{}
+	client := hermes.New(hermes.WithAllowPrivateNetworks(true), hermes.WithLogger(logger))
+
+	output := captureStdout(t, func() {
+		if _, err := client.ParseHTML(context.Background(), loggerArticleHTML, "http://127.0.0.1/article"); err != nil {
+			t.Errorf("ParseHTML failed: %v", err)
+		}
+	})
+	if output != "" {
+		t.Errorf("Expected logger output instead of stdout, got %q", output)
+	}
+
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+	found := false
+	for _, msg := range logger.debug {
+		if strings.Contains(msg, "using generic extraction") && strings.Contains(msg, "127.0.0.1") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected a debug message about generic extraction, got %q", logger.debug)
+	}
+}
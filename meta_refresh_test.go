@@ -0,0 +1,72 @@
+package hermes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newMetaRefreshServer serves a redirect stub at /old that refreshes to refreshTarget, and the article at /story
+func newMetaRefreshServer(t *testing.T, refreshTarget string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		switch r.URL.Path {
+		case "/old":
+			w.Write([]byte(`<html><head><title>Redirecting</title><meta http-equiv="Refresh" content="0; URL='` + refreshTarget + `'"></head>
+<body><p>This page has moved. If you are not redirected, <a href="` + refreshTarget + `">follow this link</a>.</p></body></html>`))
+		case "/story":
+			w.Write([]byte(`<html><head><title>Orchard Harvest Best in a Decade</title></head><body><article>
+	<p>Growers across the valley say this year's apple harvest is the best in a decade, after a mild spring and a dry, sunny September.</p>
+	<p>The cooperative expects to press twice as much juice as last year and has hired extra pickers to bring the crop in before the first frosts.</p>
+</article></body></html>`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestFollowMetaRefresh(t *testing.T) {
+	ts := newMetaRefreshServer(t, "/story")
+	defer ts.Close()
+	ctx := context.Background()
+
+	result, err := New(WithAllowPrivateNetworks(true), WithFollowMetaRefresh(true)).Parse(ctx, ts.URL+"/old")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if result.Title != "Orchard Harvest Best in a Decade" {
+		t.Errorf("Expected the redirect target's title, got %q", result.Title)
+	}
+	if !strings.Contains(result.Content, "best in a decade") {
+		t.Errorf("Expected the redirect target's content, got %q", result.Content)
+	}
+	if result.URL != ts.URL+"/story" {
+		t.Errorf("Expected URL %q, got %q", ts.URL+"/story", result.URL)
+	}
+
+	// Off by default: the stub itself is extracted
+	stub, err := New(WithAllowPrivateNetworks(true)).Parse(ctx, ts.URL+"/old")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if strings.Contains(stub.Content, "best in a decade") {
+		t.Errorf("Expected the redirect not to be followed by default, got %q", stub.Content)
+	}
+}
+
+func TestFollowMetaRefreshBlocksPrivateTargets(t *testing.T) {
+	ts := newMetaRefreshServer(t, "http://10.0.0.1/admin")
+	defer ts.Close()
+
+	// The stub's own host is allowlisted, the private refresh target is not
+	client := New(WithSSRFAllowHosts([]string{"127.0.0.1"}), WithFollowMetaRefresh(true))
+	_, err := client.Parse(context.Background(), ts.URL+"/old")
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Code != ErrSSRF {
+		t.Fatalf("Expected an SSRF error for the private refresh target, got %v", err)
+	}
+}
@@ -0,0 +1,40 @@
+package hermes
+
+import (
+	"context"
+	"errors"
+)
+
+// ParseMetadata fetches url and extracts only its metadata: title, author,
+// dates, description, dek, lead image, site name, favicon and the other
+// page-level fields. Content extraction is skipped entirely, so Content,
+// Excerpt and the word counts are empty, which makes it much faster than
+// Parse on large pages. It suits link previews and feed deduplication.
+//
+// Results are kept apart from full parses: ParseMetadata neither reads nor
+// fills the result cache or conditional store unless the client was
+// created with WithMetadataOnly, where every result is metadata only.
+//
+// Example:
+//
+//	preview, err := client.ParseMetadata(ctx, "https://example.com/article")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(preview.Title, preview.LeadImageURL)
+func (c *Client) ParseMetadata(ctx context.Context, url string) (*Result, error) {
+	metadataClient := *c.forURL(url)
+	metadataClient.domainProfiles = nil
+	if !metadataClient.metadataOnly {
+		metadataClient.metadataOnly = true
+		metadataClient.cache = nil
+		metadataClient.conditionalStore = nil
+	}
+
+	result, err := metadataClient.Parse(ctx, url)
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		parseErr.Op = "ParseMetadata"
+	}
+	return result, err
+}
@@ -0,0 +1,116 @@
+package hermes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// largeArticleHTML returns an article page with full metadata and the given number of paragraphs
+func largeArticleHTML(paragraphs int) string {
+	var body strings.Builder
+	for i := 0; i < paragraphs; i++ {
+		fmt.Fprintf(&body, `<div class="block"><p>Paragraph %d of the report describes how the river authority rebuilt the weir, the fish pass and the towpath, and why the work took so much longer than planned.</p></div>`, i)
+	}
+	return `<html><head>
+<title>Weir Rebuilt After Floods | River News</title>
+<meta property="og:title" content="Weir Rebuilt After Floods">
+<meta property="og:description" content="The river authority has finished rebuilding the weir washed away in last winter's floods.">
+<meta property="og:image" content="http://127.0.0.1/images/weir.jpg">
+<meta property="article:published_time" content="2024-03-05T09:00:00Z">
+<meta name="byl" content="By Ada Fisher">
+</head><body><nav><a href="/">Home</a><a href="/news">News</a></nav><article>` + body.String() + `</article></body></html>`
+}
+
+func newArticleServer(t testing.TB, html string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(html))
+	}))
+}
+
+func TestParseMetadata(t *testing.T) {
+	ts := newArticleServer(t, largeArticleHTML(20))
+	defer ts.Close()
+
+	result, err := New(WithAllowPrivateNetworks(true)).ParseMetadata(context.Background(), ts.URL+"/news/weir")
+	if err != nil {
+		t.Fatalf("ParseMetadata failed: %v", err)
+	}
+	if result.Title != "Weir Rebuilt After Floods" {
+		t.Errorf("Expected title from metadata, got %q", result.Title)
+	}
+	if !strings.Contains(result.Description, "finished rebuilding the weir") {
+		t.Errorf("Expected description, got %q", result.Description)
+	}
+	if result.LeadImageURL != "http://127.0.0.1/images/weir.jpg" {
+		t.Errorf("Expected lead image from og:image, got %q", result.LeadImageURL)
+	}
+	if result.DatePublished == nil || !result.DatePublished.Equal(time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected date published, got %v", result.DatePublished)
+	}
+	if result.Content != "" || result.WordCount != 0 {
+		t.Errorf("Expected no content, got %d words: %q", result.WordCount, result.Content)
+	}
+}
+
+func TestParseMetadataKeepsCacheSeparate(t *testing.T) {
+	ts := newArticleServer(t, largeArticleHTML(5))
+	defer ts.Close()
+
+	client := New(WithAllowPrivateNetworks(true), WithCache(time.Minute, 10))
+	ctx := context.Background()
+
+	if _, err := client.ParseMetadata(ctx, ts.URL+"/news/weir"); err != nil {
+		t.Fatalf("ParseMetadata failed: %v", err)
+	}
+	full, err := client.Parse(ctx, ts.URL+"/news/weir")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if !strings.Contains(full.Content, "fish pass") {
+		t.Errorf("Expected a full parse after ParseMetadata to have content, got %q", full.Content)
+	}
+}
+
+func TestWithMetadataOnly(t *testing.T) {
+	result, err := New(WithAllowPrivateNetworks(true), WithMetadataOnly(true)).ParseHTML(context.Background(), largeArticleHTML(5), "http://127.0.0.1/news/weir")
+	if err != nil {
+		t.Fatalf("ParseHTML failed: %v", err)
+	}
+	if result.Title != "Weir Rebuilt After Floods" || result.Author != "Ada Fisher" {
+		t.Errorf("Expected title and author, got %q by %q", result.Title, result.Author)
+	}
+	if result.Content != "" {
+		t.Errorf("Expected no content, got %q", result.Content)
+	}
+}
+
+func BenchmarkParseMetadata(b *testing.B) {
+	ts := newArticleServer(b, largeArticleHTML(400))
+	defer ts.Close()
+
+	client := New(WithAllowPrivateNetworks(true))
+	ctx := context.Background()
+	target := ts.URL + "/news/weir"
+
+	b.Run("full", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := client.Parse(ctx, target); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("metadata-only", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := client.ParseMetadata(ctx, target); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package hermes
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/BumpyClock/hermes/internal/utils/dom"
+	"github.com/BumpyClock/hermes/internal/utils/text"
+)
+
+// NormalizeURL returns the canonical form of an article URL that Hermes
+// uses when comparing pages, so callers can key caches and deduplicate
+// URLs the same way. It lowercases the scheme and host, drops a leading
+// "www." and default ports, removes the fragment, tracking parameters such
+// as utm_* and fbclid, trailing page numbers like "/2", file extensions and
+// trailing slashes, and sorts the remaining query parameters.
+//
+// An error is returned for URLs that are not absolute http or https URLs.
+//
+// Example:
+//
+//	key, err := hermes.NormalizeURL("https://www.Example.com/story/?utm_source=rss#comments")
+//	// key == "https://example.com/story"
+func NormalizeURL(rawURL string) (string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", &ParseError{Code: ErrInvalidURL, URL: rawURL, Op: "NormalizeURL", Err: err}
+	}
+
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", &ParseError{Code: ErrInvalidURL, URL: rawURL, Op: "NormalizeURL", Err: fmt.Errorf("not an absolute http or https URL")}
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+	if port := parsed.Port(); port != "" && !isDefaultPort(parsed.Scheme, port) {
+		host += ":" + port
+	}
+	parsed.Host = host
+	parsed.User = nil
+
+	// Pagination segments, file extensions and trailing slashes come off the
+	// path the same way the next-page extractor compares pages
+	base, err := url.Parse(text.ArticleBaseURL(parsed.String(), parsed))
+	if err != nil {
+		return "", &ParseError{Code: ErrInvalidURL, URL: rawURL, Op: "NormalizeURL", Err: err}
+	}
+	base.RawQuery = parsed.RawQuery
+
+	normalized, err := url.Parse(dom.SanitizeURL(dom.RemoveAnchor(base.String())))
+	if err != nil {
+		return "", &ParseError{Code: ErrInvalidURL, URL: rawURL, Op: "NormalizeURL", Err: err}
+	}
+	return normalized.String(), nil
+}
+
+// isDefaultPort reports whether port is the scheme's default and can be omitted
+func isDefaultPort(scheme, port string) bool {
+	return (scheme == "http" && port == "80") || (scheme == "https" && port == "443")
+}
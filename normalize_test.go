@@ -0,0 +1,48 @@
+package hermes_test
+
+import (
+	"testing"
+
+	"github.com/BumpyClock/hermes"
+)
+
+func TestNormalizeURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"tracking params", "https://example.com/story?utm_source=rss&utm_medium=feed&fbclid=abc", "https://example.com/story"},
+		{"keeps other params sorted", "https://example.com/watch?v=42&gclid=x&list=7", "https://example.com/watch?list=7&v=42"},
+		{"anchor", "https://example.com/story#comments", "https://example.com/story"},
+		{"trailing slash", "https://example.com/story/", "https://example.com/story"},
+		{"root trailing slash", "https://example.com/", "https://example.com"},
+		{"www and case", "HTTPS://WWW.Example.COM/Story", "https://example.com/Story"},
+		{"default port", "http://example.com:80/story", "http://example.com/story"},
+		{"custom port", "http://example.com:8080/story", "http://example.com:8080/story"},
+		{"pagination", "https://example.com/story/2", "https://example.com/story"},
+		{"everything", "https://www.example.com/news/story/?utm_campaign=x#top", "https://example.com/news/story"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := hermes.NormalizeURL(tt.input)
+			if err != nil {
+				t.Fatalf("NormalizeURL(%q) failed: %v", tt.input, err)
+			}
+			if got != tt.expected {
+				t.Errorf("NormalizeURL(%q) = %q, expected %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNormalizeURLInvalid(t *testing.T) {
+	for _, input := range []string{"", "/relative/path", "ftp://example.com/file", "https://"} {
+		_, err := hermes.NormalizeURL(input)
+		parseErr, ok := err.(*hermes.ParseError)
+		if !ok || !parseErr.IsInvalidURL() {
+			t.Errorf("NormalizeURL(%q): expected an ErrInvalidURL ParseError, got %v", input, err)
+		}
+	}
+}
@@ -10,6 +10,9 @@ type Option func(*Client)
 
 // WithHTTPClient sets a custom HTTP client for the parser.
 // This allows you to configure connection pooling, timeouts, proxies, etc.
+// URLs are still validated before they are fetched, but a custom transport
+// does not get the default transport's connection-time check that pins each
+// connection to validated addresses and guards against DNS rebinding.
 //
 // Example:
 //
@@ -23,6 +26,7 @@ type Option func(*Client)
 func WithHTTPClient(httpClient *http.Client) Option {
 	return func(c *Client) {
 		c.httpClient = httpClient
+		c.ownsHTTPClient = false
 	}
 }
 
@@ -59,6 +63,7 @@ func WithTimeout(timeout time.Duration) Option {
 		c.timeout = timeout
 		if c.httpClient == nil {
 			c.httpClient = &http.Client{}
+			c.ownsHTTPClient = true
 		}
 		c.httpClient.Timeout = timeout
 	}
@@ -76,6 +81,19 @@ func WithUserAgent(userAgent string) Option {
 	}
 }
 
+// WithAcceptHeader overrides the Accept header sent with HTTP requests.
+// The default is "text/html,application/xhtml+xml". Responses with a non-HTML
+// Content-Type are still rejected with ErrUnsupportedContentType.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithAcceptHeader("text/html;q=1.0,*/*;q=0.5"))
+func WithAcceptHeader(accept string) Option {
+	return func(c *Client) {
+		c.acceptHeader = accept
+	}
+}
+
 // WithAllowPrivateNetworks allows or disallows parsing of private network URLs.
 // By default, private networks are blocked for security (SSRF protection).
 // Set to true only in trusted environments where you need to parse internal URLs.
@@ -99,8 +117,21 @@ func WithAllowPrivateNetworks(allow bool) Option {
 }
 
 // WithContentType sets the output content type for parsing.
-// Valid options are "html", "markdown", and "text".
-// By default, content is returned as HTML.
+// Valid options are "html", "html-inline-css", "epub-chapter", "markdown", "text"
+// and "text-sentences".
+// By default, content is returned as HTML. Text output separates paragraphs
+// and headings with blank lines and puts list items on their own lines.
+// "html-inline-css" returns sanitized HTML with a small fixed set of inline
+// styles (paragraph spacing, blockquote borders, image max-width) so it
+// renders acceptably in email clients without an external stylesheet.
+// "epub-chapter" returns a complete XHTML document with the title as an h1
+// and the sanitized content as its body, ready to package as an EPUB chapter.
+// Void elements are self-closed and content that is not well-formed XML is
+// reported as an error rather than returned.
+// "text-sentences" is text output with each sentence on its own line,
+// for NLP pipelines that work line by line. Paragraphs stay separated by
+// blank lines, and common abbreviations such as "Dr." and "e.g." do not
+// end a sentence.
 //
 // Example:
 //
@@ -110,4 +141,709 @@ func WithContentType(contentType string) Option {
 	return func(c *Client) {
 		c.contentType = contentType
 	}
-}
\ No newline at end of file
+}
+
+// WithExpandTruncated enables following "continue reading" links on truncated pages.
+// Some sites serve only a teaser on the article URL with a link such as
+// "Continue reading →" to the full body. When enabled, the client follows links
+// that point back to the same article (or a ?page= variant of it), fetches the
+// full body and merges it into the result. Links to other articles are ignored.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithExpandTruncated(true))
+func WithExpandTruncated(expand bool) Option {
+	return func(c *Client) {
+		c.expandTruncated = expand
+	}
+}
+
+// WithCache enables an in-memory cache of parsed results for Parse.
+// Successful results are served from the cache for ttl without touching the
+// network; errors are never cached. Cache keys are normalized URLs, so tracking
+// parameters (utm_source, fbclid, ...) and anchors don't create separate entries.
+// When more than maxEntries results are cached, the least recently used entry
+// is evicted. A maxEntries of zero or less means no limit, and a ttl of zero or
+// less disables the cache.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithCache(10*time.Minute, 1000))
+func WithCache(ttl time.Duration, maxEntries int) Option {
+	return func(c *Client) {
+		if ttl <= 0 {
+			c.cache = nil
+			return
+		}
+		c.cache = newResultCache(ttl, maxEntries)
+	}
+}
+
+// WithConditionalFetch enables conditional fetching for Parse using the given store.
+// After a successful parse, the response's ETag and Last-Modified headers are
+// saved in store together with the result. The next Parse of the same URL sends
+// If-None-Match/If-Modified-Since, and if the server answers 304 Not Modified
+// the stored result is returned without re-parsing. If the store has validators
+// but no result, Parse returns a *ParseError with code ErrNotModified instead.
+//
+// Example:
+//
+//	store := hermes.NewMemoryConditionalStore()
+//	client := hermes.New(hermes.WithConditionalFetch(store))
+func WithConditionalFetch(store ConditionalStore) Option {
+	return func(c *Client) {
+		c.conditionalStore = store
+	}
+}
+
+// WithKeepSafeStyles keeps a small allowlist of inline CSS properties in HTML content.
+// By default all style attributes are stripped. When enabled, text-align,
+// font-style and font-weight declarations with plain keyword values survive as
+// readability hints (e.g. centered pull quotes). Everything else, including any
+// value containing url() or expression(), is still removed.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithKeepSafeStyles(true))
+func WithKeepSafeStyles(keep bool) Option {
+	return func(c *Client) {
+		c.keepSafeStyles = keep
+	}
+}
+
+// WithPDFSupport enables text extraction from PDF responses.
+// By default, responses with Content-Type application/pdf are rejected with
+// ErrUnsupportedContentType. When enabled, the PDF title and body text are
+// extracted and the result has ExtractorUsed set to "pdf". Encrypted or
+// unreadable PDFs still return ErrUnsupportedContentType.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithPDFSupport(true))
+func WithPDFSupport(enabled bool) Option {
+	return func(c *Client) {
+		c.pdfSupport = enabled
+	}
+}
+
+// WithLocale sets the locale used to read dates (e.g. "en-GB", "fr-FR").
+// Ambiguous numeric dates such as 02/03/2024 are read day first for locales
+// that write dates that way, and localized month names like "janvier" are
+// recognized. When unset, dates are parsed month first (US style).
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithLocale("en-GB"))
+func WithLocale(locale string) Option {
+	return func(c *Client) {
+		c.locale = locale
+	}
+}
+
+// WithSummary fills Result.Summary with an extractive summary of the given
+// number of sentences. Sentences are scored by term frequency and position in
+// the article, so the summary is deterministic and needs no network access.
+// Articles too short to summarize get the excerpt instead. Zero disables it.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithSummary(3))
+func WithSummary(sentences int) Option {
+	return func(c *Client) {
+		c.summarySentences = sentences
+	}
+}
+
+// WithFetcher replaces the built-in HTTP fetching used by Parse.
+// The fetched body goes through the same encoding detection, cleaning and
+// extraction as a regular HTTP response, and relative links resolve against
+// the final URL the fetcher reports. URLs are still checked for SSRF before
+// the fetcher is called. Use NewHTTPFetcher as a base for fetchers that wrap
+// plain HTTP.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithFetcher(myHeadlessBrowserFetcher))
+func WithFetcher(fetcher Fetcher) Option {
+	return func(c *Client) {
+		c.fetcher = fetcher
+	}
+}
+
+// WithPageTimeout sets a deadline for fetching the full article behind a
+// "continue reading" link when WithExpandTruncated is on. A fetch that stalls
+// past it is abandoned and the teaser is returned without failing the parse.
+// WithTimeout still bounds the whole operation.
+//
+// Example:
+//
+//	client := hermes.New(
+//	    hermes.WithTimeout(30*time.Second),
+//	    hermes.WithPageTimeout(5*time.Second),
+//	)
+func WithPageTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.pageTimeout = timeout
+	}
+}
+
+// WithMaxContentLength sets the maximum size in bytes of a fetched page.
+// The limit applies to the decompressed body, so gzip, deflate and Brotli
+// responses cannot expand past it. Defaults to 5 MB.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithMaxContentLength(2 << 20)) // 2 MB
+func WithMaxContentLength(bytes int64) Option {
+	return func(c *Client) {
+		c.maxContentLength = bytes
+	}
+}
+
+// WithStripTrackingParams removes tracking parameters such as utm_source,
+// fbclid and gclid from links and image URLs in the extracted content.
+// Other query parameters are kept. Off by default so links are returned
+// exactly as the page wrote them.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithStripTrackingParams(true))
+func WithStripTrackingParams(strip bool) Option {
+	return func(c *Client) {
+		c.stripTrackingParams = strip
+	}
+}
+
+// WithLinkDensityThreshold sets the share of link text above which a
+// well-scored block such as a list or paragraph is still removed from the
+// article as navigation. Documentation sites with many inline links may need
+// a higher value like 0.8. Lists introduced by a colon and elements marked
+// entry-content-asset are always kept. Defaults to 0.5.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithLinkDensityThreshold(0.8))
+func WithLinkDensityThreshold(threshold float64) Option {
+	return func(c *Client) {
+		c.linkDensityThreshold = threshold
+	}
+}
+
+// WithIncludeRawContent also returns the extracted article HTML before
+// sanitization in Result.RawContent, for security research and archiving.
+// Content is still sanitized. Executable scripts, styles and forms are
+// removed from the page before extraction, but RawContent keeps event
+// handlers, javascript: URLs, embedded data scripts and other markup the
+// sanitizer strips, so it must never be rendered. Off by default.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithIncludeRawContent(true))
+func WithIncludeRawContent(include bool) Option {
+	return func(c *Client) {
+		c.includeRawContent = include
+	}
+}
+
+// WithDomainProfile applies opts on top of the client's configuration when
+// parsing URLs on domain, so one client can use different settings per site.
+// Matching ignores case and a leading "www.", so "example.com" also covers
+// "www.example.com"; other subdomains need their own profile. Profile
+// options are applied after all base options regardless of order, and
+// repeated profiles for the same domain are combined.
+//
+// Example:
+//
+//	client := hermes.New(
+//	    hermes.WithContentType("html"),
+//	    hermes.WithDomainProfile("example.com",
+//	        hermes.WithContentType("markdown"),
+//	        hermes.WithTimeout(10*time.Second),
+//	    ),
+//	)
+func WithDomainProfile(domain string, opts ...Option) Option {
+	return func(c *Client) {
+		if c.domainProfileOptions == nil {
+			c.domainProfileOptions = make(map[string][]Option)
+		}
+		key := profileDomain(domain)
+		c.domainProfileOptions[key] = append(c.domainProfileOptions[key], opts...)
+	}
+}
+
+// WithProseWordCount makes Result.WordCount count only the article's running
+// prose, skipping figure captions, tables, asides and code blocks, so reading
+// time estimates reflect what readers actually read. The inclusive count is
+// always available as Result.TotalWordCount. Off by default.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithProseWordCount(true))
+func WithProseWordCount(prose bool) Option {
+	return func(c *Client) {
+		c.proseWordCount = prose
+	}
+}
+
+// WithLogger sends parse diagnostics, such as the extractor chosen for a
+// page and any fallbacks taken, to logger. Without it the client logs
+// nothing.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithLogger(myLogger))
+func WithLogger(logger Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithNestedHeadings demotes the headings in markdown content by one level
+// (h1 becomes h2, h2 becomes h3 and so on) so they nest under the article
+// title when it is rendered as the document's h1, avoiding duplicate
+// top-level headings. Only markdown output is affected. Off by default.
+//
+// Example:
+//
+//	client := hermes.New(
+//	    hermes.WithContentType("markdown"),
+//	    hermes.WithNestedHeadings(true),
+//	)
+func WithNestedHeadings(nest bool) Option {
+	return func(c *Client) {
+		c.nestHeadings = nest
+	}
+}
+
+// WithMinLeadImageSize rejects images in the page content smaller than
+// width x height pixels as lead image candidates, so thumbnails and icons
+// are never chosen. Content images without width and height attributes are
+// rejected too while a minimum is set. Images named by og:image, twitter:image
+// or image_src are always accepted since their dimensions are rarely known.
+// When no image qualifies, Result.LeadImageURL is left empty. Zero disables
+// a dimension.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithMinLeadImageSize(400, 200))
+func WithMinLeadImageSize(width, height int) Option {
+	return func(c *Client) {
+		c.minLeadImageWidth = width
+		c.minLeadImageHeight = height
+	}
+}
+
+// WithStructuredData fills Result.Structured with recipes and how-to guides
+// the page declares as schema.org Recipe or HowTo JSON-LD, giving ingredient
+// lists and ordered steps as structured data. Off by default.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithStructuredData(true))
+//	result, _ := client.Parse(ctx, url)
+//	if recipe, ok := result.Structured["recipe"].(map[string]interface{}); ok {
+//	    fmt.Println(recipe["ingredients"])
+//	}
+func WithStructuredData(enabled bool) Option {
+	return func(c *Client) {
+		c.structuredData = enabled
+	}
+}
+
+// WithContentHint nudges generic content extraction toward elements matching
+// selector, for sites where you know roughly where the article lives but do
+// not want to write a custom extractor. Matching elements with substantial
+// text get a score boost; it settles close calls but does not force the
+// choice, so a hint pointing at thin or low quality markup still loses to
+// better content. Custom extractors ignore the hint. Combine it with
+// WithDomainProfile to scope it to one site.
+//
+// Example:
+//
+//	client := hermes.New(
+//	    hermes.WithDomainProfile("example.com", hermes.WithContentHint(".story-body")),
+//	)
+func WithContentHint(selector string) Option {
+	return func(c *Client) {
+		c.contentHint = selector
+	}
+}
+
+// WithContentSections also returns the content split into sections at h2 and
+// h3 headings in Result.Sections, for readers that render an article
+// progressively. Each section is a sanitized HTML fragment starting with its
+// heading, regardless of the content type. Result.Content is unchanged.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithContentSections(true))
+//	result, _ := client.Parse(ctx, url)
+//	for _, section := range result.Sections {
+//	    fmt.Println(section.Heading)
+//	}
+func WithContentSections(enabled bool) Option {
+	return func(c *Client) {
+		c.contentSections = enabled
+	}
+}
+
+// WithASCIIQuotes replaces curly single and double quotes with ASCII ' and "
+// in text content, for downstream tools that expect plain ASCII punctuation.
+// It applies only with WithContentType("text") or "text-sentences"; other Unicode such as dashes
+// is kept. HTML entities in text content are always decoded.
+//
+// Example:
+//
+//	client := hermes.New(
+//	    hermes.WithContentType("text"),
+//	    hermes.WithASCIIQuotes(true),
+//	)
+func WithASCIIQuotes(ascii bool) Option {
+	return func(c *Client) {
+		c.asciiQuotes = ascii
+	}
+}
+
+// WithRejectNonArticles makes parsing fail with an ErrNotArticle ParseError
+// when the page looks like a home page or listing rather than a single
+// article. Without it such pages still parse and Result.IsArticle is false.
+// The classifier is conservative, so crawlers can use it to skip index pages
+// without losing real articles.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithRejectNonArticles(true))
+//	result, err := client.Parse(ctx, url)
+//	var parseErr *hermes.ParseError
+//	if errors.As(err, &parseErr) && parseErr.IsNotArticle() {
+//	    // skip listing pages
+//	}
+func WithRejectNonArticles(reject bool) Option {
+	return func(c *Client) {
+		c.rejectNonArticles = reject
+	}
+}
+
+// WithMinParagraphWords removes paragraphs with fewer than n words from
+// generically extracted content, dropping one- and two-word fragments such
+// as orphaned captions and UI labels. Paragraphs containing an image, video
+// or a link to another page are kept however short. Defaults to 0, which
+// keeps every non-empty paragraph.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithMinParagraphWords(3))
+func WithMinParagraphWords(n int) Option {
+	return func(c *Client) {
+		c.minParagraphWords = n
+	}
+}
+
+// WithAllowedContentTypes restricts fetched responses to the given media
+// types, rejecting anything else with ErrUnsupportedContentType. Entries
+// ignore parameters such as charset and may use a subtype wildcard like
+// "text/*". Responses without a Content-Type header are rejected, and pages
+// from a custom fetcher are always treated as text/html. By default any
+// HTML, XHTML, XML or plain text response is parsed. ParseHTML is not
+// affected.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithAllowedContentTypes([]string{"text/html", "application/xhtml+xml"}))
+func WithAllowedContentTypes(types []string) Option {
+	return func(c *Client) {
+		c.allowedContentTypes = types
+	}
+}
+
+// WithResultTransformer adds a function that adjusts every Result before it
+// is returned, e.g. to rewrite image hosts or redact personal data. It may be
+// given several times; transformers run in the order added. A transformer
+// error aborts the parse with an ErrTransform ParseError wrapping it. With
+// WithCache, results are cached after transformation.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithResultTransformer(func(r *hermes.Result) error {
+//	    r.LeadImageURL = strings.Replace(r.LeadImageURL, "cdn.example.com", "img.example.net", 1)
+//	    return nil
+//	}))
+func WithResultTransformer(fn func(*Result) error) Option {
+	return func(c *Client) {
+		// Copy so domain profiles never share the base client's backing array
+		c.resultTransformers = append(c.resultTransformers[:len(c.resultTransformers):len(c.resultTransformers)], fn)
+	}
+}
+
+// WithContributors fills Result.Authors and Result.Contributors, separating
+// the article's writers from editors, photographers and other credited
+// contributors. Roles are read from JSON-LD author, editor and contributor
+// properties and from bylines such as "Reported by X, edited by Y".
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithContributors(true))
+func WithContributors(enabled bool) Option {
+	return func(c *Client) {
+		c.contributors = enabled
+	}
+}
+
+// WithMarkdownFrontmatter starts markdown content with a YAML frontmatter
+// block holding the title, author, date, url and lead_image, for static site
+// generators. Values are double-quoted and escaped, and empty fields are
+// left out. Has no effect on other content types. Defaults to false.
+//
+// Example:
+//
+//	client := hermes.New(
+//	    hermes.WithContentType("markdown"),
+//	    hermes.WithMarkdownFrontmatter(true),
+//	)
+func WithMarkdownFrontmatter(enabled bool) Option {
+	return func(c *Client) {
+		c.markdownFrontmatter = enabled
+	}
+}
+
+// TransportConfig tunes connection pooling and protocol use of the default
+// HTTP transport. Zero values keep the defaults: 100 idle connections, 10
+// per host and a 90 second idle timeout.
+type TransportConfig struct {
+	// MaxIdleConns caps idle connections kept across all hosts
+	MaxIdleConns int
+	
+	// MaxIdleConnsPerHost caps idle connections kept per host
+	MaxIdleConnsPerHost int
+	
+	// IdleConnTimeout is how long an idle connection stays in the pool
+	IdleConnTimeout time.Duration
+	
+	// ForceHTTP2 attempts HTTP/2 even when dial or TLS settings are customized
+	ForceHTTP2 bool
+	
+	// DisableKeepAlives uses each connection for a single request
+	DisableKeepAlives bool
+}
+
+// WithTransportTuning adjusts the default HTTP transport's pool sizes,
+// keep-alives and HTTP/2 use without building an http.Client. It has no
+// effect when WithHTTPClient or WithTransport supplies the transport. SSRF
+// protection validates each URL before it is fetched, so it applies
+// unchanged.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithTransportTuning(hermes.TransportConfig{
+//	    MaxIdleConnsPerHost: 50,
+//	    IdleConnTimeout:     2 * time.Minute,
+//	    ForceHTTP2:          true,
+//	}))
+func WithTransportTuning(config TransportConfig) Option {
+	return func(c *Client) {
+		c.transportConfig = &config
+	}
+}
+
+// WithSSRFAllowHosts lets the client reach specific internal hosts while the
+// private network block stays on for everything else. Entries are hostnames,
+// "*.domain" wildcards, IP addresses or CIDR ranges, and are checked against
+// both the hostname and the addresses it resolves to. Later calls add to the
+// list.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithSSRFAllowHosts([]string{"cms.internal", "10.20.0.0/16"}))
+func WithSSRFAllowHosts(hosts []string) Option {
+	return func(c *Client) {
+		c.ssrfAllowHosts = append(c.ssrfAllowHosts[:len(c.ssrfAllowHosts):len(c.ssrfAllowHosts)], hosts...)
+	}
+}
+
+// WithSSRFDenyHosts refuses specific hosts even when they are public, allowed
+// by WithSSRFAllowHosts or reachable through WithAllowPrivateNetworks. Entries
+// take the same forms as WithSSRFAllowHosts; a denied entry always wins.
+// Later calls add to the list.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithSSRFDenyHosts([]string{"169.254.169.254", "*.corp.example.com"}))
+func WithSSRFDenyHosts(hosts []string) Option {
+	return func(c *Client) {
+		c.ssrfDenyHosts = append(c.ssrfDenyHosts[:len(c.ssrfDenyHosts):len(c.ssrfDenyHosts)], hosts...)
+	}
+}
+
+// WithAllowDekURLs keeps deks (subtitles) that mention a plain-text URL. By
+// default such deks are rejected because they are usually link blurbs rather
+// than subtitles; the length and excerpt checks still apply either way.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithAllowDekURLs(true))
+func WithAllowDekURLs(allow bool) Option {
+	return func(c *Client) {
+		c.allowDekURLs = allow
+	}
+}
+
+// WithDebugScores fills Result.DebugScores with the elements the generic
+// content extractor scored highest, for tuning extraction on difficult
+// pages. It is diagnostic only and does not change the extracted content.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithDebugScores(true))
+//	result, _ := client.Parse(ctx, url)
+//	for _, candidate := range result.DebugScores {
+//	    fmt.Println(candidate.Score, candidate.Path)
+//	}
+func WithDebugScores(enabled bool) Option {
+	return func(c *Client) {
+		c.debugScores = enabled
+	}
+}
+
+// WithClock sets the source of the current time wherever parsing depends
+// on it: relative dates such as "2 hours ago" or "yesterday", dates written
+// without a year, and Result.Freshness. The default is time.Now. A fixed
+// clock makes results deterministic in tests and lets a batch job measure
+// every page against the same moment.
+//
+// Example:
+//
+//	runStart := time.Now()
+//	client := hermes.New(hermes.WithClock(func() time.Time { return runStart }))
+func WithClock(now func() time.Time) Option {
+	return func(c *Client) {
+		c.clock = now
+	}
+}
+
+// WithMetadataOnly makes every parse extract only metadata, skipping the
+// content extraction that dominates parse time on large pages. Content,
+// Excerpt and the word counts are left empty and truncated articles are
+// not expanded. See Client.ParseMetadata to do this for a single call.
+//
+// Example:
+//
+//	previews := hermes.New(hermes.WithMetadataOnly(true))
+//	result, _ := previews.Parse(ctx, url)
+//	fmt.Println(result.Title, result.Description, result.LeadImageURL)
+func WithMetadataOnly(enabled bool) Option {
+	return func(c *Client) {
+		c.metadataOnly = enabled
+	}
+}
+
+// WithFollowMetaRefresh makes Parse follow <meta http-equiv="refresh">
+// redirect pages. When a fetched page has little text of its own and
+// refreshes to another URL, that URL is fetched and parsed instead, up to
+// five hops. Each target passes the same SSRF checks as the original URL.
+// Result.URL is the page the content came from. The default is off.
+//
+// Example:
+//
+//	client := hermes.New(hermes.WithFollowMetaRefresh(true))
+//	result, _ := client.Parse(ctx, "https://example.com/old-link")
+//	fmt.Println(result.URL) // the page the stub redirected to
+func WithFollowMetaRefresh(enabled bool) Option {
+	return func(c *Client) {
+		c.followMetaRefresh = enabled
+	}
+}
+
+// WithStripEmoji removes emoji from the title, author, dek, excerpt,
+// description and site name, and from content in the "text", "text-sentences"
+// and "markdown" formats, for consumers such as search indexers that only want words. HTML
+// content is left as is. Zero-width spaces, joiners and control characters
+// are removed from the same fields whether or not this is enabled.
+//
+// Example:
+//
+//	client := hermes.New(
+//	    hermes.WithContentType("text"),
+//	    hermes.WithStripEmoji(true),
+//	)
+func WithStripEmoji(strip bool) Option {
+	return func(c *Client) {
+		c.stripEmoji = strip
+	}
+}
+
+// WithMaxExtractionConcurrency caps how many field extractions run at once
+// within a single parse. Each parse otherwise starts a goroutine per metadata
+// field, which under hundreds of simultaneous parses adds up to thousands of
+// goroutines competing for the scheduler. n = 1 runs the extractions one
+// after another on the calling goroutine, which is often faster for a single
+// document since each field takes only microseconds. n <= 0 removes the
+// limit, the default.
+//
+// Example:
+//
+//	client := hermes.New(
+//	    hermes.WithMaxExtractionConcurrency(4),
+//	)
+func WithMaxExtractionConcurrency(n int) Option {
+	return func(c *Client) {
+		c.maxExtractionConcurrency = n
+	}
+}
+
+// WithKeepEmbedHosts keeps iframes served from hosts in HTML content, in
+// addition to the built-in embeds: YouTube and Vimeo players, Twitter,
+// Instagram, Spotify, CodePen, SoundCloud and Reddit. A host also covers its
+// subdomains. Iframes from any other host, such as ad and tracking frames,
+// are removed.
+//
+// Example:
+//
+//	client := hermes.New(
+//	    hermes.WithKeepEmbedHosts([]string{"embed.podcasts.apple.com", "datawrapper.dwcdn.net"}),
+//	)
+func WithKeepEmbedHosts(hosts []string) Option {
+	return func(c *Client) {
+		c.keepEmbedHosts = append([]string(nil), hosts...)
+	}
+}
+
+// WithVerifyFavicon checks the favicon Hermes falls back to when a page
+// declares no icon link. That fallback is /favicon.ico on the page's host,
+// which nearly every site serves, but not all. When enabled, a HEAD request
+// is sent for it and Result.Favicon is left empty unless the server answers
+// with a 2xx status. Declared icons are never requested. Disabled by default
+// as it adds a network round trip to such pages.
+//
+// Example:
+//
+//	client := hermes.New(
+//	    hermes.WithVerifyFavicon(true),
+//	)
+func WithVerifyFavicon(verify bool) Option {
+	return func(c *Client) {
+		c.verifyFavicon = verify
+	}
+}
+
+// WithFallback sets whether a page the extractors find nothing on falls
+// back to rough results: the <title> or first h1 as the title, and the text
+// of the first article, main or body element as the content, marked with
+// the "fallback" source. Clients leave this off unless enabled here or for
+// one call with CallOptions.Fallback.
+//
+// The internal parser turns fallback on by itself when it is handed
+// otherwise empty options: HTML output, no headers and no multi-page
+// fetching. WithFallback always takes precedence over that heuristic, so
+// WithFallback(false) keeps fallback off for such options too.
+//
+// Example:
+//
+//	client := hermes.New(
+//	    hermes.WithFallback(true),
+//	)
+func WithFallback(enabled bool) Option {
+	return func(c *Client) {
+		c.fallback = enabled
+		c.fallbackSet = true
+	}
+}
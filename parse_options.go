@@ -0,0 +1,79 @@
+// ABOUTME: Per-call option overrides for ParseWithOptions on a shared client
+// ABOUTME: Overriding calls bypass the result cache and conditional store so formats never mix
+
+package hermes
+
+import (
+	"context"
+	"errors"
+)
+
+// CallOptions overrides client settings for a single ParseWithOptions
+// call. Zero values keep the client's configuration.
+type CallOptions struct {
+	// ContentType is the output format for this call, one of the values
+	// accepted by WithContentType
+	ContentType string
+
+	// Fallback turns the rough fallback results described at WithFallback
+	// on or off for this call; nil keeps the client's setting
+	Fallback *bool
+
+	// Headers are sent with this call's requests, replacing client headers
+	// of the same name such as User-Agent
+	Headers map[string]string
+}
+
+// ParseWithOptions fetches url like Parse, applying opts over the client's
+// configuration and any domain profile for this call only. It lets one
+// shared client return different formats without building a client per
+// format.
+//
+// Calls that change the output or the request bypass the result cache and
+// conditional store, so a result in one format is never served for another.
+//
+// Example:
+//
+//	md, err := client.ParseWithOptions(ctx, url, hermes.CallOptions{ContentType: "markdown"})
+//	if err != nil {
+//	    return err
+//	}
+//	plain, err := client.ParseWithOptions(ctx, url, hermes.CallOptions{ContentType: "text"})
+func (c *Client) ParseWithOptions(ctx context.Context, url string, opts CallOptions) (*Result, error) {
+	callClient := *c.forURL(url)
+	callClient.domainProfiles = nil
+
+	overridden := false
+	if opts.ContentType != "" && opts.ContentType != callClient.contentType {
+		callClient.contentType = opts.ContentType
+		overridden = true
+	}
+	// An explicit choice also disables the parser's empty-options heuristic
+	if opts.Fallback != nil && (*opts.Fallback != callClient.fallback || !callClient.fallbackSet) {
+		callClient.fallback = *opts.Fallback
+		callClient.fallbackSet = true
+		overridden = true
+	}
+	if len(opts.Headers) > 0 {
+		headers := make(map[string]string, len(callClient.headers)+len(opts.Headers))
+		for name, value := range callClient.headers {
+			headers[name] = value
+		}
+		for name, value := range opts.Headers {
+			headers[name] = value
+		}
+		callClient.headers = headers
+		overridden = true
+	}
+	if overridden {
+		callClient.cache = nil
+		callClient.conditionalStore = nil
+	}
+
+	result, err := callClient.Parse(ctx, url)
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		parseErr.Op = "ParseWithOptions"
+	}
+	return result, err
+}
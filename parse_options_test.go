@@ -0,0 +1,114 @@
+package hermes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseWithOptionsContentType(t *testing.T) {
+	ts := newArticleServer(t, `<html><head><title>Weir Rebuilt</title></head><body><article>
+<h2>The new fish pass</h2>
+<p>The river authority has finished rebuilding the weir that was washed away in last winter's floods, <strong>six months</strong> late.</p>
+<p>Engineers added a fish pass on the eastern bank so salmon can reach the spawning grounds upstream for the first time in decades.</p>
+</article></body></html>`)
+	defer ts.Close()
+
+	client := New(WithAllowPrivateNetworks(true), WithCache(time.Minute, 10))
+	url := ts.URL + "/news/weir"
+
+	markdown, err := client.ParseWithOptions(context.Background(), url, CallOptions{ContentType: "markdown"})
+	if err != nil {
+		t.Fatalf("ParseWithOptions markdown failed: %v", err)
+	}
+	if !strings.Contains(markdown.Content, "**six months**") || !strings.Contains(markdown.Content, "## The new fish pass") {
+		t.Errorf("Expected markdown content, got %q", markdown.Content)
+	}
+
+	plain, err := client.ParseWithOptions(context.Background(), url, CallOptions{ContentType: "text"})
+	if err != nil {
+		t.Fatalf("ParseWithOptions text failed: %v", err)
+	}
+	if strings.ContainsAny(plain.Content, "<*#") || !strings.Contains(plain.Content, "six months late") {
+		t.Errorf("Expected plain text content, got %q", plain.Content)
+	}
+
+	// The client default is untouched by either call
+	html, err := client.Parse(context.Background(), url)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if !strings.Contains(html.Content, "<strong>six months</strong>") {
+		t.Errorf("Expected HTML content from the client default, got %q", html.Content)
+	}
+}
+
+func TestParseWithOptionsHeaders(t *testing.T) {
+	var userAgent, cookie string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent, cookie = r.Header.Get("User-Agent"), r.Header.Get("Cookie")
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(largeArticleHTML(3)))
+	}))
+	defer ts.Close()
+
+	client := New(WithAllowPrivateNetworks(true), WithUserAgent("Reader/1.0"))
+	_, err := client.ParseWithOptions(context.Background(), ts.URL+"/news/weir", CallOptions{
+		Headers: map[string]string{"cookie": "session=abc", "user-agent": "Reader/2.0"},
+	})
+	if err != nil {
+		t.Fatalf("ParseWithOptions failed: %v", err)
+	}
+	if userAgent != "Reader/2.0" || cookie != "session=abc" {
+		t.Errorf("Expected per-call headers, got User-Agent %q and Cookie %q", userAgent, cookie)
+	}
+
+	if _, err := client.Parse(context.Background(), ts.URL+"/news/weir"); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if userAgent != "Reader/1.0" || cookie != "" {
+		t.Errorf("Expected client headers on later calls, got User-Agent %q and Cookie %q", userAgent, cookie)
+	}
+}
+
+func TestParseWithOptionsErrorOp(t *testing.T) {
+	_, err := New().ParseWithOptions(context.Background(), "", CallOptions{ContentType: "text"})
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Op != "ParseWithOptions" {
+		t.Errorf("Expected a ParseWithOptions error, got %v", err)
+	}
+}
+
+func TestParseWithOptionsFallback(t *testing.T) {
+	ts := newArticleServer(t, `<html><head><title>Service Status</title></head><body><main>All systems normal</main></body></html>`)
+	defer ts.Close()
+
+	on, off := true, false
+	tests := []struct {
+		name     string
+		client   []Option
+		fallback *bool
+		expected string
+	}{
+		{"call turns fallback off", []Option{WithFallback(true)}, &off, ""},
+		{"call turns fallback on", nil, &on, "All systems normal"},
+		{"nil keeps the client setting", []Option{WithFallback(true)}, nil, "All systems normal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := New(append([]Option{WithAllowPrivateNetworks(true)}, tt.client...)...)
+			result, err := client.ParseWithOptions(context.Background(), ts.URL+"/status", CallOptions{Fallback: tt.fallback})
+			if err != nil {
+				t.Fatalf("ParseWithOptions failed: %v", err)
+			}
+			if result.Content != tt.expected {
+				t.Errorf("Expected content %q, got %q", tt.expected, result.Content)
+			}
+		})
+	}
+}
@@ -16,22 +16,221 @@ type Result struct {
 	Author        string     `json:"author,omitempty"`
 	DatePublished *time.Time `json:"date_published,omitempty"`
 	
+	// PublishTimezone is the timezone the article was published in, as an
+	// IANA name ("Europe/Berlin") or UTC offset ("+05:30"), inferred from the
+	// publish date's offset, a timezone meta tag or the site locale. Empty
+	// when unknown. DatePublished itself is always UTC.
+	PublishTimezone string `json:"publish_timezone,omitempty"`
+	
+	// DateModified is when the article was last updated, in UTC, from
+	// modified-time meta tags or JSON-LD dateModified. Nil when undeclared.
+	DateModified *time.Time `json:"date_modified,omitempty"`
+	
+	// Freshness buckets the age of the later of DatePublished and
+	// DateModified at parse time: "breaking" (under an hour), "today" (under
+	// a day), "this-week" (under seven days) or "older". It is "unknown" when
+	// the page has neither date or dates it more than a day into the future.
+	// WithClock sets the time it is measured against.
+	Freshness string `json:"freshness"`
+	
+	// Authors lists everyone credited with writing the article, and
+	// Contributors everyone credited in another role such as editor or
+	// photographer, from JSON-LD and bylines like "Reported by X, edited by
+	// Y". Set only with WithContributors; len(Authors) is the author count.
+	// Author remains the primary author.
+	Authors      []AuthorInfo `json:"authors,omitempty"`
+	Contributors []AuthorInfo `json:"contributors,omitempty"`
+	
+	// RawContent is the extracted article HTML before sanitization, set only
+	// with WithIncludeRawContent. It may contain event handlers, javascript:
+	// URLs and script elements and is UNSAFE to render; use Content for display.
+	RawContent string `json:"raw_content,omitempty"`
+	
 	// Media and metadata
 	LeadImageURL  string `json:"lead_image_url,omitempty"`
+	// LeadImageWidth and LeadImageHeight are the lead image's size in pixels
+	// as declared by og:image:width and og:image:height, or by the width and
+	// height attributes of the content image it was taken from. 0 when the
+	// page does not say.
+	LeadImageWidth  int `json:"lead_image_width,omitempty"`
+	LeadImageHeight int `json:"lead_image_height,omitempty"`
 	Dek           string `json:"dek,omitempty"`
 	Domain        string `json:"domain"`
 	Excerpt       string `json:"excerpt,omitempty"`
+	Summary       string `json:"summary,omitempty"`
+	
+	// ContentHash is the SHA-256 hex digest of Content's text with markup
+	// stripped and whitespace collapsed, so re-fetching a page whose markup,
+	// layout or ads changed yields the same hash unless the article text did.
+	// Hashes are comparable between results parsed with the same content
+	// type. Empty when there is no content.
+	ContentHash string `json:"content_hash,omitempty"`
 	
 	// Content metrics
 	WordCount     int    `json:"word_count"`
 	Direction     string `json:"direction,omitempty"`
-	TotalPages    int    `json:"total_pages,omitempty"`
 	RenderedPages int    `json:"rendered_pages,omitempty"`
 	
+	// TotalPages is the number of pages the article spans, read from "Page X
+	// of Y" indicators or a rel=last link. It is 1 when no pagination is found.
+	TotalPages int `json:"total_pages,omitempty"`
+	
+	// TotalWordCount counts every word in the content, including captions,
+	// tables and code. WordCount equals it unless WithProseWordCount is set.
+	TotalWordCount int `json:"total_word_count"`
+	
+	// CommentCount is the number of reader comments, or -1 when unknown
+	CommentCount int `json:"comment_count"`
+
+	// Paywalled reports that the full text appears to be behind a subscription wall
+	Paywalled bool `json:"paywalled"`
+	
+	// IsArticle reports whether the page looks like a single article rather
+	// than a home page or listing, judged from link density, the size of the
+	// extracted content, h1 count and publish date. Pages declaring an
+	// article type in og:type or JSON-LD always count as articles.
+	IsArticle bool `json:"is_article"`
+	
+	// PageType is the kind of page: "article", "blog", "product", "video" or
+	// "unknown". It comes from the first recognized JSON-LD @type, then
+	// og:type, then the page itself: a price means a product, a video player
+	// with little text a video, and a byline with a date an article. Pages
+	// nothing decides are articles when content was extracted.
+	PageType string `json:"page_type"`
+	
 	// Site information
 	SiteName    string `json:"site_name,omitempty"`
 	Description string `json:"description,omitempty"`
 	Language    string `json:"language,omitempty"`
+	
+	// Alternates maps hreflang codes, including "x-default", to the absolute
+	// URLs of the page's translations
+	Alternates map[string]string `json:"alternates,omitempty"`
+	
+	// Favicon is the single preferred site icon, kept for compatibility.
+	// Pages that declare no icon get /favicon.ico on their host.
+	Favicon string `json:"favicon,omitempty"`
+	
+	// Icons lists every declared icon (favicons, apple-touch and mask icons)
+	// with absolute URLs, largest first by declared size
+	Icons []IconInfo `json:"icons,omitempty"`
+	
+	// ThemeColor is the CSS color the page declares for browser UI with
+	// <meta name="theme-color">, e.g. "#4285f4". When light and dark scheme
+	// colors are declared, the light one is used.
+	ThemeColor string `json:"theme_color,omitempty"`
+	
+	// Breadcrumbs lists the site's category trail from root to leaf
+	Breadcrumbs []string `json:"breadcrumbs,omitempty"`
+	
+	// Section is the site section the article is filed under, e.g. "Politics",
+	// read from article:section, JSON-LD articleSection or the breadcrumb leaf
+	Section string `json:"section,omitempty"`
+	
+	// Location is the place the story is about, e.g. "City Hall, Springfield,
+	// IL", read from JSON-LD contentLocation or spatialCoverage, then the
+	// geo.placename or article:location meta tags. Geo holds its coordinates
+	// when the page declares them. Both are empty when the page names no place.
+	Location string    `json:"location,omitempty"`
+	Geo      *GeoPoint `json:"geo,omitempty"`
+	
+	// SocialMeta maps every og:*, twitter:* and article:* meta tag to its
+	// content, unmodified, for building social previews
+	SocialMeta map[string]string `json:"social_meta,omitempty"`
+	
+	// Videos lists embedded video URLs, with YouTube and Vimeo embeds
+	// normalized to their watch URLs
+	Videos []string `json:"videos,omitempty"`
+	
+	// ImageCount and EmbedCount are the number of images and of embedded
+	// iframes, such as video players and social posts, left in the cleaned
+	// content. They are counted whatever the content type, so text output
+	// reports the images its HTML carried.
+	ImageCount int `json:"image_count"`
+	EmbedCount int `json:"embed_count"`
+	
+	// AudioURL is the absolute URL of the page's audio file, such as a
+	// podcast episode or an article's narration, read from an <audio> player,
+	// then JSON-LD AudioObject or associatedMedia, then og:audio. It is empty
+	// when the page offers none.
+	AudioURL string `json:"audio_url,omitempty"`
+	
+	// Tables holds each table in the content as a grid of cell text, header
+	// rows first. Spanned cells repeat the spanning cell's text.
+	Tables [][][]string `json:"tables,omitempty"`
+	
+	// Quotes lists the blockquotes and pull quotes in the content as plain
+	// text, trimmed and deduplicated. A quote with a <cite> or <footer>
+	// attribution ends with " — " and the attribution. The quotes also
+	// remain in Content.
+	Quotes []string `json:"quotes,omitempty"`
+	
+	// Sections splits the content at h2 and h3 headings into sanitized HTML
+	// fragments, set only with WithContentSections. Content before the first
+	// heading forms a leading section with an empty Heading.
+	Sections []ContentSection `json:"sections,omitempty"`
+	
+	// ExtractorUsed names the extractor that produced the result,
+	// e.g. "custom:www.nytimes.com" or "pdf". Empty for the generic extractor.
+	ExtractorUsed string `json:"extractor_used,omitempty"`
+	
+	// FieldSources records where key fields were found, keyed by JSON field
+	// name: "custom", "jsonld", "meta", "selector", "byline", "url",
+	// "generic-heuristic" or "fallback". Useful for judging extraction
+	// confidence, e.g. {"title": "jsonld", "content": "generic-heuristic"}.
+	FieldSources map[string]string `json:"field_sources,omitempty"`
+	
+	// Warnings lists issues that did not fail the parse but lower confidence
+	// in the result: a missing title or content, fields only a fallback
+	// could fill, and a declared lang or dir that disagrees with the script
+	// the content is written in, e.g. lang="en" on an Arabic article.
+	Warnings []string `json:"warnings,omitempty"`
+	
+	// Structured holds schema.org data declared in JSON-LD when
+	// WithStructuredData is enabled. A "recipe" entry has "name",
+	// "ingredients" and "instructions"; a "how_to" entry has "name" and
+	// "steps". Lists are []string in page order. Nil when the page declares
+	// neither type.
+	Structured map[string]interface{} `json:"structured,omitempty"`
+	
+	// DebugScores lists the elements the generic content extractor scored
+	// highest, best first, when WithDebugScores is enabled. The first entry
+	// is the block the content was taken from. Scores are diagnostic and may
+	// change between releases. Nil when a custom extractor supplied the
+	// content.
+	DebugScores []CandidateScore `json:"debug_scores,omitempty"`
+}
+
+// AuthorInfo is a person credited on the article and their role, e.g.
+// "author", "editor", "contributor", "photographer" or "illustrator"
+type AuthorInfo struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+// IconInfo describes a site icon declared with a <link> tag
+type IconInfo struct {
+	URL   string `json:"url"`
+	Sizes string `json:"sizes,omitempty"` // e.g. "180x180" or "any"
+	Type  string `json:"type,omitempty"`  // e.g. "image/png"
+}
+
+// GeoPoint is a position in decimal degrees
+type GeoPoint struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// CandidateScore is one element scored by the generic content extractor
+type CandidateScore struct {
+	Path  string `json:"path"`  // CSS-like path, e.g. "html > body > div#main > article.post"
+	Score int    `json:"score"`
+}
+
+// ContentSection is one heading-delimited part of the article content
+type ContentSection struct {
+	Heading string `json:"heading"` // Heading text, empty for content before the first heading
+	HTML    string `json:"html"`    // Sanitized HTML, starting with the heading element
 }
 
 // FormatMarkdown formats the result as Markdown with metadata header.
@@ -140,4 +339,91 @@ func (r *Result) HasDate() bool {
 // HasImage returns true if a lead image is available
 func (r *Result) HasImage() bool {
 	return r.LeadImageURL != ""
-}
\ No newline at end of file
+}
+
+// clone returns a deep copy of the result, so a stored copy shares no maps,
+// slices or pointers with the one handed to the caller
+func (r *Result) clone() *Result {
+	c := *r
+	if r.DatePublished != nil {
+		t := *r.DatePublished
+		c.DatePublished = &t
+	}
+	if r.DateModified != nil {
+		t := *r.DateModified
+		c.DateModified = &t
+	}
+	if r.Geo != nil {
+		geo := *r.Geo
+		c.Geo = &geo
+	}
+	c.Authors = cloneSlice(r.Authors)
+	c.Contributors = cloneSlice(r.Contributors)
+	c.Icons = cloneSlice(r.Icons)
+	c.Breadcrumbs = cloneSlice(r.Breadcrumbs)
+	c.Videos = cloneSlice(r.Videos)
+	c.Quotes = cloneSlice(r.Quotes)
+	c.Sections = cloneSlice(r.Sections)
+	c.Warnings = cloneSlice(r.Warnings)
+	c.DebugScores = cloneSlice(r.DebugScores)
+	c.Alternates = cloneStringMap(r.Alternates)
+	c.SocialMeta = cloneStringMap(r.SocialMeta)
+	c.FieldSources = cloneStringMap(r.FieldSources)
+	if r.Tables != nil {
+		c.Tables = make([][][]string, len(r.Tables))
+		for i, table := range r.Tables {
+			c.Tables[i] = make([][]string, len(table))
+			for j, row := range table {
+				c.Tables[i][j] = cloneSlice(row)
+			}
+		}
+	}
+	if r.Structured != nil {
+		c.Structured = cloneValue(r.Structured).(map[string]interface{})
+	}
+	return &c
+}
+
+// cloneSlice copies a slice of values, keeping nil as nil
+func cloneSlice[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(s)), s...)
+}
+
+// cloneStringMap copies a string map, keeping nil as nil
+func cloneStringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	c := make(map[string]string, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// cloneValue deep-copies the maps and slices of decoded structured data
+func cloneValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		c := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			c[k] = cloneValue(item)
+		}
+		return c
+	case []interface{}:
+		c := make([]interface{}, len(v))
+		for i, item := range v {
+			c[i] = cloneValue(item)
+		}
+		return c
+	case []string:
+		return cloneSlice(v)
+	case map[string]string:
+		return cloneStringMap(v)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,109 @@
+package hermes
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// ParseStats is a snapshot of parse outcomes across every Client in the process,
+// counted since start-up or the last ResetStats.
+type ParseStats struct {
+	// Parses counts completed Parse, ParseHTML and ParseDocument calls
+	Parses int64 `json:"parses"`
+
+	// Successes counts calls that returned a result
+	Successes int64 `json:"successes"`
+
+	// Failures counts failed calls by error code
+	Failures map[ErrorCode]int64 `json:"failures"`
+
+	// Fields counts successful results in which each field was populated,
+	// keyed by JSON field name, e.g. Fields["author"]. Dividing by
+	// Successes gives how often a field is found.
+	Fields map[string]int64 `json:"fields"`
+}
+
+// statsFields are the result fields whose population Stats tracks
+var statsFields = [...]struct {
+	name      string
+	populated func(*Result) bool
+}{
+	{"title", func(r *Result) bool { return r.Title != "" }},
+	{"content", func(r *Result) bool { return r.Content != "" }},
+	{"author", func(r *Result) bool { return r.Author != "" }},
+	{"date_published", func(r *Result) bool { return r.DatePublished != nil }},
+	{"lead_image_url", func(r *Result) bool { return r.LeadImageURL != "" }},
+	{"dek", func(r *Result) bool { return r.Dek != "" }},
+	{"excerpt", func(r *Result) bool { return r.Excerpt != "" }},
+	{"site_name", func(r *Result) bool { return r.SiteName != "" }},
+	{"description", func(r *Result) bool { return r.Description != "" }},
+	{"language", func(r *Result) bool { return r.Language != "" }},
+}
+
+// parseStats holds the live counters behind Stats. Failure counters are
+// created on first use per error code.
+var parseStats struct {
+	parses    atomic.Int64
+	successes atomic.Int64
+	failures  sync.Map // ErrorCode -> *atomic.Int64
+	fields    [len(statsFields)]atomic.Int64
+}
+
+// Stats returns a snapshot of parse counts. Counters are read one at a
+// time, so a snapshot taken while parses are running may be off by the
+// calls in flight.
+func Stats() ParseStats {
+	stats := ParseStats{
+		Parses:    parseStats.parses.Load(),
+		Successes: parseStats.successes.Load(),
+		Failures:  make(map[ErrorCode]int64),
+		Fields:    make(map[string]int64, len(statsFields)),
+	}
+	parseStats.failures.Range(func(code, count interface{}) bool {
+		if n := count.(*atomic.Int64).Load(); n > 0 {
+			stats.Failures[code.(ErrorCode)] = n
+		}
+		return true
+	})
+	for i, field := range statsFields {
+		stats.Fields[field.name] = parseStats.fields[i].Load()
+	}
+	return stats
+}
+
+// ResetStats sets every parse counter back to zero
+func ResetStats() {
+	parseStats.parses.Store(0)
+	parseStats.successes.Store(0)
+	parseStats.failures.Range(func(code, count interface{}) bool {
+		count.(*atomic.Int64).Store(0)
+		return true
+	})
+	for i := range parseStats.fields {
+		parseStats.fields[i].Store(0)
+	}
+}
+
+// recordParse counts the outcome of one parse call
+func recordParse(result *Result, err error) {
+	parseStats.parses.Add(1)
+	if err != nil {
+		code := ErrExtract
+		if parseErr, ok := err.(*ParseError); ok {
+			code = parseErr.Code
+		}
+		counter, _ := parseStats.failures.LoadOrStore(code, new(atomic.Int64))
+		counter.(*atomic.Int64).Add(1)
+		return
+	}
+
+	parseStats.successes.Add(1)
+	if result == nil {
+		return
+	}
+	for i, field := range statsFields {
+		if field.populated(result) {
+			parseStats.fields[i].Add(1)
+		}
+	}
+}
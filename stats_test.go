@@ -0,0 +1,70 @@
+package hermes_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/BumpyClock/hermes"
+)
+
+const statsArticleHTML = `<html><head><title>Counted Article</title><meta name="dc.creator" content="Jane Smith"></head><body>
+  <article>
+    <p>Every parse that completes is counted, so operators can see how often extraction succeeds and which fields come up empty.</p>
+    <p>The counters are updated atomically, which keeps the totals consistent when many goroutines parse at the same time.</p>
+  </article>
+</body></html>`
+
+func TestStatsConcurrentParses(t *testing.T) {
+	hermes.ResetStats()
+	t.Cleanup(hermes.ResetStats)
+
+	client := hermes.New(hermes.WithAllowPrivateNetworks(true))
+	const workers, perWorker = 8, 25
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				if i%5 == 0 {
+					// Empty HTML fails validation with ErrInvalidURL
+					client.ParseHTML(context.Background(), "", "http://127.0.0.1/article")
+					continue
+				}
+				if _, err := client.ParseHTML(context.Background(), statsArticleHTML, "http://127.0.0.1/article"); err != nil {
+					t.Errorf("ParseHTML failed: %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	stats := hermes.Stats()
+	total := int64(workers * perWorker)
+	failures := int64(workers * perWorker / 5)
+
+	if stats.Parses != total {
+		t.Errorf("Expected %d parses, got %d", total, stats.Parses)
+	}
+	if stats.Successes != total-failures {
+		t.Errorf("Expected %d successes, got %d", total-failures, stats.Successes)
+	}
+	if stats.Failures[hermes.ErrInvalidURL] != failures {
+		t.Errorf("Expected %d ErrInvalidURL failures, got %v", failures, stats.Failures)
+	}
+	for _, field := range []string{"title", "content", "author"} {
+		if stats.Fields[field] != stats.Successes {
+			t.Errorf("Expected %s populated in all %d successes, got %d", field, stats.Successes, stats.Fields[field])
+		}
+	}
+	if stats.Fields["lead_image_url"] != 0 {
+		t.Errorf("Expected no lead images, got %d", stats.Fields["lead_image_url"])
+	}
+
+	hermes.ResetStats()
+	if reset := hermes.Stats(); reset.Parses != 0 || reset.Successes != 0 || len(reset.Failures) != 0 || reset.Fields["title"] != 0 {
+		t.Errorf("Expected zeroed stats after ResetStats, got %+v", reset)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/BumpyClock/hermes/internal/extractors/custom"
 	"github.com/BumpyClock/hermes/internal/parser"
+	"github.com/BumpyClock/hermes/internal/utils/text"
 )
 
 func main() {
@@ -75,7 +76,7 @@ func main() {
 	fmt.Printf("✅ Parser succeeded\n")
 	fmt.Printf("\n=== EXTRACTION RESULTS ===")
 	fmt.Printf("🔧 Extractor used: %s\n", result.ExtractorUsed)
-	fmt.Printf("📰 Title: %s\n", truncateString(result.Title, 80))
+	fmt.Printf("📰 Title: %s\n", text.Truncate(result.Title, 80))
 	fmt.Printf("👤 Author: %s\n", result.Author)
 	
 	if result.DatePublished != nil {
@@ -85,7 +86,7 @@ func main() {
 	}
 	
 	fmt.Printf("📊 Word count: %d\n", result.WordCount)
-	fmt.Printf("📝 Excerpt: %s\n", truncateString(result.Excerpt, 120))
+	fmt.Printf("📝 Excerpt: %s\n", text.Truncate(result.Excerpt, 120))
 	
 	// Show content preview
 	contentPreview := strings.ReplaceAll(result.Content, "\n", " ")
@@ -93,7 +94,7 @@ func main() {
 	for strings.Contains(contentPreview, "  ") {
 		contentPreview = strings.ReplaceAll(contentPreview, "  ", " ")
 	}
-	fmt.Printf("📄 Content preview: %s\n", truncateString(contentPreview, 150))
+	fmt.Printf("📄 Content preview: %s\n", text.Truncate(contentPreview, 150))
 
 	// Custom extractor quality check
 	fmt.Printf("\n=== QUALITY ASSESSMENT ===")
@@ -131,11 +132,4 @@ func main() {
 		jsonData, _ := json.MarshalIndent(result, "", "  ")
 		fmt.Println(string(jsonData))
 	}
-}
-
-func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
-	}
-	return s[:maxLen] + "..."
 }
\ No newline at end of file
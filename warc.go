@@ -0,0 +1,120 @@
+package hermes
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+
+	"github.com/BumpyClock/hermes/internal/resource"
+)
+
+// ParseWARCRecord extracts content from a single WARC response record, as
+// written by web archiving tools. The record may be gzip-compressed on its
+// own, as each record in a .warc.gz file is. The archived HTTP payload is
+// decompressed, decoded to UTF-8 and parsed with ParseHTML using the record's
+// WARC-Target-URI, so links resolve against the original address.
+//
+// Example:
+//
+//	f, _ := os.Open("article.warc.gz")
+//	defer f.Close()
+//	result, err := client.ParseWARCRecord(ctx, f)
+func (c *Client) ParseWARCRecord(ctx context.Context, record io.Reader) (*Result, error) {
+	maxBytes := c.maxContentLength
+	if maxBytes <= 0 {
+		maxBytes = resource.MAX_CONTENT_LENGTH
+	}
+	targetURI, html, err := readWARCResponse(record, maxBytes)
+	if err != nil {
+		code := ErrParse
+		if errors.Is(err, resource.ErrUnsupportedContentType) {
+			code = ErrUnsupportedContentType
+		}
+		parseErr := &ParseError{
+			Code: code,
+			URL:  targetURI,
+			Op:   "ParseWARCRecord",
+			Err:  err,
+		}
+		// ParseHTML counts its own outcomes, records rejected before it must be counted here
+		recordParse(nil, parseErr)
+		return nil, parseErr
+	}
+	return c.ParseHTML(ctx, html, targetURI)
+}
+
+// readWARCResponse reads one WARC response record and returns its target URI
+// and the archived HTTP body as UTF-8 text. Bodies that decompress to more
+// than maxBytes are refused.
+func readWARCResponse(record io.Reader, maxBytes int64) (string, string, error) {
+	buffered := bufio.NewReader(record)
+	if magic, err := buffered.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid gzip record: %w", err)
+		}
+		defer gz.Close()
+		// A .warc.gz file is a series of gzip members, one per record
+		gz.Multistream(false)
+		buffered = bufio.NewReader(gz)
+	}
+
+	reader := textproto.NewReader(buffered)
+	version, err := reader.ReadLine()
+	if err != nil {
+		return "", "", fmt.Errorf("reading WARC version: %w", err)
+	}
+	if !strings.HasPrefix(version, "WARC/") {
+		return "", "", fmt.Errorf("not a WARC record: %q", version)
+	}
+	header, err := reader.ReadMIMEHeader()
+	if err != nil {
+		return "", "", fmt.Errorf("reading WARC headers: %w", err)
+	}
+
+	// WARC/1.0 writers sometimes wrap the URI in angle brackets
+	targetURI := strings.Trim(strings.TrimSpace(header.Get("WARC-Target-URI")), "<>")
+	if recordType := header.Get("WARC-Type"); recordType != "response" {
+		return targetURI, "", fmt.Errorf("WARC record type %q is not a response", recordType)
+	}
+	if targetURI == "" {
+		return "", "", fmt.Errorf("WARC record has no WARC-Target-URI")
+	}
+	length, err := strconv.ParseInt(header.Get("Content-Length"), 10, 64)
+	if err != nil || length < 0 {
+		return targetURI, "", fmt.Errorf("invalid WARC Content-Length %q", header.Get("Content-Length"))
+	}
+
+	// The record block is the HTTP response exactly as it came off the wire
+	block := bufio.NewReader(io.LimitReader(buffered, length))
+	resp, err := http.ReadResponse(block, nil)
+	if err != nil {
+		return targetURI, "", fmt.Errorf("reading archived HTTP response: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return targetURI, "", fmt.Errorf("archived response has status %d", resp.StatusCode)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if contentType != "" && !resource.IsTextContent(contentType) {
+		return targetURI, "", fmt.Errorf("%w: archived content type %s", resource.ErrUnsupportedContentType, contentType)
+	}
+	body, err := resource.ReadLimitedBody(resp, maxBytes)
+	if err != nil {
+		return targetURI, "", fmt.Errorf("reading archived body: %w", err)
+	}
+
+	html, err := resource.DetectAndDecodeText(body, contentType)
+	if err != nil {
+		return targetURI, "", err
+	}
+	return targetURI, html, nil
+}
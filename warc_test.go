@@ -0,0 +1,117 @@
+package hermes_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/BumpyClock/hermes"
+)
+
+func TestParseWARCRecord(t *testing.T) {
+	record, err := os.ReadFile("testdata/article.warc")
+	if err != nil {
+		t.Fatalf("Failed to read fixture: %v", err)
+	}
+
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	gz.Write(record)
+	gz.Close()
+
+	tests := []struct {
+		name   string
+		record []byte
+	}{
+		{"plain record", record},
+		{"gzipped record", compressed.Bytes()},
+	}
+
+	client := hermes.New(hermes.WithAllowPrivateNetworks(true))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := client.ParseWARCRecord(context.Background(), bytes.NewReader(tt.record))
+			if err != nil {
+				t.Fatalf("ParseWARCRecord failed: %v", err)
+			}
+			if result.URL != "http://127.0.0.1/news/tidal-mill" {
+				t.Errorf("Expected the WARC-Target-URI as URL, got %q", result.URL)
+			}
+			if result.Title != "Tidal Mill Reopens" {
+				t.Errorf("Expected title 'Tidal Mill Reopens', got %q", result.Title)
+			}
+			if !strings.Contains(result.Content, "ground its first flour in forty years") {
+				t.Errorf("Expected archived article content, got %q", result.Content)
+			}
+		})
+	}
+}
+
+func TestParseWARCRecordErrors(t *testing.T) {
+	client := hermes.New(hermes.WithAllowPrivateNetworks(true))
+
+	tests := []struct {
+		name   string
+		record string
+		code   hermes.ErrorCode
+	}{
+		{
+			name:   "not a WARC record",
+			record: "HTTP/1.1 200 OK\r\n\r\n",
+			code:   hermes.ErrParse,
+		},
+		{
+			name:   "request record",
+			record: "WARC/1.0\r\nWARC-Type: request\r\nWARC-Target-URI: http://127.0.0.1/\r\nContent-Length: 0\r\n\r\n",
+			code:   hermes.ErrParse,
+		},
+		{
+			name: "image payload",
+			record: "WARC/1.0\r\nWARC-Type: response\r\nWARC-Target-URI: http://127.0.0.1/logo.png\r\nContent-Length: 44\r\n\r\n" +
+				"HTTP/1.1 200 OK\r\nContent-Type: image/png\r\n\r\n",
+			code: hermes.ErrUnsupportedContentType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ParseWARCRecord(context.Background(), strings.NewReader(tt.record))
+			parseErr, ok := err.(*hermes.ParseError)
+			if !ok {
+				t.Fatalf("Expected *ParseError, got %T: %v", err, err)
+			}
+			if parseErr.Code != tt.code {
+				t.Errorf("Expected %v, got %v: %v", tt.code, parseErr.Code, err)
+			}
+		})
+	}
+}
+
+func TestParseWARCRecordSizeLimit(t *testing.T) {
+	hermes.ResetStats()
+	t.Cleanup(hermes.ResetStats)
+
+	payload := "<html><body>" + strings.Repeat("<p>padding</p>", 1000) + "</body></html>"
+	block := "HTTP/1.1 200 OK\r\nContent-Type: text/html\r\n\r\n" + payload
+	record := fmt.Sprintf("WARC/1.0\r\nWARC-Type: response\r\nWARC-Target-URI: http://127.0.0.1/big\r\nContent-Length: %d\r\n\r\n%s", len(block), block)
+
+	client := hermes.New(hermes.WithAllowPrivateNetworks(true), hermes.WithMaxContentLength(1024))
+	_, err := client.ParseWARCRecord(context.Background(), strings.NewReader(record))
+	parseErr, ok := err.(*hermes.ParseError)
+	if !ok {
+		t.Fatalf("Expected *ParseError for an oversized record, got %T: %v", err, err)
+	}
+	if !strings.Contains(parseErr.Error(), "too large") {
+		t.Errorf("Expected size limit error, got %v", parseErr)
+	}
+
+	// Records refused before extraction still show up in the stats
+	stats := hermes.Stats()
+	if stats.Parses != 1 || stats.Failures[hermes.ErrParse] != 1 {
+		t.Errorf("Expected one failed parse in stats, got %+v", stats)
+	}
+}